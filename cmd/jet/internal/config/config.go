@@ -0,0 +1,272 @@
+// Package config contains jet generator configuration file support. Configuration file can be in YAML or JSON
+// format, and it declares database connection, include/exclude filters, Go type overrides for database types
+// and columns, additional model struct tags and paths of generated model and sql builder files.
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-jet/jet/v2/generator/metadata"
+	"github.com/go-jet/jet/v2/generator/template"
+	"github.com/go-jet/jet/v2/internal/jet"
+	"gopkg.in/yaml.v3"
+)
+
+// Config is jet generator configuration
+type Config struct {
+	Connection Connection `json:"connection"`
+	// Path is destination directory for generated files
+	Path string `json:"path"`
+
+	Tables Filter `json:"tables"`
+	Views  Filter `json:"views"`
+	Enums  Filter `json:"enums"`
+	// ColumnFilter filters table and view columns, see metadata.Filters
+	ColumnFilter Filter `json:"column_filter"`
+
+	Model      Model      `json:"model"`
+	SQLBuilder SQLBuilder `json:"sql_builder"`
+
+	// Types are Go type overrides for database types
+	Types []TypeOverride `json:"types"`
+	// Columns are model field overrides for table and view columns
+	Columns []ColumnOverride `json:"columns"`
+}
+
+// Connection is database connection configuration. If DSN is set, other connection parameters are not used.
+type Connection struct {
+	Source   string `json:"source"`
+	DSN      string `json:"dsn"`
+	Host     string `json:"host"`
+	Port     int    `json:"port"`
+	User     string `json:"user"`
+	Password string `json:"password"`
+	DBName   string `json:"dbname"`
+	Schema   string `json:"schema"`
+	SslMode  string `json:"sslmode"`
+	Params   string `json:"params"`
+}
+
+// Filter is list of include and exclude patterns. Patterns are case-insensitive names, path.Match glob patterns or
+// regular expressions enclosed in slashes. If include list is empty all the names are included, unless they are excluded.
+type Filter = metadata.Filter
+
+// Model is model files generation configuration
+type Model struct {
+	Skip bool   `json:"skip"`
+	Path string `json:"path"`
+	// Tags is a list of struct tag keys (for instance json or db) added to each model field, with column name as value
+	Tags []string `json:"tags"`
+	// NullType is go type of nullable column fields: pointer (default), sql (sql.NullInt32, ...) or generic (null.Null[T])
+	NullType template.NullType `json:"null_type"`
+}
+
+// SQLBuilder is sql builder files generation configuration
+type SQLBuilder struct {
+	Skip bool   `json:"skip"`
+	Path string `json:"path"`
+	// Routines enables generation of database function and stored procedure sql builder files
+	Routines bool `json:"routines"`
+}
+
+// TypeOverride replaces model field Go type for all the columns of database type DBType
+type TypeOverride struct {
+	DBType string `json:"db_type"`
+	GoType string `json:"go_type"`
+	// Import is Go type package import path, if Go type is not builtin type
+	Import string `json:"import"`
+}
+
+// ColumnOverride customizes model fields for columns matching Table and Column patterns
+type ColumnOverride struct {
+	Table  string `json:"table"`
+	Column string `json:"column"`
+
+	GoType string `json:"go_type"`
+	Import string `json:"import"`
+	// Name is new model field name
+	Name string `json:"name"`
+	// Tags is a list of additional struct tags, for instance `validate:"required"`
+	Tags []string `json:"tags"`
+}
+
+// Load loads configuration from file. File format is detected from file extension (.yaml, .yml or .json).
+func Load(filePath string) (Config, error) {
+	data, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return Config{}, err
+	}
+
+	config, err := decode(data, filepath.Ext(filePath))
+	if err != nil {
+		return Config{}, fmt.Errorf("failed to load config file %s: %w", filePath, err)
+	}
+
+	return config, nil
+}
+
+func decode(data []byte, extension string) (Config, error) {
+	var jsonData []byte
+	var err error
+
+	switch strings.ToLower(extension) {
+	case ".json":
+		jsonData = data
+	case ".yaml", ".yml":
+		var values map[string]interface{}
+
+		if err = yaml.Unmarshal(data, &values); err != nil {
+			return Config{}, err
+		}
+
+		jsonData, err = json.Marshal(values)
+	default:
+		return Config{}, fmt.Errorf("unsupported config file format '%s', expected .yaml, .yml or .json", extension)
+	}
+
+	if err != nil {
+		return Config{}, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.DisallowUnknownFields()
+
+	var config Config
+
+	if err := decoder.Decode(&config); err != nil {
+		return Config{}, err
+	}
+
+	if err := config.Filters().Validate(); err != nil {
+		return Config{}, err
+	}
+
+	switch config.Model.NullType {
+	case "", template.NullPointer, template.NullSQL, template.NullGeneric:
+	default:
+		return Config{}, fmt.Errorf("unsupported model null_type '%s', expected pointer, sql or generic", config.Model.NullType)
+	}
+
+	return config, nil
+}
+
+// Template returns generator template for the dialect, customized with configuration
+func (c Config) Template(dialect jet.Dialect) template.Template {
+	return template.Default(dialect).
+		UseFilters(c.Filters()).
+		UseSchema(func(schemaMetaData metadata.Schema) template.Schema {
+			return template.DefaultSchema(schemaMetaData).
+				UseModel(c.modelTemplate()).
+				UseSQLBuilder(c.sqlBuilderTemplate())
+		})
+}
+
+// Filters returns schema metadata filters
+func (c Config) Filters() metadata.Filters {
+	return metadata.Filters{
+		Tables:  c.Tables,
+		Views:   c.Views,
+		Enums:   c.Enums,
+		Columns: c.ColumnFilter,
+	}
+}
+
+func (c Config) modelTemplate() template.Model {
+	model := template.DefaultModel().
+		UseTable(func(table metadata.Table) template.TableModel {
+			return template.DefaultTableModel(table).UseField(c.tableModelField(table))
+		}).
+		UseView(func(view metadata.Table) template.ViewModel {
+			return template.DefaultViewModel(view).UseField(c.tableModelField(view))
+		})
+
+	model.Skip = c.Model.Skip
+
+	if c.Model.Path != "" {
+		model = model.UsePath(c.Model.Path)
+	}
+
+	return model
+}
+
+func (c Config) tableModelField(table metadata.Table) func(column metadata.Column) template.TableModelField {
+	return func(column metadata.Column) template.TableModelField {
+		field := template.TableModelFieldWithNullType(c.nullType())(column)
+
+		for _, typeOverride := range c.Types {
+			if column.DataType.Kind != metadata.ArrayType && strings.EqualFold(typeOverride.DBType, column.DataType.Name) {
+				field = field.UseType(c.overrideType(column, typeOverride.GoType, typeOverride.Import))
+			}
+		}
+
+		for _, tag := range c.Model.Tags {
+			field = field.UseTags(fmt.Sprintf(`%s:"%s"`, tag, column.Name))
+		}
+
+		for _, columnOverride := range c.Columns {
+			if !columnOverride.matches(table, column) {
+				continue
+			}
+
+			if columnOverride.GoType != "" {
+				field = field.UseType(c.overrideType(column, columnOverride.GoType, columnOverride.Import))
+			}
+
+			if columnOverride.Name != "" {
+				field = field.UseName(columnOverride.Name)
+			}
+
+			field = field.UseTags(columnOverride.Tags...)
+		}
+
+		return field
+	}
+}
+
+func (o ColumnOverride) matches(table metadata.Table, column metadata.Column) bool {
+	return (o.Table == "" || metadata.MatchPattern(o.Table, table.Name)) && metadata.MatchPattern(o.Column, column.Name)
+}
+
+func (c Config) nullType() template.NullType {
+	if c.Model.NullType == "" {
+		return template.NullPointer
+	}
+
+	return c.Model.NullType
+}
+
+// overrideType returns Go type for the column. Nullable column type is wrapped into configured null type, unless type
+// is already a pointer.
+func (c Config) overrideType(column metadata.Column, goType, importPath string) template.Type {
+	overrideType := template.Type{
+		ImportPath: importPath,
+		Name:       goType,
+	}
+
+	if column.IsNullable && !strings.HasPrefix(goType, "*") {
+		return template.NullableType(overrideType, c.nullType())
+	}
+
+	return overrideType
+}
+
+func (c Config) sqlBuilderTemplate() template.SQLBuilder {
+	sqlBuilder := template.DefaultSQLBuilder()
+
+	sqlBuilder.Skip = c.SQLBuilder.Skip
+
+	if c.SQLBuilder.Path != "" {
+		sqlBuilder = sqlBuilder.UsePath(c.SQLBuilder.Path)
+	}
+
+	if c.SQLBuilder.Routines {
+		sqlBuilder = sqlBuilder.UseRoutine(template.DefaultRoutineSQLBuilder)
+	}
+
+	return sqlBuilder
+}
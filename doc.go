@@ -82,6 +82,26 @@ Now we can run the statement and store the result into desired destination:
 
 	err := stmt.Query(db, &dest)
 
+Or, using generic query functions, with the destination type checked at compile time:
+
+	type FilmActors struct {
+		model.Film
+
+		Actors []model.Actor
+	}
+
+	films, err := jet.QueryAll[FilmActors](ctx, db, stmt)
+
+Result columns without destination field, and destination fields without result column, are ignored by default.
+Strict mapping mode, set per call or in the context with qrm.WithStrictMode, reports them as errors:
+
+	films, err := jet.QueryAll[FilmActors](ctx, db, stmt, qrm.Strict)
+
+For ad-hoc queries, rows can be mapped into maps, with column aliases as keys, or into nested maps with table and
+column names as keys:
+
+	rows, err := jet.QueryAll[map[string]map[string]interface{}](ctx, db, stmt)
+
 We can print a statement to see SQL query and arguments sent to postgres server:
 
 	fmt.Println(stmt.Sql())
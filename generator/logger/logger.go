@@ -0,0 +1,78 @@
+// Package logger contains the generator progress and warning reporting. Logger is passed to the generator inside the
+// context.Context, and if the context does not contain a logger, messages are printed to standard output.
+package logger
+
+import (
+	"context"
+	"fmt"
+)
+
+// Level is the level of the generator log message
+type Level int
+
+// Log message levels
+const (
+	// Info level messages report generator progress
+	Info Level = iota
+	// Warning level messages report unsupported database objects skipped or mapped to a default type
+	Warning
+)
+
+// String returns level name
+func (l Level) String() string {
+	switch l {
+	case Info:
+		return "info"
+	case Warning:
+		return "warning"
+	default:
+		return fmt.Sprintf("level(%d)", int(l))
+	}
+}
+
+// Logger receives generator log messages
+type Logger interface {
+	Log(level Level, message string)
+}
+
+// Func is an adapter to allow the use of ordinary function as a Logger
+type Func func(level Level, message string)
+
+// Log calls f(level, message)
+func (f Func) Log(level Level, message string) {
+	f(level, message)
+}
+
+// Stdout is a logger printing each message in a new line of the standard output
+var Stdout Logger = Func(func(level Level, message string) {
+	fmt.Println(message)
+})
+
+// Discard is a logger that ignores all the messages
+var Discard Logger = Func(func(level Level, message string) {})
+
+type contextKey struct{}
+
+// WithLogger returns a copy of ctx that carries logger
+func WithLogger(ctx context.Context, logger Logger) context.Context {
+	return context.WithValue(ctx, contextKey{}, logger)
+}
+
+// FromContext returns logger carried by ctx, or Stdout if ctx does not carry a logger
+func FromContext(ctx context.Context) Logger {
+	if logger, ok := ctx.Value(contextKey{}).(Logger); ok && logger != nil {
+		return logger
+	}
+
+	return Stdout
+}
+
+// Infof formats and logs info message to the ctx logger
+func Infof(ctx context.Context, format string, args ...interface{}) {
+	FromContext(ctx).Log(Info, fmt.Sprintf(format, args...))
+}
+
+// Warnf formats and logs warning message to the ctx logger
+func Warnf(ctx context.Context, format string, args ...interface{}) {
+	FromContext(ctx).Log(Warning, fmt.Sprintf(format, args...))
+}
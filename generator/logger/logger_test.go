@@ -0,0 +1,26 @@
+package logger
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFromContext(t *testing.T) {
+	require.NotNil(t, FromContext(context.Background()))
+
+	var messages []string
+	ctx := WithLogger(context.Background(), Func(func(level Level, message string) {
+		messages = append(messages, level.String()+": "+message)
+	}))
+
+	Infof(ctx, "Generating %s model files...", "table")
+	Warnf(ctx, "- [Repository] Table '%s' does not have a primary key, skipping.", "item")
+	Warnf(WithLogger(ctx, Discard), "discarded")
+
+	require.Equal(t, []string{
+		"info: Generating table model files...",
+		"warning: - [Repository] Table 'item' does not have a primary key, skipping.",
+	}, messages)
+}
@@ -2,11 +2,14 @@ package metadata
 
 // Column struct
 type Column struct {
-	Name         string
-	IsPrimaryKey bool
-	IsNullable   bool
-	IsGenerated  bool
-	DataType     DataType
+	Name         string   `json:"name"`
+	IsPrimaryKey bool     `json:"isPrimaryKey,omitempty"`
+	IsNullable   bool     `json:"isNullable,omitempty"`
+	IsGenerated  bool     `json:"isGenerated,omitempty"`
+	HasDefault   bool     `json:"hasDefault,omitempty"`
+	DefaultValue string   `json:"defaultValue,omitempty"` // column default expression, empty for identity and auto increment columns
+	DataType     DataType `json:"dataType"`
+	Comment      string   `json:"comment,omitempty"`
 }
 
 // DataTypeKind is database type kind(base, enum, user-defined, array)
@@ -22,7 +25,8 @@ const (
 
 // DataType contains information about column data type
 type DataType struct {
-	Name       string
-	Kind       DataTypeKind
-	IsUnsigned bool
+	Name       string       `json:"name"`
+	Kind       DataTypeKind `json:"kind"`
+	IsUnsigned bool         `json:"isUnsigned,omitempty"`
+	Dimensions int          `json:"dimensions,omitempty"` // number of array dimensions
 }
@@ -1,8 +1,12 @@
 package metadata
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
+
+	"github.com/go-jet/jet/v2/generator/logger"
+	"github.com/go-jet/jet/v2/internal/utils/throw"
 )
 
 // TableType is type of database table(view or base)
@@ -20,17 +24,86 @@ type DialectQuerySet interface {
 	GetEnumsMetaData(db *sql.DB, schemaName string) []Enum
 }
 
-// GetSchema retrieves Schema information from database
-func GetSchema(db *sql.DB, querySet DialectQuerySet, schemaName string) Schema {
-	ret := Schema{
-		Name:           schemaName,
-		TablesMetaData: querySet.GetTablesMetaData(db, schemaName, BaseTable),
-		ViewsMetaData:  querySet.GetTablesMetaData(db, schemaName, ViewTable),
-		EnumsMetaData:  querySet.GetEnumsMetaData(db, schemaName),
+// SchemaQuerySet is set of methods necessary to retrieve filtered dialect meta data information, with the context
+// used for the database queries
+type SchemaQuerySet interface {
+	// GetTablesMetaData returns metadata of the tables that match tableFilter, and of the views that match viewFilter.
+	// Columns and foreign keys are retrieved with a single query each, and only for the matching tables and views.
+	GetTablesMetaData(ctx context.Context, db *sql.DB, schemaName string, tableFilter, viewFilter Filter) (tables, views []Table, err error)
+	GetEnumsMetaData(ctx context.Context, db *sql.DB, schemaName string) ([]Enum, error)
+	GetRoutinesMetaData(ctx context.Context, db *sql.DB, schemaName string) ([]Routine, error)
+}
+
+// GetSchema retrieves Schema information from database. Filters, if set, are applied the same way as by
+// GetSchemaContext. It panics on invalid filter pattern.
+func GetSchema(db *sql.DB, querySet DialectQuerySet, schemaName string, filters ...Filters) Schema {
+	var schemaFilters Filters
+
+	if len(filters) > 0 {
+		schemaFilters = filters[0]
 	}
 
-	fmt.Println("	FOUND", len(ret.TablesMetaData), "table(s),", len(ret.ViewsMetaData), "view(s),",
-		len(ret.EnumsMetaData), "enum(s)")
+	schema, err := GetSchemaContext(context.Background(), db, dialectQuerySetAdapter{querySet}, schemaName, schemaFilters)
+	throw.OnError(err)
+
+	return schema
+}
+
+// dialectQuerySetAdapter adapts DialectQuerySet to SchemaQuerySet. Dialect query set retrieves all the tables
+// and views of the schema, so they are filtered after retrieval.
+type dialectQuerySetAdapter struct {
+	querySet DialectQuerySet
+}
+
+func (a dialectQuerySetAdapter) GetTablesMetaData(ctx context.Context, db *sql.DB, schemaName string, tableFilter, viewFilter Filter) (tables, views []Table, err error) {
+	tables = FilterTables(a.querySet.GetTablesMetaData(db, schemaName, BaseTable), tableFilter)
+	views = FilterTables(a.querySet.GetTablesMetaData(db, schemaName, ViewTable), viewFilter)
+
+	return tables, views, nil
+}
+
+func (a dialectQuerySetAdapter) GetEnumsMetaData(ctx context.Context, db *sql.DB, schemaName string) ([]Enum, error) {
+	return a.querySet.GetEnumsMetaData(db, schemaName), nil
+}
+
+func (a dialectQuerySetAdapter) GetRoutinesMetaData(ctx context.Context, db *sql.DB, schemaName string) ([]Routine, error) {
+	return nil, nil
+}
+
+// GetSchemaContext retrieves Schema information from database, with the context used for the database queries and
+// the progress logging. Tables, views, enums and columns not matching filters are left out, and columns of filtered
+// out tables and views are not retrieved. Any error, including invalid filter pattern and context cancellation, is
+// returned wrapped.
+func GetSchemaContext(ctx context.Context, db *sql.DB, querySet SchemaQuerySet, schemaName string, filters Filters) (Schema, error) {
+	if err := filters.Validate(); err != nil {
+		return Schema{}, err
+	}
+
+	tables, views, err := querySet.GetTablesMetaData(ctx, db, schemaName, filters.Tables, filters.Views)
+	if err != nil {
+		return Schema{}, fmt.Errorf("failed to retrieve tables metadata: %w", err)
+	}
+
+	enums, err := querySet.GetEnumsMetaData(ctx, db, schemaName)
+	if err != nil {
+		return Schema{}, fmt.Errorf("failed to retrieve enums metadata: %w", err)
+	}
+
+	routines, err := querySet.GetRoutinesMetaData(ctx, db, schemaName)
+	if err != nil {
+		return Schema{}, fmt.Errorf("failed to retrieve routines metadata: %w", err)
+	}
+
+	schema := filters.Apply(Schema{
+		Name:             schemaName,
+		TablesMetaData:   tables,
+		ViewsMetaData:    views,
+		EnumsMetaData:    enums,
+		RoutinesMetaData: routines,
+	})
+
+	logger.Infof(ctx, "	FOUND %d table(s), %d view(s), %d enum(s), %d routine(s)", len(schema.TablesMetaData),
+		len(schema.ViewsMetaData), len(schema.EnumsMetaData), len(schema.RoutinesMetaData))
 
-	return ret
+	return schema, nil
 }
@@ -0,0 +1,197 @@
+package metadata
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+)
+
+// Filter is a list of include and exclude patterns for database object names. Pattern is either case-insensitive
+// name or path.Match glob pattern (for instance audit_*), or case-insensitive regular expression enclosed in slashes
+// (for instance /^log_\d+$/). If include list is empty all the names are included, unless they are excluded.
+type Filter struct {
+	Include []string `json:"include,omitempty"`
+	Exclude []string `json:"exclude,omitempty"`
+}
+
+// Match returns true if name passes the filter
+func (f Filter) Match(name string) bool {
+	if len(f.Include) > 0 && !matchAny(f.Include, name) {
+		return false
+	}
+
+	return !matchAny(f.Exclude, name)
+}
+
+// IsEmpty returns true if filter does not contain any pattern
+func (f Filter) IsEmpty() bool {
+	return len(f.Include) == 0 && len(f.Exclude) == 0
+}
+
+// Validate returns an error if any of the filter regular expressions or glob patterns is invalid
+func (f Filter) Validate() error {
+	for _, patterns := range [][]string{f.Include, f.Exclude} {
+		for _, pattern := range patterns {
+			if err := validatePattern(pattern); err != nil {
+				return fmt.Errorf("invalid filter pattern %s: %w", pattern, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func validatePattern(pattern string) error {
+	if expression, ok := regexpPattern(pattern); ok {
+		_, err := regexp.Compile(expression)
+		return err
+	}
+
+	_, err := path.Match(pattern, "")
+
+	return err
+}
+
+// FilterTables returns tables with names matching the filter
+func FilterTables(tables []Table, filter Filter) []Table {
+	var ret []Table
+
+	for _, table := range tables {
+		if filter.Match(table.Name) {
+			ret = append(ret, table)
+		}
+	}
+
+	return ret
+}
+
+// Filters are schema metadata filters, applied while schema metadata is retrieved from database, so that columns
+// and foreign keys of filtered out tables and views are never retrieved.
+type Filters struct {
+	Tables Filter
+	Views  Filter
+	Enums  Filter
+	// Columns filter applies to table and view columns. Regular expressions and glob patterns containing a dot
+	// (for instance audit_*.created_at) are matched against table.column qualified name, other patterns are
+	// matched against column name only.
+	Columns Filter
+}
+
+// Validate returns an error if any of the filters regular expressions or glob patterns is invalid
+func (f Filters) Validate() error {
+	for _, filter := range []Filter{f.Tables, f.Views, f.Enums, f.Columns} {
+		if err := filter.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Apply returns schema with filtered out tables, views, enums and columns removed. It is used to filter schema
+// metadata that was not retrieved using GetSchema, for instance metadata loaded from snapshot file.
+func (f Filters) Apply(schema Schema) Schema {
+	var enums []Enum
+
+	for _, enum := range schema.EnumsMetaData {
+		if f.Enums.Match(enum.Name) {
+			enums = append(enums, enum)
+		}
+	}
+
+	schema.TablesMetaData = f.filterTables(schema.TablesMetaData, f.Tables)
+	schema.ViewsMetaData = f.filterTables(schema.ViewsMetaData, f.Views)
+	schema.EnumsMetaData = enums
+
+	return schema
+}
+
+func (f Filters) filterTables(tables []Table, tableFilter Filter) []Table {
+	tables = FilterTables(tables, tableFilter)
+
+	for i := range tables {
+		tables[i] = f.filterColumns(tables[i])
+	}
+
+	return tables
+}
+
+// filterColumns returns table with filtered out columns removed
+func (f Filters) filterColumns(table Table) Table {
+	if f.Columns.IsEmpty() {
+		return table
+	}
+
+	var columns []Column
+
+	for _, column := range table.Columns {
+		if f.matchColumn(table.Name, column.Name) {
+			columns = append(columns, column)
+		}
+	}
+
+	table.Columns = columns
+
+	return table
+}
+
+func (f Filters) matchColumn(tableName, columnName string) bool {
+	matchColumn := func(pattern string) bool {
+		if _, ok := regexpPattern(pattern); ok || strings.Contains(pattern, ".") {
+			return MatchPattern(pattern, tableName+"."+columnName)
+		}
+
+		return MatchPattern(pattern, columnName)
+	}
+
+	if len(f.Columns.Include) > 0 && !anyPattern(f.Columns.Include, matchColumn) {
+		return false
+	}
+
+	return !anyPattern(f.Columns.Exclude, matchColumn)
+}
+
+// MatchPattern returns true if name matches filter pattern. Pattern is case-insensitive name or path.Match glob
+// pattern, or case-insensitive regular expression enclosed in slashes. Invalid pattern, rejected by
+// Filter.Validate, does not match any name.
+func MatchPattern(pattern, name string) bool {
+	if expression, ok := regexpPattern(pattern); ok {
+		matched, _ := regexp.MatchString("(?i)"+expression, name)
+		return matched
+	}
+
+	pattern, name = strings.ToLower(pattern), strings.ToLower(name)
+
+	if pattern == name {
+		return true
+	}
+
+	matched, err := path.Match(pattern, name)
+
+	return err == nil && matched
+}
+
+func regexpPattern(pattern string) (string, bool) {
+	if len(pattern) > 2 && strings.HasPrefix(pattern, "/") && strings.HasSuffix(pattern, "/") {
+		return pattern[1 : len(pattern)-1], true
+	}
+
+	return "", false
+}
+
+func matchAny(patterns []string, name string) bool {
+	return anyPattern(patterns, func(pattern string) bool {
+		return MatchPattern(pattern, name)
+	})
+}
+
+func anyPattern(patterns []string, match func(pattern string) bool) bool {
+	for _, pattern := range patterns {
+		if match(pattern) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,161 @@
+package metadata
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchPattern(t *testing.T) {
+	require.True(t, MatchPattern("actor", "Actor"))
+	require.True(t, MatchPattern("audit_*", "AUDIT_LOG"))
+	require.True(t, MatchPattern("payment_p2020_0?", "payment_p2020_01"))
+	require.False(t, MatchPattern("audit_*", "actor"))
+
+	require.True(t, MatchPattern(`/^payment_p\d{4}_\d{2}$/`, "payment_p2020_01"))
+	require.True(t, MatchPattern(`/^STAGING_/`, "staging_film"))
+	require.False(t, MatchPattern(`/^payment_p\d{4}_\d{2}$/`, "payment"))
+	require.False(t, MatchPattern("//", "actor")) // empty regular expression is not a regular expression pattern
+	require.False(t, MatchPattern("audit_[", "audit_log"))
+}
+
+func TestFilter_Validate(t *testing.T) {
+	require.NoError(t, Filter{Include: []string{"actor", "/^film/"}, Exclude: []string{"audit_[0-9]*"}}.Validate())
+	require.EqualError(t, Filter{Exclude: []string{"actor", "audit_["}}.Validate(),
+		"invalid filter pattern audit_[: syntax error in pattern")
+	require.EqualError(t, Filters{Columns: Filter{Include: []string{"film.[a-"}}}.Validate(),
+		"invalid filter pattern film.[a-: syntax error in pattern")
+	require.EqualError(t, Filters{Enums: Filter{Exclude: []string{"/+/"}}}.Validate(),
+		"invalid filter pattern /+/: error parsing regexp: missing argument to repetition operator: `+`")
+}
+
+func TestFilters_Apply(t *testing.T) {
+	filters := Filters{
+		Tables:  Filter{Exclude: []string{"audit_*", `/^payment_p\d+$/`}},
+		Views:   Filter{Include: []string{"*_list"}},
+		Enums:   Filter{Exclude: []string{"mood"}},
+		Columns: Filter{Exclude: []string{"last_update", "/^film\\.rental_/", "actor.first_*"}},
+	}
+
+	schema := filters.Apply(Schema{
+		Name: "dvds",
+		TablesMetaData: []Table{
+			{Name: "actor", Columns: []Column{{Name: "actor_id"}, {Name: "first_name"}, {Name: "last_update"}}},
+			{Name: "audit_log"},
+			{Name: "film", Columns: []Column{{Name: "film_id"}, {Name: "rental_rate"}, {Name: "first_name"}}},
+			{Name: "payment_p2020"},
+		},
+		ViewsMetaData: []Table{{Name: "actor_info"}, {Name: "film_list"}},
+		EnumsMetaData: []Enum{{Name: "mood"}, {Name: "mpaa_rating"}},
+	})
+
+	require.Equal(t, Schema{
+		Name: "dvds",
+		TablesMetaData: []Table{
+			{Name: "actor", Columns: []Column{{Name: "actor_id"}}},
+			{Name: "film", Columns: []Column{{Name: "film_id"}, {Name: "first_name"}}},
+		},
+		ViewsMetaData: []Table{{Name: "film_list"}},
+		EnumsMetaData: []Enum{{Name: "mpaa_rating"}},
+	}, schema)
+
+	includeColumns := Filters{Columns: Filter{Include: []string{"*_id"}}}
+	require.Equal(t, []Column{{Name: "actor_id"}},
+		includeColumns.filterColumns(Table{Name: "actor", Columns: []Column{{Name: "actor_id"}, {Name: "name"}}}).Columns)
+}
+
+type testQuerySet struct {
+	tables         []Table
+	columnsQueried []string
+	calls          int
+	enumsErr       error
+}
+
+func (q *testQuerySet) GetTablesMetaData(ctx context.Context, db *sql.DB, schemaName string, tableFilter, viewFilter Filter) (tables, views []Table, err error) {
+	q.calls++
+
+	tables = FilterTables(q.tables, tableFilter)
+	q.columnsQueried = TableNames(tables)
+
+	return tables, nil, nil
+}
+
+func (q *testQuerySet) GetEnumsMetaData(ctx context.Context, db *sql.DB, schemaName string) ([]Enum, error) {
+	if q.enumsErr != nil {
+		return nil, q.enumsErr
+	}
+
+	return []Enum{{Name: "mood"}, {Name: "mpaa_rating"}}, nil
+}
+
+func (q *testQuerySet) GetRoutinesMetaData(ctx context.Context, db *sql.DB, schemaName string) ([]Routine, error) {
+	return nil, nil
+}
+
+func TestGetSchema_Filters(t *testing.T) {
+	querySet := &testQuerySet{
+		tables: []Table{
+			{Name: "actor", Columns: []Column{{Name: "actor_id"}, {Name: "last_update"}}},
+			{Name: "audit_log", Columns: []Column{{Name: "id"}}},
+		},
+	}
+
+	schema, err := GetSchemaContext(context.Background(), nil, querySet, "dvds", Filters{
+		Tables:  Filter{Exclude: []string{"audit_*"}},
+		Enums:   Filter{Include: []string{"/^mpaa/"}},
+		Columns: Filter{Exclude: []string{"last_update"}},
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, []string{"actor"}, querySet.columnsQueried)
+	require.Equal(t, 1, querySet.calls)
+	require.Equal(t, Schema{
+		Name:           "dvds",
+		TablesMetaData: []Table{{Name: "actor", Columns: []Column{{Name: "actor_id"}}}},
+		EnumsMetaData:  []Enum{{Name: "mpaa_rating"}},
+	}, schema)
+
+	_, err = GetSchemaContext(context.Background(), nil, querySet, "dvds", Filters{Views: Filter{Include: []string{"/(/"}}})
+	require.EqualError(t, err, "invalid filter pattern /(/: error parsing regexp: missing closing ): `(`")
+
+	_, err = GetSchemaContext(context.Background(), nil, querySet, "dvds", Filters{Tables: Filter{Exclude: []string{"audit_["}}})
+	require.EqualError(t, err, "invalid filter pattern audit_[: syntax error in pattern")
+
+	querySet.enumsErr = context.Canceled
+	_, err = GetSchemaContext(context.Background(), nil, querySet, "dvds", Filters{})
+	require.EqualError(t, err, "failed to retrieve enums metadata: context canceled")
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+type testDialectQuerySet struct{}
+
+func (q testDialectQuerySet) GetTablesMetaData(db *sql.DB, schemaName string, tableType TableType) []Table {
+	return []Table{{Name: string(tableType)}}
+}
+
+func (q testDialectQuerySet) GetEnumsMetaData(db *sql.DB, schemaName string) []Enum {
+	return []Enum{{Name: "mood"}}
+}
+
+func TestGetSchema(t *testing.T) {
+	require.Equal(t, Schema{
+		Name:           "dvds",
+		TablesMetaData: []Table{{Name: "BASE TABLE"}},
+		ViewsMetaData:  []Table{{Name: "VIEW"}},
+		EnumsMetaData:  []Enum{{Name: "mood"}},
+	}, GetSchema(nil, testDialectQuerySet{}, "dvds"))
+
+	require.Equal(t, Schema{
+		Name:           "dvds",
+		TablesMetaData: []Table{{Name: "BASE TABLE"}},
+	}, GetSchema(nil, testDialectQuerySet{}, "dvds", Filters{
+		Views: Filter{Exclude: []string{"VIEW"}},
+		Enums: Filter{Include: []string{"mpaa_*"}},
+	}))
+
+	require.Panics(t, func() {
+		GetSchema(nil, testDialectQuerySet{}, "dvds", Filters{Tables: Filter{Include: []string{"/(/"}}})
+	})
+}
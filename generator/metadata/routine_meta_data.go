@@ -0,0 +1,63 @@
+package metadata
+
+// RoutineType is type of database routine(function or procedure)
+type RoutineType string
+
+// Database routine types
+const (
+	FunctionRoutine  RoutineType = "FUNCTION"
+	ProcedureRoutine RoutineType = "PROCEDURE"
+)
+
+// Routine metadata struct, describes database function or stored procedure
+type Routine struct {
+	Name       string             `json:"name"`
+	Type       RoutineType        `json:"type"`
+	Parameters []RoutineParameter `json:"parameters,omitempty"`
+	// ReturnType is return type of scalar function
+	ReturnType DataType `json:"returnType"`
+	// ResultColumns is list of result columns of set-returning or composite result function
+	ResultColumns []Column `json:"resultColumns,omitempty"`
+}
+
+// IsProcedure returns true if routine is stored procedure
+func (r Routine) IsProcedure() bool {
+	return r.Type == ProcedureRoutine
+}
+
+// IsTableFunction returns true if routine is function returning set of rows or composite value
+func (r Routine) IsTableFunction() bool {
+	return r.Type == FunctionRoutine && len(r.ResultColumns) > 0
+}
+
+// InputParameters returns list of routine input(IN and INOUT) parameters
+func (r Routine) InputParameters() []RoutineParameter {
+	var ret []RoutineParameter
+
+	for _, parameter := range r.Parameters {
+		if parameter.Mode == OutParameter {
+			continue
+		}
+
+		ret = append(ret, parameter)
+	}
+
+	return ret
+}
+
+// ParameterMode is routine parameter mode(IN, OUT or INOUT)
+type ParameterMode string
+
+// Routine parameter modes
+const (
+	InParameter    ParameterMode = "IN"
+	OutParameter   ParameterMode = "OUT"
+	InOutParameter ParameterMode = "INOUT"
+)
+
+// RoutineParameter metadata struct
+type RoutineParameter struct {
+	Name     string        `json:"name"`
+	Mode     ParameterMode `json:"mode"`
+	DataType DataType      `json:"dataType"`
+}
@@ -2,13 +2,15 @@ package metadata
 
 // Schema struct
 type Schema struct {
-	Name           string
-	TablesMetaData []Table
-	ViewsMetaData  []Table
-	EnumsMetaData  []Enum
+	Name             string    `json:"name"`
+	TablesMetaData   []Table   `json:"tables,omitempty"`
+	ViewsMetaData    []Table   `json:"views,omitempty"`
+	EnumsMetaData    []Enum    `json:"enums,omitempty"`
+	RoutinesMetaData []Routine `json:"routines,omitempty"`
 }
 
-// IsEmpty returns true if schema info does not contain any table, views or enums metadata
+// IsEmpty returns true if schema info does not contain any table, views, enums or routines metadata
 func (s Schema) IsEmpty() bool {
-	return len(s.TablesMetaData) == 0 && len(s.ViewsMetaData) == 0 && len(s.EnumsMetaData) == 0
+	return len(s.TablesMetaData) == 0 && len(s.ViewsMetaData) == 0 && len(s.EnumsMetaData) == 0 &&
+		len(s.RoutinesMetaData) == 0
 }
@@ -0,0 +1,64 @@
+package metadata
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+
+	"github.com/go-jet/jet/v2/internal/utils"
+)
+
+// Snapshot is database metadata snapshot. It contains all the information needed to generate
+// jet files without a database connection.
+type Snapshot struct {
+	// Dialect is the name of the database dialect(postgres, mysql or sqlite)
+	Dialect string `json:"dialect"`
+	// Database is the name of the database, if generated files directory depends on it
+	Database string `json:"database,omitempty"`
+	Schema   Schema `json:"schema"`
+}
+
+// SaveSnapshot saves metadata snapshot as indented JSON file, creating destination directory if needed
+func SaveSnapshot(filePath string, snapshot Snapshot) error {
+	data, err := json.MarshalIndent(snapshot, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode metadata snapshot: %w", err)
+	}
+
+	if err := utils.EnsureDirPath(filepath.Dir(filePath)); err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(filePath, append(data, '\n'), 0644)
+}
+
+// LoadSnapshot loads metadata snapshot from JSON file
+func LoadSnapshot(filePath string) (Snapshot, error) {
+	data, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	var snapshot Snapshot
+
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return Snapshot{}, fmt.Errorf("failed to decode metadata snapshot %s: %w", filePath, err)
+	}
+
+	return snapshot, nil
+}
+
+// LoadDialectSnapshot loads metadata snapshot from JSON file, and checks that snapshot is for the dialect
+func LoadDialectSnapshot(filePath, dialect string) (Snapshot, error) {
+	snapshot, err := LoadSnapshot(filePath)
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	if snapshot.Dialect != dialect {
+		return Snapshot{}, fmt.Errorf("metadata snapshot %s is for %s dialect, not %s", filePath, snapshot.Dialect, dialect)
+	}
+
+	return snapshot, nil
+}
@@ -0,0 +1,52 @@
+package metadata
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnapshotSaveLoad(t *testing.T) {
+	dir, err := ioutil.TempDir("", "jet-snapshot")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	snapshot := Snapshot{
+		Dialect:  "postgres",
+		Database: "jetdb",
+		Schema: Schema{
+			Name: "dvds",
+			TablesMetaData: []Table{
+				{
+					Name: "film",
+					Columns: []Column{
+						{Name: "film_id", IsPrimaryKey: true, HasDefault: true, DataType: DataType{Name: "integer", Kind: BaseType}},
+						{Name: "language_id", DataType: DataType{Name: "smallint", Kind: BaseType}, Comment: "Film language"},
+					},
+					ForeignKeys: []ForeignKey{
+						{Name: "film_language_id_fkey", Columns: []string{"language_id"}, ReferencedSchema: "dvds",
+							ReferencedTable: "language", ReferencedColumns: []string{"language_id"}},
+					},
+				},
+			},
+			EnumsMetaData: []Enum{{Name: "mpaa_rating", Values: []string{"G", "PG"}}},
+		},
+	}
+
+	filePath := filepath.Join(dir, "snapshot", "jetdb.json")
+
+	require.NoError(t, SaveSnapshot(filePath, snapshot))
+
+	loaded, err := LoadDialectSnapshot(filePath, "postgres")
+	require.NoError(t, err)
+	require.Equal(t, loaded, snapshot)
+
+	_, err = LoadDialectSnapshot(filePath, "mysql")
+	require.EqualError(t, err, "metadata snapshot "+filePath+" is for postgres dialect, not mysql")
+
+	_, err = LoadSnapshot(filepath.Join(dir, "missing.json"))
+	require.Error(t, err)
+}
@@ -2,8 +2,10 @@ package metadata
 
 // Table metadata struct
 type Table struct {
-	Name    string
-	Columns []Column
+	Name        string       `json:"name"`
+	Columns     []Column     `json:"columns"`
+	ForeignKeys []ForeignKey `json:"foreignKeys,omitempty"`
+	Comment     string       `json:"comment,omitempty"`
 }
 
 // MutableColumns returns list of mutable columns for table
@@ -20,3 +22,40 @@ func (t Table) MutableColumns() []Column {
 
 	return ret
 }
+
+// DefaultableColumns returns list of table columns with default value, excluding generated columns
+func (t Table) DefaultableColumns() []Column {
+	var ret []Column
+
+	for _, column := range t.Columns {
+		if !column.HasDefault || column.IsGenerated {
+			continue
+		}
+
+		ret = append(ret, column)
+	}
+
+	return ret
+}
+
+// Column returns table column metadata with the column name
+func (t Table) Column(name string) (Column, bool) {
+	for _, column := range t.Columns {
+		if column.Name == name {
+			return column, true
+		}
+	}
+
+	return Column{}, false
+}
+
+// TableNames returns names of the tables
+func TableNames(tables []Table) []string {
+	var ret []string
+
+	for _, table := range tables {
+		ret = append(ret, table.Name)
+	}
+
+	return ret
+}
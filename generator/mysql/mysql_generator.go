@@ -1,14 +1,16 @@
 package mysql
 
 import (
+	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"strings"
 
+	"github.com/go-jet/jet/v2/generator/logger"
 	"github.com/go-jet/jet/v2/generator/metadata"
 	"github.com/go-jet/jet/v2/generator/template"
 	"github.com/go-jet/jet/v2/internal/utils"
-	"github.com/go-jet/jet/v2/internal/utils/throw"
 	"github.com/go-jet/jet/v2/mysql"
 	mysqldr "github.com/go-sql-driver/mysql"
 )
@@ -24,27 +26,114 @@ type DBConnection struct {
 	DBName string
 }
 
+// DialectName is the name of the dialect in metadata snapshot files
+const DialectName = "mysql"
+
+// Options are generator options used by Run
+type Options struct {
+	// DSN is database connection string. Connection details are used instead, if DSN is not set.
+	DSN string
+	// Connection contains database connection details, used if DSN is not set
+	Connection DBConnection
+	// FromMetadata is metadata snapshot file path. If set, jet files are generated from the snapshot file, without
+	// database connection.
+	FromMetadata string
+	// DumpMetadata is metadata snapshot file path. If set, retrieved database metadata is saved into the snapshot
+	// file, instead of generating jet files. Only template filters are used in that case.
+	DumpMetadata string
+	// DestDir is destination dir for the generated jet files
+	DestDir string
+	// Check, if set, compares jet files at destination dir with the generated files, and returns the difference.
+	// Destination dir is not modified.
+	Check bool
+	// Template is generator template. Default generator template is used, if Template is not set.
+	Template *template.Template
+}
+
 // Generate generates jet files at destination dir from database connection details
-func Generate(destDir string, dbConn DBConnection, generatorTemplate ...template.Template) (err error) {
-	defer utils.ErrorCatch(&err)
+func Generate(destDir string, dbConn DBConnection, templates ...template.Template) (err error) {
+	_, err = Run(context.Background(), Options{
+		Connection: dbConn,
+		DestDir:    destDir,
+		Template:   optionalTemplate(templates),
+	})
+
+	return err
+}
+
+// GenerateDSN opens connection via DSN string and does everything what Generate does.
+func GenerateDSN(dsn, destDir string, templates ...template.Template) (err error) {
+	_, err = Run(context.Background(), Options{
+		DSN:      dsn,
+		DestDir:  destDir,
+		Template: optionalTemplate(templates),
+	})
 
-	connectionString := fmt.Sprintf("%s:%s@tcp(%s:%d)/%s", dbConn.User, dbConn.Password, dbConn.Host, dbConn.Port, dbConn.DBName)
-	if dbConn.Params != "" {
-		connectionString += "?" + dbConn.Params
+	return err
+}
+
+// Run generates jet files, checks generated jet files or dumps database metadata, depending on the options.
+// Context is used for the database queries, and context logger, if set with logger.WithLogger, receives generator
+// progress and warnings. Returned difference is set only if options.Check is set.
+func Run(ctx context.Context, options Options) (template.Diff, error) {
+	if options.DumpMetadata != "" && (options.FromMetadata != "" || options.Check) {
+		return template.Diff{}, errors.New("DumpMetadata option can not be used together with FromMetadata or Check options")
 	}
 
-	db := openConnection(connectionString)
-	defer utils.DBClose(db)
+	generatorTemplate := template.Default(mysql.Dialect)
+	if options.Template != nil {
+		generatorTemplate = *options.Template
+	}
 
-	generate(db, dbConn.DBName, destDir, generatorTemplate...)
+	snapshot, err := options.snapshot(ctx, generatorTemplate.Filters)
+	if err != nil {
+		return template.Diff{}, err
+	}
 
-	return nil
+	if options.DumpMetadata != "" {
+		if err := metadata.SaveSnapshot(options.DumpMetadata, snapshot); err != nil {
+			return template.Diff{}, err
+		}
+
+		logger.Infof(ctx, "Metadata snapshot saved to %s", options.DumpMetadata)
+		return template.Diff{}, nil
+	}
+
+	if options.Check {
+		return template.CheckSchemaContext(ctx, options.DestDir, snapshot.Schema, generatorTemplate)
+	}
+
+	return template.Diff{}, template.ProcessSchemaContext(ctx, options.DestDir, snapshot.Schema, generatorTemplate)
 }
 
-// GenerateDSN opens connection via DSN string and does everything what Generate does.
-func GenerateDSN(dsn, destDir string, templates ...template.Template) (err error) {
-	defer utils.ErrorCatch(&err)
+// snapshot loads metadata snapshot file, or retrieves metadata snapshot from the database
+func (o Options) snapshot(ctx context.Context, filters metadata.Filters) (metadata.Snapshot, error) {
+	if o.FromMetadata != "" {
+		return metadata.LoadDialectSnapshot(o.FromMetadata, DialectName)
+	}
+
+	if o.DSN == "" {
+		return getSnapshot(ctx, o.Connection.connectionString(), o.Connection.DBName, filters)
+	}
+
+	connectionString, dbName, err := parseDSN(o.DSN)
+	if err != nil {
+		return metadata.Snapshot{}, err
+	}
+
+	return getSnapshot(ctx, connectionString, dbName, filters)
+}
+
+func (c DBConnection) connectionString() string {
+	connectionString := fmt.Sprintf("%s:%s@tcp(%s:%d)/%s", c.User, c.Password, c.Host, c.Port, c.DBName)
+	if c.Params != "" {
+		connectionString += "?" + c.Params
+	}
+
+	return connectionString
+}
 
+func parseDSN(dsn string) (connectionString, dbName string, err error) {
 	// Special case for go mysql driver. It does not understand schema,
 	// so we need to trim it before passing to generator
 	// https://github.com/go-sql-driver/mysql#dsn-data-source-name
@@ -54,39 +143,57 @@ func GenerateDSN(dsn, destDir string, templates ...template.Template) (err error
 	}
 
 	cfg, err := mysqldr.ParseDSN(dsn)
-	throw.OnError(err)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to parse MySQL dsn: %w", err)
+	}
 	if cfg.DBName == "" {
-		panic("database name is required")
+		return "", "", errors.New("database name is required")
 	}
 
-	db := openConnection(dsn)
-	defer utils.DBClose(db)
-
-	generate(db, cfg.DBName, destDir, templates...)
-
-	return nil
+	return dsn, cfg.DBName, nil
 }
 
-func openConnection(connectionString string) *sql.DB {
-	fmt.Println("Connecting to MySQL database")
+func openConnection(ctx context.Context, connectionString string) (*sql.DB, error) {
+	logger.Infof(ctx, "Connecting to MySQL database")
+
 	db, err := sql.Open("mysql", connectionString)
-	throw.OnError(err)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open MySQL database connection: %w", err)
+	}
 
-	err = db.Ping()
-	throw.OnError(err)
+	if err := db.PingContext(ctx); err != nil {
+		utils.DBClose(db)
+		return nil, fmt.Errorf("failed to connect to MySQL database: %w", err)
+	}
 
-	return db
+	return db, nil
 }
 
-func generate(db *sql.DB, dbName, destDir string, templates ...template.Template) {
-	fmt.Println("Retrieving database information...")
+func getSnapshot(ctx context.Context, connectionString, dbName string, filters metadata.Filters) (metadata.Snapshot, error) {
+	db, err := openConnection(ctx, connectionString)
+	if err != nil {
+		return metadata.Snapshot{}, err
+	}
+	defer utils.DBClose(db)
+
+	logger.Infof(ctx, "Retrieving database information...")
+
 	// No schemas in MySQL
-	schemaMetaData := metadata.GetSchema(db, &mySqlQuerySet{}, dbName)
+	schemaMetaData, err := metadata.GetSchemaContext(ctx, db, &mySqlQuerySet{}, dbName, filters)
+	if err != nil {
+		return metadata.Snapshot{}, err
+	}
 
-	genTemplate := template.Default(mysql.Dialect)
+	return metadata.Snapshot{
+		Dialect: DialectName,
+		Schema:  schemaMetaData,
+	}, nil
+}
+
+func optionalTemplate(templates []template.Template) *template.Template {
 	if len(templates) > 0 {
-		genTemplate = templates[0]
+		return &templates[0]
 	}
 
-	template.ProcessSchema(destDir, schemaMetaData, genTemplate)
+	return nil
 }
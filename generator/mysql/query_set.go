@@ -3,79 +3,219 @@ package mysql
 import (
 	"context"
 	"database/sql"
+	"fmt"
 	"strings"
 
 	"github.com/go-jet/jet/v2/generator/metadata"
-	"github.com/go-jet/jet/v2/internal/utils/throw"
 	"github.com/go-jet/jet/v2/qrm"
 )
 
 // mySqlQuerySet is dialect query set for MySQL
 type mySqlQuerySet struct{}
 
-func (m mySqlQuerySet) GetTablesMetaData(db *sql.DB, schemaName string, tableType metadata.TableType) []metadata.Table {
+func (m mySqlQuerySet) GetTablesMetaData(ctx context.Context, db *sql.DB, schemaName string, tableFilter, viewFilter metadata.Filter) (tables, views []metadata.Table, err error) {
 	query := `
-SELECT table_name as "table.name"
+SELECT table_name as "table.name",
+	table_type as "tableType",
+	IF(table_type = 'VIEW', '', table_comment) as "table.comment"
 FROM INFORMATION_SCHEMA.tables
-WHERE table_schema = ? and table_type = ?
+WHERE table_schema = ? and table_type IN ('BASE TABLE', 'VIEW')
 ORDER BY table_name;
 `
-	var tables []metadata.Table
+	var tableInfos []struct {
+		TableType string
+		Table     metadata.Table
+	}
+
+	_, err = qrm.Query(ctx, db, query, []interface{}{schemaName}, &tableInfos)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to query tables: %w", err)
+	}
+
+	for _, tableInfo := range tableInfos {
+		switch {
+		case tableInfo.TableType == string(metadata.BaseTable) && tableFilter.Match(tableInfo.Table.Name):
+			tables = append(tables, tableInfo.Table)
+		case tableInfo.TableType == string(metadata.ViewTable) && viewFilter.Match(tableInfo.Table.Name):
+			views = append(views, tableInfo.Table)
+		}
+	}
+
+	if len(tables) == 0 && len(views) == 0 {
+		return nil, nil, nil
+	}
+
+	tableNames := metadata.TableNames(tables)
+
+	columns, err := m.getColumns(ctx, db, schemaName, append(tableNames, metadata.TableNames(views)...))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var foreignKeys map[string][]metadata.ForeignKey
 
-	_, err := qrm.Query(context.Background(), db, query, []interface{}{schemaName, tableType}, &tables)
-	throw.OnError(err)
+	if len(tables) > 0 {
+		foreignKeys, err = m.getForeignKeys(ctx, db, schemaName, tableNames)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
 
 	for i := range tables {
-		tables[i].Columns = m.GetTableColumnsMetaData(db, schemaName, tables[i].Name)
+		tables[i].Columns = columns[tables[i].Name]
+		tables[i].ForeignKeys = foreignKeys[tables[i].Name]
 	}
 
-	return tables
+	for i := range views {
+		views[i].Columns = columns[views[i].Name]
+
+		// view column defaults are inherited from the underlying table columns, and views are rarely insertable
+		for j := range views[i].Columns {
+			views[i].Columns[j].HasDefault = false
+			views[i].Columns[j].DefaultValue = ""
+		}
+	}
+
+	return tables, views, nil
+}
+
+// GetTableColumnsMetaData returns columns of the schema table
+func (m mySqlQuerySet) GetTableColumnsMetaData(ctx context.Context, db *sql.DB, schemaName string, tableName string) ([]metadata.Column, error) {
+	columns, err := m.getColumns(ctx, db, schemaName, []string{tableName})
+
+	return columns[tableName], err
 }
 
-func (m mySqlQuerySet) GetTableColumnsMetaData(db *sql.DB, schemaName string, tableName string) []metadata.Column {
+// getColumns returns columns of the schema tables and views, with tableNames names, grouped by table name
+func (m mySqlQuerySet) getColumns(ctx context.Context, db *sql.DB, schemaName string, tableNames []string) (map[string][]metadata.Column, error) {
 	query := `
-SELECT COLUMN_NAME AS "column.Name", 
-	IS_NULLABLE = "YES" AS "column.IsNullable", 
-	(EXISTS(
-		SELECT 1
-		FROM information_schema.table_constraints t
-			JOIN information_schema.key_column_usage k USING(constraint_name,table_schema,table_name)
-		WHERE table_schema = ? AND table_name = ? AND t.constraint_type='PRIMARY KEY' AND k.column_name = columns.column_name
-	)) AS "column.IsPrimaryKey",
-	IF (COLUMN_TYPE = 'tinyint(1)', 
+SELECT c.TABLE_NAME AS "tableName",
+	c.COLUMN_NAME AS "column.Name", 
+	c.IS_NULLABLE = "YES" AS "column.IsNullable", 
+	pk.COLUMN_NAME IS NOT NULL AS "column.IsPrimaryKey",
+	c.COLUMN_DEFAULT IS NOT NULL OR c.EXTRA LIKE '%auto_increment%' AS "column.HasDefault",
+	COALESCE(c.COLUMN_DEFAULT, '') AS "column.DefaultValue",
+	IF (c.COLUMN_TYPE = 'tinyint(1)', 
 			'boolean', 
-			IF (DATA_TYPE='enum', 
-					CONCAT(TABLE_NAME, '_', COLUMN_NAME), 
-					DATA_TYPE)
+			IF (c.DATA_TYPE='enum', 
+					CONCAT(c.TABLE_NAME, '_', c.COLUMN_NAME), 
+					c.DATA_TYPE)
 	) AS "dataType.Name", 
-	IF (DATA_TYPE = 'enum', 'enum', 'base') AS "dataType.Kind", 
-	COLUMN_TYPE LIKE '%unsigned%' AS "dataType.IsUnsigned"
-FROM information_schema.columns
-WHERE table_schema = ? AND table_name = ?
-ORDER BY ordinal_position;
+	IF (c.DATA_TYPE = 'enum', 'enum', 'base') AS "dataType.Kind", 
+	c.COLUMN_TYPE LIKE '%unsigned%' AS "dataType.IsUnsigned",
+	c.COLUMN_COMMENT AS "column.Comment"
+FROM information_schema.columns AS c
+	LEFT JOIN (
+		SELECT k.TABLE_NAME, k.COLUMN_NAME
+		FROM information_schema.table_constraints t
+			JOIN information_schema.key_column_usage k USING(constraint_name,table_schema,table_name)
+		WHERE t.table_schema = ? AND t.constraint_type='PRIMARY KEY'
+	) AS pk ON pk.TABLE_NAME = c.TABLE_NAME AND pk.COLUMN_NAME = c.COLUMN_NAME
+WHERE c.table_schema = ?
+ORDER BY c.TABLE_NAME, c.ORDINAL_POSITION;
 `
-	var columns []metadata.Column
-	_, err := qrm.Query(context.Background(), db, query, []interface{}{schemaName, tableName, schemaName, tableName}, &columns)
-	throw.OnError(err)
+	var columns []struct {
+		TableName string
+		Column    metadata.Column
+	}
+
+	_, err := qrm.Query(ctx, db, query, []interface{}{schemaName, schemaName}, &columns)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query columns: %w", err)
+	}
+
+	ret := map[string][]metadata.Column{}
+	retrieve := nameSet(tableNames)
+
+	for _, column := range columns {
+		if !retrieve[column.TableName] {
+			continue
+		}
 
-	return columns
+		ret[column.TableName] = append(ret[column.TableName], column.Column)
+	}
+
+	return ret, nil
+}
+
+// nameSet returns set of the names. Columns and foreign keys are queried for the whole schema, with a fixed number
+// of query parameters, and the rows of the tables not in the set are skipped.
+func nameSet(names []string) map[string]bool {
+	ret := map[string]bool{}
+
+	for _, name := range names {
+		ret[name] = true
+	}
+
+	return ret
 }
 
-func (m *mySqlQuerySet) GetEnumsMetaData(db *sql.DB, schemaName string) []metadata.Enum {
+// getForeignKeys returns foreign keys of the schema tables, with tableNames names, grouped by table name
+func (m mySqlQuerySet) getForeignKeys(ctx context.Context, db *sql.DB, schemaName string, tableNames []string) (map[string][]metadata.ForeignKey, error) {
+	query := `
+SELECT k.TABLE_NAME AS "tableName",
+	k.CONSTRAINT_NAME AS "name",
+	k.REFERENCED_TABLE_SCHEMA AS "referencedSchema",
+	k.REFERENCED_TABLE_NAME AS "referencedTable",
+	GROUP_CONCAT(k.COLUMN_NAME ORDER BY k.ORDINAL_POSITION) AS "columns",
+	GROUP_CONCAT(k.REFERENCED_COLUMN_NAME ORDER BY k.ORDINAL_POSITION) AS "referencedColumns"
+FROM information_schema.key_column_usage AS k
+WHERE k.TABLE_SCHEMA = ? AND k.REFERENCED_TABLE_NAME IS NOT NULL
+GROUP BY k.TABLE_NAME, k.CONSTRAINT_NAME, k.REFERENCED_TABLE_SCHEMA, k.REFERENCED_TABLE_NAME
+ORDER BY k.TABLE_NAME, k.CONSTRAINT_NAME;
+`
+	var foreignKeys []struct {
+		TableName         string
+		Name              string
+		ReferencedSchema  string
+		ReferencedTable   string
+		Columns           string
+		ReferencedColumns string
+	}
+
+	_, err := qrm.Query(ctx, db, query, []interface{}{schemaName}, &foreignKeys)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query foreign keys: %w", err)
+	}
+
+	ret := map[string][]metadata.ForeignKey{}
+	retrieve := nameSet(tableNames)
+
+	for _, fk := range foreignKeys {
+		if !retrieve[fk.TableName] {
+			continue
+		}
+
+		ret[fk.TableName] = append(ret[fk.TableName], metadata.ForeignKey{
+			Name:              fk.Name,
+			Columns:           strings.Split(fk.Columns, ","),
+			ReferencedSchema:  fk.ReferencedSchema,
+			ReferencedTable:   fk.ReferencedTable,
+			ReferencedColumns: strings.Split(fk.ReferencedColumns, ","),
+		})
+	}
+
+	return ret, nil
+}
+
+func (m *mySqlQuerySet) GetEnumsMetaData(ctx context.Context, db *sql.DB, schemaName string) ([]metadata.Enum, error) {
 	query := `
 SELECT (CASE c.DATA_TYPE WHEN 'enum' then CONCAT(c.TABLE_NAME, '_', c.COLUMN_NAME) ELSE '' END ) as "name", 
        SUBSTRING(c.COLUMN_TYPE,5) as "values"
 FROM information_schema.columns as c
 	INNER JOIN information_schema.tables  as t on (t.table_schema = c.table_schema AND t.table_name = c.table_name)
-WHERE c.table_schema = ? AND DATA_TYPE = 'enum';
+WHERE c.table_schema = ? AND DATA_TYPE = 'enum'
+ORDER BY c.TABLE_NAME, c.COLUMN_NAME;
 `
 	var queryResult []struct {
 		Name   string
 		Values string
 	}
 
-	_, err := qrm.Query(context.Background(), db, query, []interface{}{schemaName}, &queryResult)
-	throw.OnError(err)
+	_, err := qrm.Query(ctx, db, query, []interface{}{schemaName}, &queryResult)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query enums: %w", err)
+	}
 
 	var ret []metadata.Enum
 
@@ -88,5 +228,76 @@ WHERE c.table_schema = ? AND DATA_TYPE = 'enum';
 		})
 	}
 
-	return ret
+	return ret, nil
+}
+
+func (m *mySqlQuerySet) GetRoutinesMetaData(ctx context.Context, db *sql.DB, schemaName string) ([]metadata.Routine, error) {
+	query := `
+SELECT r.ROUTINE_NAME AS "routine.name",
+	r.ROUTINE_TYPE AS "routine.type",
+	p.PARAMETER_NAME AS "parameter.name",
+	p.PARAMETER_MODE AS "parameter.mode",
+	IF (p.DTD_IDENTIFIER = 'tinyint(1)', 'boolean', p.DATA_TYPE) AS "parameter.dataType",
+	p.DTD_IDENTIFIER LIKE '%unsigned%' AS "parameter.isUnsigned"
+FROM information_schema.routines AS r
+	LEFT JOIN information_schema.parameters AS p 
+		ON (p.SPECIFIC_SCHEMA = r.ROUTINE_SCHEMA AND p.SPECIFIC_NAME = r.SPECIFIC_NAME AND p.ROUTINE_TYPE = r.ROUTINE_TYPE)
+WHERE r.ROUTINE_SCHEMA = ?
+ORDER BY r.ROUTINE_NAME, p.ORDINAL_POSITION;
+`
+	var queryResult []struct {
+		Routine struct {
+			Name string `sql:"primary_key"`
+			Type string
+		}
+
+		Parameters []struct {
+			Name       *string
+			Mode       *string
+			DataType   string
+			IsUnsigned bool
+		} `alias:"parameter"`
+	}
+
+	_, err := qrm.Query(ctx, db, query, []interface{}{schemaName}, &queryResult)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query routines: %w", err)
+	}
+
+	var ret []metadata.Routine
+
+	for _, result := range queryResult {
+		routine := metadata.Routine{
+			Name: result.Routine.Name,
+			Type: metadata.RoutineType(result.Routine.Type),
+		}
+
+		for _, parameter := range result.Parameters {
+			dataType := metadata.DataType{
+				Name:       parameter.DataType,
+				Kind:       metadata.BaseType,
+				IsUnsigned: parameter.IsUnsigned,
+			}
+
+			if parameter.Mode == nil { // function return value
+				routine.ReturnType = dataType
+				continue
+			}
+
+			var name string
+			if parameter.Name != nil {
+				name = *parameter.Name
+			}
+
+			routine.Parameters = append(routine.Parameters, metadata.RoutineParameter{
+				Name:     name,
+				Mode:     metadata.ParameterMode(*parameter.Mode),
+				DataType: dataType,
+			})
+		}
+
+		ret = append(ret, routine)
+	}
+
+	return ret, nil
 }
@@ -1,20 +1,25 @@
 package postgres
 
 import (
+	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"net/url"
 	"path"
 	"strconv"
 
+	"github.com/go-jet/jet/v2/generator/logger"
 	"github.com/go-jet/jet/v2/generator/metadata"
 	"github.com/go-jet/jet/v2/generator/template"
 	"github.com/go-jet/jet/v2/internal/utils"
-	"github.com/go-jet/jet/v2/internal/utils/throw"
 	"github.com/go-jet/jet/v2/postgres"
 	"github.com/jackc/pgconn"
 )
 
+// DialectName is the name of the dialect in metadata snapshot files
+const DialectName = "postgres"
+
 // DBConnection contains postgres connection details
 type DBConnection struct {
 	Host     string
@@ -28,54 +33,161 @@ type DBConnection struct {
 	SchemaName string
 }
 
+// Options are generator options used by Run
+type Options struct {
+	// DSN is database connection string. Connection details are used instead, if DSN is not set.
+	DSN string
+	// Schema is database schema name, used together with DSN
+	Schema string
+	// Connection contains database connection details, used if DSN is not set
+	Connection DBConnection
+	// FromMetadata is metadata snapshot file path. If set, jet files are generated from the snapshot file, without
+	// database connection.
+	FromMetadata string
+	// DumpMetadata is metadata snapshot file path. If set, retrieved database metadata is saved into the snapshot
+	// file, instead of generating jet files. Only template filters are used in that case.
+	DumpMetadata string
+	// DestDir is destination dir for the generated jet files
+	DestDir string
+	// Check, if set, compares jet files at destination dir with the generated files, and returns the difference.
+	// Destination dir is not modified.
+	Check bool
+	// Template is generator template. Default generator template is used, if Template is not set.
+	Template *template.Template
+}
+
 // Generate generates jet files at destination dir from database connection details
 func Generate(destDir string, dbConn DBConnection, genTemplate ...template.Template) (err error) {
-	dsn := fmt.Sprintf("postgresql://%s:%s@%s:%s/%s?sslmode=%s",
-		url.PathEscape(dbConn.User),
-		url.PathEscape(dbConn.Password),
-		dbConn.Host,
-		strconv.Itoa(dbConn.Port),
-		url.PathEscape(dbConn.DBName),
-		dbConn.SslMode,
-	)
+	_, err = Run(context.Background(), Options{
+		Connection: dbConn,
+		DestDir:    destDir,
+		Template:   optionalTemplate(genTemplate),
+	})
 
-	return GenerateDSN(dsn, dbConn.SchemaName, destDir, genTemplate...)
+	return err
 }
 
 // GenerateDSN generates jet files using dsn connection string
 func GenerateDSN(dsn, schema, destDir string, templates ...template.Template) (err error) {
-	defer utils.ErrorCatch(&err)
+	_, err = Run(context.Background(), Options{
+		DSN:      dsn,
+		Schema:   schema,
+		DestDir:  destDir,
+		Template: optionalTemplate(templates),
+	})
+
+	return err
+}
+
+// Run generates jet files, checks generated jet files or dumps database metadata, depending on the options.
+// Context is used for the database queries, and context logger, if set with logger.WithLogger, receives generator
+// progress and warnings. Returned difference is set only if options.Check is set.
+func Run(ctx context.Context, options Options) (template.Diff, error) {
+	if options.DumpMetadata != "" && (options.FromMetadata != "" || options.Check) {
+		return template.Diff{}, errors.New("DumpMetadata option can not be used together with FromMetadata or Check options")
+	}
+
+	generatorTemplate := template.Default(postgres.Dialect)
+	if options.Template != nil {
+		generatorTemplate = *options.Template
+	}
+
+	snapshot, err := options.snapshot(ctx, generatorTemplate.Filters)
+	if err != nil {
+		return template.Diff{}, err
+	}
+
+	if options.DumpMetadata != "" {
+		if err := metadata.SaveSnapshot(options.DumpMetadata, snapshot); err != nil {
+			return template.Diff{}, err
+		}
+
+		logger.Infof(ctx, "Metadata snapshot saved to %s", options.DumpMetadata)
+		return template.Diff{}, nil
+	}
+
+	dirPath := path.Join(options.DestDir, snapshot.Database)
+
+	if options.Check {
+		return template.CheckSchemaContext(ctx, dirPath, snapshot.Schema, generatorTemplate)
+	}
+
+	return template.Diff{}, template.ProcessSchemaContext(ctx, dirPath, snapshot.Schema, generatorTemplate)
+}
 
+// snapshot loads metadata snapshot file, or retrieves metadata snapshot from the database
+func (o Options) snapshot(ctx context.Context, filters metadata.Filters) (metadata.Snapshot, error) {
+	if o.FromMetadata != "" {
+		return metadata.LoadDialectSnapshot(o.FromMetadata, DialectName)
+	}
+
+	if o.DSN != "" {
+		return getSnapshot(ctx, o.DSN, o.Schema, filters)
+	}
+
+	return getSnapshot(ctx, o.Connection.dsn(), o.Connection.SchemaName, filters)
+}
+
+func (c DBConnection) dsn() string {
+	return fmt.Sprintf("postgresql://%s:%s@%s:%s/%s?sslmode=%s",
+		url.PathEscape(c.User),
+		url.PathEscape(c.Password),
+		c.Host,
+		strconv.Itoa(c.Port),
+		url.PathEscape(c.DBName),
+		c.SslMode,
+	)
+}
+
+func getSnapshot(ctx context.Context, dsn, schema string, filters metadata.Filters) (metadata.Snapshot, error) {
 	cfg, err := pgconn.ParseConfig(dsn)
-	throw.OnError(err)
+	if err != nil {
+		return metadata.Snapshot{}, fmt.Errorf("failed to parse postgres dsn: %w", err)
+	}
 	if cfg.Database == "" {
-		panic("database name is required")
+		return metadata.Snapshot{}, errors.New("database name is required")
+	}
+
+	db, err := openConnection(ctx, dsn)
+	if err != nil {
+		return metadata.Snapshot{}, err
 	}
-	db := openConnection(dsn)
 	defer utils.DBClose(db)
 
-	fmt.Println("Retrieving schema information...")
-	generatorTemplate := template.Default(postgres.Dialect)
-	if len(templates) > 0 {
-		generatorTemplate = templates[0]
+	logger.Infof(ctx, "Retrieving schema information...")
+
+	schemaMetaData, err := metadata.GetSchemaContext(ctx, db, &postgresQuerySet{}, schema, filters)
+	if err != nil {
+		return metadata.Snapshot{}, err
 	}
 
-	schemaMetadata := metadata.GetSchema(db, &postgresQuerySet{}, schema)
+	return metadata.Snapshot{
+		Dialect:  DialectName,
+		Database: cfg.Database,
+		Schema:   schemaMetaData,
+	}, nil
+}
 
-	dirPath := path.Join(destDir, cfg.Database)
+func optionalTemplate(templates []template.Template) *template.Template {
+	if len(templates) > 0 {
+		return &templates[0]
+	}
 
-	template.ProcessSchema(dirPath, schemaMetadata, generatorTemplate)
-	return
+	return nil
 }
 
-func openConnection(dsn string) *sql.DB {
-	fmt.Printf("Connecting to postgres database")
+func openConnection(ctx context.Context, dsn string) (*sql.DB, error) {
+	logger.Infof(ctx, "Connecting to postgres database...")
 
 	db, err := sql.Open("postgres", dsn)
-	throw.OnError(err)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open postgres database connection: %w", err)
+	}
 
-	err = db.Ping()
-	throw.OnError(err)
+	if err := db.PingContext(ctx); err != nil {
+		utils.DBClose(db)
+		return nil, fmt.Errorf("failed to connect to postgres database: %w", err)
+	}
 
-	return db
+	return db, nil
 }
@@ -3,76 +3,212 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"fmt"
+	"strings"
 
+	"github.com/go-jet/jet/v2/generator/logger"
 	"github.com/go-jet/jet/v2/generator/metadata"
-	"github.com/go-jet/jet/v2/internal/utils/throw"
 	"github.com/go-jet/jet/v2/qrm"
 )
 
 // postgresQuerySet is dialect query set for PostgreSQL
 type postgresQuerySet struct{}
 
-func (p postgresQuerySet) GetTablesMetaData(db *sql.DB, schemaName string, tableType metadata.TableType) []metadata.Table {
+func (p postgresQuerySet) GetTablesMetaData(ctx context.Context, db *sql.DB, schemaName string, tableFilter, viewFilter metadata.Filter) (tables, views []metadata.Table, err error) {
 	query := `
-SELECT table_name as "table.name" 
+SELECT table_name as "table.name",
+	   table_type as "tableType",
+	   COALESCE(obj_description((quote_ident(table_schema) || '.' || quote_ident(table_name))::regclass, 'pg_class'), '') as "table.comment"
 FROM information_schema.tables
-WHERE table_schema = $1 and table_type = $2
+WHERE table_schema = $1 and table_type IN ('BASE TABLE', 'VIEW')
 ORDER BY table_name;
 `
-	var tables []metadata.Table
+	var tableInfos []struct {
+		TableType string
+		Table     metadata.Table
+	}
+
+	_, err = qrm.Query(ctx, db, query, []interface{}{schemaName}, &tableInfos)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to query tables: %w", err)
+	}
+
+	for _, tableInfo := range tableInfos {
+		switch {
+		case tableInfo.TableType == string(metadata.BaseTable) && tableFilter.Match(tableInfo.Table.Name):
+			tables = append(tables, tableInfo.Table)
+		case tableInfo.TableType == string(metadata.ViewTable) && viewFilter.Match(tableInfo.Table.Name):
+			views = append(views, tableInfo.Table)
+		}
+	}
+
+	if len(tables) == 0 && len(views) == 0 {
+		return nil, nil, nil
+	}
+
+	tableNames := metadata.TableNames(tables)
+
+	columns, err := p.getColumns(ctx, db, schemaName, append(tableNames, metadata.TableNames(views)...))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var foreignKeys map[string][]metadata.ForeignKey
 
-	_, err := qrm.Query(context.Background(), db, query, []interface{}{schemaName, tableType}, &tables)
-	throw.OnError(err)
+	if len(tables) > 0 {
+		foreignKeys, err = p.getForeignKeys(ctx, db, schemaName, tableNames)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
 
 	for i := range tables {
-		tables[i].Columns = p.GetTableColumnsMetaData(db, schemaName, tables[i].Name)
+		tables[i].Columns = columns[tables[i].Name]
+		tables[i].ForeignKeys = foreignKeys[tables[i].Name]
+	}
+
+	for i := range views {
+		views[i].Columns = columns[views[i].Name]
 	}
 
-	return tables
+	return tables, views, nil
+}
+
+// GetTableColumnsMetaData returns columns of the schema table
+func (p postgresQuerySet) GetTableColumnsMetaData(ctx context.Context, db *sql.DB, schemaName string, tableName string) ([]metadata.Column, error) {
+	columns, err := p.getColumns(ctx, db, schemaName, []string{tableName})
+
+	return columns[tableName], err
 }
 
-func (p postgresQuerySet) GetTableColumnsMetaData(db *sql.DB, schemaName string, tableName string) []metadata.Column {
+// getColumns returns columns of the schema tables and views, with tableNames names, grouped by table name
+func (p postgresQuerySet) getColumns(ctx context.Context, db *sql.DB, schemaName string, tableNames []string) (map[string][]metadata.Column, error) {
 	query := `
 WITH primaryKeys AS (
-	SELECT column_name
+	SELECT c.table_name, c.column_name
 	FROM information_schema.key_column_usage AS c
-		LEFT JOIN information_schema.table_constraints AS t
+		JOIN information_schema.table_constraints AS t
 			 ON t.constraint_name = c.constraint_name AND 
 				c.table_schema = t.table_schema AND 
 				c.table_name = t.table_name
-	WHERE t.table_schema = $1 AND t.table_name = $2 AND t.constraint_type = 'PRIMARY KEY'
+	WHERE t.table_schema = $1 AND t.constraint_type = 'PRIMARY KEY'
 )
-SELECT column_name as "column.Name", 
+SELECT columns.table_name as "tableName",
+	   columns.column_name as "column.Name", 
 	   is_nullable = 'YES' as "column.isNullable",
 	   is_generated = 'ALWAYS' or is_generated = 'YES' as "column.isGenerated",
-	   (EXISTS(SELECT 1 from primaryKeys as pk where pk.column_name = columns.column_name)) as "column.IsPrimaryKey",
+	   column_default IS NOT NULL or is_identity = 'YES' as "column.hasDefault",
+	   COALESCE(column_default, '') as "column.defaultValue",
+	   pk.column_name IS NOT NULL as "column.IsPrimaryKey",
 	   dataType.kind as "dataType.Kind",	
 	   (case dataType.Kind when 'base' then data_type else LTRIM(udt_name, '_') end) as "dataType.Name", 
-	   FALSE as "dataType.isUnsigned"
-FROM information_schema.columns,
-	 LATERAL (select (case data_type
+	   FALSE as "dataType.isUnsigned",
+	   COALESCE(a.attndims, 0) as "dataType.dimensions",
+	   COALESCE(col_description(c.oid, ordinal_position::int), '') as "column.comment"
+FROM information_schema.columns
+	 JOIN pg_catalog.pg_namespace AS n ON n.nspname = columns.table_schema
+	 JOIN pg_catalog.pg_class AS c ON c.relnamespace = n.oid AND c.relname = columns.table_name
+	 LEFT JOIN pg_catalog.pg_attribute AS a ON a.attrelid = c.oid AND a.attname = columns.column_name
+	 LEFT JOIN primaryKeys AS pk ON pk.table_name = columns.table_name AND pk.column_name = columns.column_name
+	 LEFT JOIN (pg_catalog.pg_type AS t JOIN pg_catalog.pg_namespace AS tn ON tn.oid = t.typnamespace AND tn.nspname = $1)
+	        ON t.typname = columns.udt_name
+	 CROSS JOIN LATERAL (select (case data_type
 				when 'ARRAY' then 'array'
-				when 'USER-DEFINED' then 
-					case (select t.typtype 
-						  from pg_type as t 
-						  join pg_namespace as p on p.oid = t.typnamespace 
-						  where t.typname = columns.udt_name and p.nspname = $1)
+				when 'USER-DEFINED' then
+					case t.typtype
 						when 'e' then 'enum'
 						else 'user-defined'
 					end
 				else 'base'
 			end) as Kind) as dataType
-where table_schema = $1 and table_name = $2
-order by ordinal_position;
+WHERE columns.table_schema = $1 AND columns.table_name = ANY($2::text::text[])
+ORDER BY columns.table_name, ordinal_position;
 `
-	var columns []metadata.Column
-	_, err := qrm.Query(context.Background(), db, query, []interface{}{schemaName, tableName}, &columns)
-	throw.OnError(err)
+	var columns []struct {
+		TableName string
+		Column    metadata.Column
+	}
+
+	_, err := qrm.Query(ctx, db, query, []interface{}{schemaName, textArray(tableNames)}, &columns)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query columns: %w", err)
+	}
 
-	return columns
+	ret := map[string][]metadata.Column{}
+
+	for _, column := range columns {
+		ret[column.TableName] = append(ret[column.TableName], column.Column)
+	}
+
+	return ret, nil
 }
 
-func (p postgresQuerySet) GetEnumsMetaData(db *sql.DB, schemaName string) []metadata.Enum {
+// textArray returns PostgreSQL text array literal of values, for instance {"actor","film"}. Array literal is
+// passed as a single text query parameter, and cast to text[] in the query.
+func textArray(values []string) string {
+	var quoted []string
+
+	for _, value := range values {
+		value = strings.ReplaceAll(value, `\`, `\\`)
+		value = strings.ReplaceAll(value, `"`, `\"`)
+
+		quoted = append(quoted, `"`+value+`"`)
+	}
+
+	return "{" + strings.Join(quoted, ",") + "}"
+}
+
+// getForeignKeys returns foreign keys of the schema tables, with tableNames names, grouped by table name
+func (p postgresQuerySet) getForeignKeys(ctx context.Context, db *sql.DB, schemaName string, tableNames []string) (map[string][]metadata.ForeignKey, error) {
+	query := `
+SELECT t.relname AS "tableName",
+       c.conname AS "name",
+       rn.nspname AS "referencedSchema",
+       rt.relname AS "referencedTable",
+       string_agg(a.attname, ',' ORDER BY k.n) AS "columns",
+       string_agg(ra.attname, ',' ORDER BY k.n) AS "referencedColumns"
+FROM pg_catalog.pg_constraint AS c
+     JOIN pg_catalog.pg_class AS t ON t.oid = c.conrelid
+     JOIN pg_catalog.pg_namespace AS n ON n.oid = t.relnamespace
+     JOIN pg_catalog.pg_class AS rt ON rt.oid = c.confrelid
+     JOIN pg_catalog.pg_namespace AS rn ON rn.oid = rt.relnamespace
+     CROSS JOIN LATERAL unnest(c.conkey, c.confkey) WITH ORDINALITY AS k(attnum, refattnum, n)
+     JOIN pg_catalog.pg_attribute AS a ON a.attrelid = c.conrelid AND a.attnum = k.attnum
+     JOIN pg_catalog.pg_attribute AS ra ON ra.attrelid = c.confrelid AND ra.attnum = k.refattnum
+WHERE c.contype = 'f' AND n.nspname = $1 AND t.relname = ANY($2::text::text[])
+GROUP BY t.relname, c.conname, rn.nspname, rt.relname
+ORDER BY t.relname, c.conname;
+`
+	var foreignKeys []struct {
+		TableName         string
+		Name              string
+		ReferencedSchema  string
+		ReferencedTable   string
+		Columns           string
+		ReferencedColumns string
+	}
+
+	_, err := qrm.Query(ctx, db, query, []interface{}{schemaName, textArray(tableNames)}, &foreignKeys)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query foreign keys: %w", err)
+	}
+
+	ret := map[string][]metadata.ForeignKey{}
+
+	for _, fk := range foreignKeys {
+		ret[fk.TableName] = append(ret[fk.TableName], metadata.ForeignKey{
+			Name:              fk.Name,
+			Columns:           strings.Split(fk.Columns, ","),
+			ReferencedSchema:  fk.ReferencedSchema,
+			ReferencedTable:   fk.ReferencedTable,
+			ReferencedColumns: strings.Split(fk.ReferencedColumns, ","),
+		})
+	}
+
+	return ret, nil
+}
+
+func (p postgresQuerySet) GetEnumsMetaData(ctx context.Context, db *sql.DB, schemaName string) ([]metadata.Enum, error) {
 	query := `
 SELECT t.typname as "enum.name",  
        e.enumlabel as "values"
@@ -84,8 +220,194 @@ ORDER BY n.nspname, t.typname, e.enumsortorder;`
 
 	var result []metadata.Enum
 
-	_, err := qrm.Query(context.Background(), db, query, []interface{}{schemaName}, &result)
-	throw.OnError(err)
+	_, err := qrm.Query(ctx, db, query, []interface{}{schemaName}, &result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query enums: %w", err)
+	}
+
+	return result, nil
+}
+
+func (p postgresQuerySet) GetRoutinesMetaData(ctx context.Context, db *sql.DB, schemaName string) ([]metadata.Routine, error) {
+	query := `
+SELECT r.specific_name AS "specificName",
+       r.routine_name AS "name",
+       r.routine_type AS "type",
+       p.proretset AS "returnsSet",
+       COALESCE(r.data_type, '') AS "dataType",
+       COALESCE(r.type_udt_schema, '') AS "udtSchema",
+       COALESCE(r.type_udt_name, '') AS "udtName",
+       COALESCE(t.typtype::text, '') AS "typeType"
+FROM information_schema.routines AS r
+     JOIN pg_catalog.pg_proc AS p ON r.specific_name = p.proname || '_' || p.oid
+     LEFT JOIN pg_catalog.pg_type AS t ON t.oid = p.prorettype
+WHERE r.specific_schema = $1 AND 
+      p.prokind IN ('f', 'p') AND
+      NOT EXISTS (SELECT 1 FROM pg_catalog.pg_depend AS d WHERE d.objid = p.oid AND d.deptype = 'e')
+ORDER BY r.routine_name, r.specific_name;
+`
+	var routines []struct {
+		SpecificName string
+		Name         string
+		Type         string
+		ReturnsSet   bool
+		DataType     string
+		UdtSchema    string
+		UdtName      string
+		TypeType     string
+	}
+
+	_, err := qrm.Query(ctx, db, query, []interface{}{schemaName}, &routines)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query routines: %w", err)
+	}
+
+	parameters, err := p.getRoutineParameters(ctx, db, schemaName)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret []metadata.Routine
+
+	for _, r := range routines {
+		routine := metadata.Routine{
+			Name: r.Name,
+			Type: metadata.RoutineType(r.Type),
+		}
+
+		var resultColumns []metadata.Column
+		supported := true
+
+		for _, parameter := range parameters[r.SpecificName] {
+			if parameter.TypeType == "p" {
+				supported = false // polymorphic or internal parameter types
+			}
+
+			mode := metadata.ParameterMode(parameter.Mode)
+			dataType := routineDataType(parameter.DataType, parameter.UdtName, parameter.TypeType)
+
+			routine.Parameters = append(routine.Parameters, metadata.RoutineParameter{
+				Name:     parameter.Name,
+				Mode:     mode,
+				DataType: dataType,
+			})
+
+			if mode == metadata.OutParameter || mode == metadata.InOutParameter {
+				resultColumns = append(resultColumns, metadata.Column{
+					Name:       parameter.Name,
+					IsNullable: true,
+					DataType:   dataType,
+				})
+			}
+		}
+
+		if !supported {
+			logger.Warnf(ctx, "- [SQL Builder] Unsupported routine '%s' parameter types, skipping.", r.Name)
+			continue
+		}
+
+		if !routine.IsProcedure() {
+			switch {
+			case r.TypeType == "c":
+				routine.ResultColumns, err = p.getCompositeTypeColumns(ctx, db, r.UdtSchema, r.UdtName)
+				if err != nil {
+					return nil, err
+				}
+			case r.ReturnsSet && len(resultColumns) > 0, !r.ReturnsSet && len(resultColumns) > 1:
+				routine.ResultColumns = resultColumns
+			case r.TypeType == "p" && r.DataType != "void":
+				logger.Warnf(ctx, "- [SQL Builder] Unsupported routine '%s' return type '%s', skipping.", r.Name, r.DataType)
+				continue
+			case r.ReturnsSet:
+				routine.ResultColumns = []metadata.Column{{Name: r.Name, IsNullable: true, DataType: routineDataType(r.DataType, r.UdtName, r.TypeType)}}
+			default:
+				routine.ReturnType = routineDataType(r.DataType, r.UdtName, r.TypeType)
+			}
+		}
+
+		ret = append(ret, routine)
+	}
+
+	return ret, nil
+}
+
+// routineDataType converts information_schema data type, udt name and pg_type.typtype of routine parameter or
+// return value into metadata.DataType
+func routineDataType(dataType, udtName, typeType string) metadata.DataType {
+	switch {
+	case dataType == "ARRAY":
+		return metadata.DataType{Name: strings.TrimLeft(udtName, "_"), Kind: metadata.ArrayType, Dimensions: 1}
+	case typeType == "e":
+		return metadata.DataType{Name: udtName, Kind: metadata.EnumType}
+	case dataType == "USER-DEFINED":
+		return metadata.DataType{Name: udtName, Kind: metadata.UserDefinedType}
+	default:
+		return metadata.DataType{Name: dataType, Kind: metadata.BaseType}
+	}
+}
+
+type routineParameter struct {
+	SpecificName string
+	Name         string
+	Mode         string
+	DataType     string
+	UdtName      string
+	TypeType     string
+}
+
+func (p postgresQuerySet) getRoutineParameters(ctx context.Context, db *sql.DB, schemaName string) (map[string][]routineParameter, error) {
+	query := `
+SELECT specific_name AS "routineParameter.specificName",
+       COALESCE(parameter_name, '') AS "routineParameter.name",
+       parameter_mode AS "routineParameter.mode",
+       data_type AS "routineParameter.dataType",
+       udt_name AS "routineParameter.udtName",
+       COALESCE((SELECT t.typtype::text
+                 FROM pg_catalog.pg_type AS t
+                     JOIN pg_catalog.pg_namespace AS n ON n.oid = t.typnamespace
+                 WHERE t.typname = parameters.udt_name AND n.nspname = parameters.udt_schema), '') AS "routineParameter.typeType"
+FROM information_schema.parameters
+WHERE specific_schema = $1
+ORDER BY specific_name, ordinal_position;
+`
+	var parameters []routineParameter
+
+	_, err := qrm.Query(ctx, db, query, []interface{}{schemaName}, &parameters)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query routine parameters: %w", err)
+	}
+
+	ret := map[string][]routineParameter{}
+
+	for _, parameter := range parameters {
+		ret[parameter.SpecificName] = append(ret[parameter.SpecificName], parameter)
+	}
+
+	return ret, nil
+}
+
+// getCompositeTypeColumns returns columns of table, view or composite type
+func (p postgresQuerySet) getCompositeTypeColumns(ctx context.Context, db *sql.DB, schemaName, typeName string) ([]metadata.Column, error) {
+	columns, err := p.GetTableColumnsMetaData(ctx, db, schemaName, typeName)
+
+	if err != nil || len(columns) > 0 {
+		return columns, err
+	}
+
+	query := `
+SELECT attribute_name AS "column.Name",
+       TRUE AS "column.isNullable",
+       (CASE data_type WHEN 'ARRAY' THEN 'array' WHEN 'USER-DEFINED' THEN 'user-defined' ELSE 'base' END) AS "dataType.Kind",
+       (CASE data_type WHEN 'ARRAY' THEN LTRIM(attribute_udt_name, '_') WHEN 'USER-DEFINED' THEN attribute_udt_name ELSE data_type END) AS "dataType.Name",
+       (CASE data_type WHEN 'ARRAY' THEN 1 ELSE 0 END) AS "dataType.dimensions"
+FROM information_schema.attributes
+WHERE udt_schema = $1 AND udt_name = $2
+ORDER BY ordinal_position;
+`
+	_, err = qrm.Query(ctx, db, query, []interface{}{schemaName, typeName}, &columns)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query composite type %s attributes: %w", typeName, err)
+	}
 
-	return result
+	return columns, nil
 }
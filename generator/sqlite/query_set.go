@@ -5,7 +5,6 @@ import (
 	"database/sql"
 	"fmt"
 	"github.com/go-jet/jet/v2/generator/metadata"
-	"github.com/go-jet/jet/v2/internal/utils/throw"
 	"github.com/go-jet/jet/v2/qrm"
 	"strings"
 )
@@ -13,52 +12,169 @@ import (
 // sqliteQuerySet is dialect query set for SQLite
 type sqliteQuerySet struct{}
 
-func (p sqliteQuerySet) GetTablesMetaData(db *sql.DB, schemaName string, tableType metadata.TableType) []metadata.Table {
+func (p sqliteQuerySet) GetTablesMetaData(ctx context.Context, db *sql.DB, schemaName string, tableFilter, viewFilter metadata.Filter) (tables, views []metadata.Table, err error) {
 	query := `
-	SELECT name as "table.name" 
+	SELECT name, type, COALESCE(sql, '') AS sql
 	FROM sqlite_master
-	WHERE type=? AND name != 'sqlite_sequence'
+	WHERE type IN ('table', 'view') AND name != 'sqlite_sequence'
 	ORDER BY name;
 `
-	sqlTableType := "table"
+	var tableInfos []struct {
+		Name string
+		Type string
+		SQL  string
+	}
 
-	if tableType == metadata.ViewTable {
-		sqlTableType = "view"
+	_, err = qrm.Query(ctx, db, query, nil, &tableInfos)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to query tables: %w", err)
 	}
 
-	var tables []metadata.Table
+	columnComments := map[string]map[string]string{}
+
+	for _, tableInfo := range tableInfos {
+		isView := tableInfo.Type == "view"
+
+		if isView && !viewFilter.Match(tableInfo.Name) || !isView && !tableFilter.Match(tableInfo.Name) {
+			continue
+		}
 
-	_, err := qrm.Query(context.Background(), db, query, []interface{}{sqlTableType}, &tables)
-	throw.OnError(err)
+		table := metadata.Table{Name: tableInfo.Name}
+		table.Comment, columnComments[table.Name] = parseComments(tableInfo.SQL)
 
-	for i := range tables {
-		tables[i].Columns = p.GetTableColumnsMetaData(db, schemaName, tables[i].Name)
+		if isView {
+			views = append(views, table)
+		} else {
+			tables = append(tables, table)
+		}
 	}
 
-	return tables
+	if len(tables) == 0 && len(views) == 0 {
+		return nil, nil, nil
+	}
+
+	columns, err := p.getColumns(ctx, db, append(metadata.TableNames(tables), metadata.TableNames(views)...))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	setColumns := func(tables []metadata.Table) {
+		for i := range tables {
+			tables[i].Columns = columns[tables[i].Name]
+
+			for j := range tables[i].Columns {
+				tables[i].Columns[j].Comment = columnComments[tables[i].Name][strings.ToLower(tables[i].Columns[j].Name)]
+			}
+		}
+	}
+
+	setColumns(tables)
+	setColumns(views)
+
+	if len(tables) > 0 {
+		foreignKeys, err := p.getForeignKeys(ctx, db, schemaName, tables)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for i := range tables {
+			tables[i].ForeignKeys = foreignKeys[tables[i].Name]
+		}
+	}
+
+	return tables, views, nil
+}
+
+// GetTableColumnsMetaData returns columns of the table or view
+func (p sqliteQuerySet) GetTableColumnsMetaData(ctx context.Context, db *sql.DB, schemaName string, tableName string) ([]metadata.Column, error) {
+	columns, err := p.getColumns(ctx, db, []string{tableName})
+
+	return columns[tableName], err
+}
+
+type columnInfo struct {
+	TableName string
+	Name      string
+	Type      string
+	NotNull   int32
+	DfltValue *string
+	Pk        int32
 }
 
-func (p sqliteQuerySet) GetTableColumnsMetaData(db *sql.DB, schemaName string, tableName string) []metadata.Column {
-	query := fmt.Sprintf(`select * from pragma_table_info(?);`)
-	var columnInfos []struct {
-		Name    string
-		Type    string
-		NotNull int32
-		Pk      int32
+// getColumns returns columns of the tables and views, with tableNames names, grouped by table name. Columns of all
+// the tables are queried, and columns of the tables not in tableNames are skipped.
+func (p sqliteQuerySet) getColumns(ctx context.Context, db *sql.DB, tableNames []string) (map[string][]metadata.Column, error) {
+	query := `
+	SELECT m.name AS "columnInfo.tableName", 
+		c.name AS "columnInfo.name", 
+		c.type AS "columnInfo.type", 
+		c."notnull" AS "columnInfo.notNull", 
+		c.dflt_value AS "columnInfo.dfltValue", 
+		c.pk AS "columnInfo.pk"
+	FROM sqlite_master AS m
+		JOIN pragma_table_info(m.name) AS c
+	WHERE m.type IN ('table', 'view')
+	ORDER BY m.name, c.cid;
+`
+	var columnInfos []columnInfo
+
+	_, err := qrm.Query(ctx, db, query, nil, &columnInfos)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query columns: %w", err)
+	}
+
+	tableColumnInfos := map[string][]columnInfo{}
+	retrieve := map[string]bool{}
+
+	for _, tableName := range tableNames {
+		retrieve[tableName] = true
 	}
 
-	_, err := qrm.Query(context.Background(), db, query, []interface{}{tableName}, &columnInfos)
-	throw.OnError(err)
+	for _, columnInfo := range columnInfos {
+		if !retrieve[columnInfo.TableName] {
+			continue
+		}
+
+		tableColumnInfos[columnInfo.TableName] = append(tableColumnInfos[columnInfo.TableName], columnInfo)
+	}
+
+	ret := map[string][]metadata.Column{}
+
+	for name, columnInfos := range tableColumnInfos {
+		ret[name] = columnsMetaData(columnInfos)
+	}
+
+	return ret, nil
+}
+
+// columnsMetaData converts pragma_table_info rows of a single table into column metadata
+func columnsMetaData(columnInfos []columnInfo) []metadata.Column {
+	primaryKeys := 0
+
+	for _, columnInfo := range columnInfos {
+		if columnInfo.Pk != 0 {
+			primaryKeys++
+		}
+	}
 
 	var columns []metadata.Column
 
 	for _, columnInfo := range columnInfos {
 		columnType := getColumnType(columnInfo.Type)
+		// single INTEGER PRIMARY KEY column is an alias for the rowid, and it is assigned automatically
+		isRowID := columnInfo.Pk != 0 && primaryKeys == 1 && strings.EqualFold(columnInfo.Type, "INTEGER")
+
+		var defaultValue string
+		if columnInfo.DfltValue != nil {
+			defaultValue = *columnInfo.DfltValue
+		}
 
 		columns = append(columns, metadata.Column{
 			Name:         columnInfo.Name,
 			IsPrimaryKey: columnInfo.Pk != 0,
 			IsNullable:   columnInfo.NotNull != 1,
+			HasDefault:   columnInfo.DfltValue != nil || isRowID,
+			DefaultValue: defaultValue,
 			DataType: metadata.DataType{
 				Name:       columnType,
 				Kind:       metadata.BaseType,
@@ -70,11 +186,176 @@ func (p sqliteQuerySet) GetTableColumnsMetaData(db *sql.DB, schemaName string, t
 	return columns
 }
 
+// getForeignKeys returns foreign keys of all the tables grouped by table name.
+// SQLite foreign keys are unnamed, so constraint name is constructed from the table and column names.
+// Foreign keys without referenced columns are referencing primary key of the referenced table.
+func (p sqliteQuerySet) getForeignKeys(ctx context.Context, db *sql.DB, schemaName string, tables []metadata.Table) (map[string][]metadata.ForeignKey, error) {
+	query := `
+	SELECT m.name AS table_name, f.id, f."table" AS referenced_table, f."from" AS column_name, f."to" AS referenced_column
+	FROM sqlite_master AS m
+		JOIN pragma_foreign_key_list(m.name) AS f
+	WHERE m.type = 'table'
+	ORDER BY m.name, f.id, f.seq;
+`
+	var fkInfos []struct {
+		TableName        string
+		ID               int32
+		ReferencedTable  string
+		ColumnName       string
+		ReferencedColumn *string
+	}
+
+	_, err := qrm.Query(ctx, db, query, nil, &fkInfos)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query foreign keys: %w", err)
+	}
+
+	ret := map[string][]metadata.ForeignKey{}
+
+	for i, fkInfo := range fkInfos {
+		if i == 0 || fkInfo.TableName != fkInfos[i-1].TableName || fkInfo.ID != fkInfos[i-1].ID {
+			ret[fkInfo.TableName] = append(ret[fkInfo.TableName], metadata.ForeignKey{
+				ReferencedSchema: schemaName,
+				ReferencedTable:  fkInfo.ReferencedTable,
+			})
+		}
+
+		foreignKeys := ret[fkInfo.TableName]
+		fk := &foreignKeys[len(foreignKeys)-1]
+		fk.Columns = append(fk.Columns, fkInfo.ColumnName)
+
+		if fkInfo.ReferencedColumn != nil {
+			fk.ReferencedColumns = append(fk.ReferencedColumns, *fkInfo.ReferencedColumn)
+		}
+	}
+
+	for tableName, foreignKeys := range ret {
+		for i := range foreignKeys {
+			fk := &foreignKeys[i]
+			fk.Name = tableName + "_" + strings.Join(fk.Columns, "_") + "_fkey"
+
+			if len(fk.ReferencedColumns) == 0 {
+				fk.ReferencedColumns = primaryKeyColumns(tables, fk.ReferencedTable)
+			}
+		}
+	}
+
+	return ret, nil
+}
+
+func primaryKeyColumns(tables []metadata.Table, tableName string) []string {
+	var ret []string
+
+	for _, table := range tables {
+		if table.Name != tableName {
+			continue
+		}
+
+		for _, column := range table.Columns {
+			if column.IsPrimaryKey {
+				ret = append(ret, column.Name)
+			}
+		}
+	}
+
+	return ret
+}
+
+// SQLite does not support table and column comments, but it does preserve the original CREATE statement text.
+// Line (--) or block (/* */) comment on the CREATE statement line is used as a table comment, and comment following
+// the column definition, on the same line, as a column comment. Comment markers inside string literals and quoted
+// identifiers are ignored. Returned column comments are keyed by lowercase column name.
+func parseComments(createSQL string) (tableComment string, columnComments map[string]string) {
+	columnComments = map[string]string{}
+
+	setComment := func(definition, comment string) {
+		definition = strings.TrimSpace(definition)
+		comment = strings.TrimSpace(comment)
+
+		if strings.HasPrefix(strings.ToUpper(definition), "CREATE ") {
+			tableComment = comment
+			return
+		}
+
+		fields := strings.Fields(strings.TrimLeft(definition, "(,"))
+
+		if len(fields) == 0 {
+			return
+		}
+
+		columnName := strings.ToLower(strings.Trim(fields[0], "\"`[],"))
+
+		switch columnName {
+		case "constraint", "primary", "unique", "check", "foreign":
+			return
+		}
+
+		columnComments[columnName] = comment
+	}
+
+	var line strings.Builder // current line of the statement, without comments
+
+	for i := 0; i < len(createSQL); i++ {
+		switch c := createSQL[i]; {
+		case c == '\n':
+			line.Reset()
+		case c == '\'' || c == '"' || c == '`' || c == '[':
+			end := quoteEnd(createSQL, i)
+			line.WriteString(createSQL[i:end])
+			i = end - 1
+		case strings.HasPrefix(createSQL[i:], "--"):
+			end := strings.IndexByte(createSQL[i:], '\n')
+			if end < 0 {
+				end = len(createSQL)
+			} else {
+				end += i
+			}
+			setComment(line.String(), createSQL[i+2:end])
+			i = end - 1
+		case strings.HasPrefix(createSQL[i:], "/*"):
+			end := strings.Index(createSQL[i+2:], "*/")
+			if end < 0 {
+				setComment(line.String(), createSQL[i+2:])
+				return
+			}
+			end += i + 2
+			setComment(line.String(), createSQL[i+2:end])
+			i = end + 1
+		default:
+			line.WriteByte(c)
+		}
+	}
+
+	return
+}
+
+// quoteEnd returns index just after the closing quote of the string literal or quoted identifier starting at start.
+// Doubled quote characters, used for escaping, are handled as two adjacent quoted strings.
+func quoteEnd(text string, start int) int {
+	closingQuote := text[start]
+
+	if closingQuote == '[' {
+		closingQuote = ']'
+	}
+
+	end := strings.IndexByte(text[start+1:], closingQuote)
+
+	if end < 0 {
+		return len(text)
+	}
+
+	return start + 1 + end + 1
+}
+
 // will convert VARCHAR(10) -> VARCHAR, etc...
 func getColumnType(columnType string) string {
 	return strings.TrimSpace(strings.Split(columnType, "(")[0])
 }
 
-func (p sqliteQuerySet) GetEnumsMetaData(db *sql.DB, schemaName string) []metadata.Enum {
-	return nil
+func (p sqliteQuerySet) GetEnumsMetaData(ctx context.Context, db *sql.DB, schemaName string) ([]metadata.Enum, error) {
+	return nil, nil
+}
+
+func (p sqliteQuerySet) GetRoutinesMetaData(ctx context.Context, db *sql.DB, schemaName string) ([]metadata.Routine, error) {
+	return nil, nil // SQLite does not support stored functions and procedures
 }
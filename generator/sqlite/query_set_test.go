@@ -0,0 +1,48 @@
+package sqlite
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseComments(t *testing.T) {
+	tableComment, columnComments := parseComments(`CREATE TABLE film ( -- Film catalog
+	film_id INTEGER PRIMARY KEY, -- Unique film id
+	"Title" TEXT NOT NULL,   --Film title
+	[length] INTEGER,
+	language_id INTEGER
+	, rating TEXT -- MPAA rating
+	, FOREIGN KEY (language_id) REFERENCES language (language_id) -- not a column
+)`)
+
+	require.Equal(t, tableComment, "Film catalog")
+	require.Equal(t, columnComments, map[string]string{
+		"film_id": "Unique film id",
+		"title":   "Film title",
+		"rating":  "MPAA rating",
+	})
+
+	tableComment, columnComments = parseComments(`CREATE TABLE "film--list" ( /* Film
+list */
+	separator TEXT DEFAULT '--', -- Separator -- with dashes
+	"film--id" INTEGER /* Film id */ PRIMARY KEY,
+	[note] TEXT DEFAULT '/* not a comment */',
+	title TEXT DEFAULT 'it''s -- quoted' /* Title */, rating TEXT
+)`)
+
+	require.Equal(t, tableComment, "Film\nlist")
+	require.Equal(t, columnComments, map[string]string{
+		"separator": "Separator -- with dashes",
+		"film--id":  "Film id",
+		"title":     "Title",
+	})
+
+	tableComment, columnComments = parseComments("CREATE TABLE actor (id INTEGER) /* unterminated")
+	require.Equal(t, tableComment, "unterminated")
+	require.Empty(t, columnComments)
+
+	tableComment, columnComments = parseComments("")
+	require.Empty(t, tableComment)
+	require.Empty(t, columnComments)
+}
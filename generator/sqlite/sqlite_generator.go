@@ -1,32 +1,113 @@
 package sqlite
 
 import (
+	"context"
 	"database/sql"
+	"errors"
 	"fmt"
+	"github.com/go-jet/jet/v2/generator/logger"
 	"github.com/go-jet/jet/v2/generator/metadata"
 	"github.com/go-jet/jet/v2/generator/template"
 	"github.com/go-jet/jet/v2/internal/utils"
-	"github.com/go-jet/jet/v2/internal/utils/throw"
 	"github.com/go-jet/jet/v2/sqlite"
 )
 
+// DialectName is the name of the dialect in metadata snapshot files
+const DialectName = "sqlite"
+
+// Options are generator options used by Run
+type Options struct {
+	// DSN is database connection string
+	DSN string
+	// FromMetadata is metadata snapshot file path. If set, jet files are generated from the snapshot file, without
+	// database connection.
+	FromMetadata string
+	// DumpMetadata is metadata snapshot file path. If set, retrieved database metadata is saved into the snapshot
+	// file, instead of generating jet files. Only template filters are used in that case.
+	DumpMetadata string
+	// DestDir is destination dir for the generated jet files
+	DestDir string
+	// Check, if set, compares jet files at destination dir with the generated files, and returns the difference.
+	// Destination dir is not modified.
+	Check bool
+	// Template is generator template. Default generator template is used, if Template is not set.
+	Template *template.Template
+}
+
 // GenerateDSN generates jet files using dsn connection string
 func GenerateDSN(dsn, destDir string, templates ...template.Template) (err error) {
-	defer utils.ErrorCatch(&err)
+	_, err = Run(context.Background(), Options{
+		DSN:      dsn,
+		DestDir:  destDir,
+		Template: optionalTemplate(templates),
+	})
 
-	db, err := sql.Open("sqlite3", dsn)
-	throw.OnError(err)
-	defer utils.DBClose(db)
+	return err
+}
 
-	fmt.Println("Retrieving schema information...")
+// Run generates jet files, checks generated jet files or dumps database metadata, depending on the options.
+// Context is used for the database queries, and context logger, if set with logger.WithLogger, receives generator
+// progress and warnings. Returned difference is set only if options.Check is set.
+func Run(ctx context.Context, options Options) (template.Diff, error) {
+	if options.DumpMetadata != "" && (options.FromMetadata != "" || options.Check) {
+		return template.Diff{}, errors.New("DumpMetadata option can not be used together with FromMetadata or Check options")
+	}
 
 	generatorTemplate := template.Default(sqlite.Dialect)
-	if len(templates) > 0 {
-		generatorTemplate = templates[0]
+	if options.Template != nil {
+		generatorTemplate = *options.Template
+	}
+
+	snapshot, err := options.snapshot(ctx, generatorTemplate.Filters)
+	if err != nil {
+		return template.Diff{}, err
+	}
+
+	if options.DumpMetadata != "" {
+		if err := metadata.SaveSnapshot(options.DumpMetadata, snapshot); err != nil {
+			return template.Diff{}, err
+		}
+
+		logger.Infof(ctx, "Metadata snapshot saved to %s", options.DumpMetadata)
+		return template.Diff{}, nil
+	}
+
+	if options.Check {
+		return template.CheckSchemaContext(ctx, options.DestDir, snapshot.Schema, generatorTemplate)
 	}
 
-	schemaMetadata := metadata.GetSchema(db, &sqliteQuerySet{}, "")
+	return template.Diff{}, template.ProcessSchemaContext(ctx, options.DestDir, snapshot.Schema, generatorTemplate)
+}
+
+// snapshot loads metadata snapshot file, or retrieves metadata snapshot from the database
+func (o Options) snapshot(ctx context.Context, filters metadata.Filters) (metadata.Snapshot, error) {
+	if o.FromMetadata != "" {
+		return metadata.LoadDialectSnapshot(o.FromMetadata, DialectName)
+	}
+
+	db, err := sql.Open("sqlite3", o.DSN)
+	if err != nil {
+		return metadata.Snapshot{}, fmt.Errorf("failed to open sqlite database: %w", err)
+	}
+	defer utils.DBClose(db)
+
+	logger.Infof(ctx, "Retrieving schema information...")
+
+	schemaMetaData, err := metadata.GetSchemaContext(ctx, db, &sqliteQuerySet{}, "", filters)
+	if err != nil {
+		return metadata.Snapshot{}, err
+	}
+
+	return metadata.Snapshot{
+		Dialect: DialectName,
+		Schema:  schemaMetaData,
+	}, nil
+}
+
+func optionalTemplate(templates []template.Template) *template.Template {
+	if len(templates) > 0 {
+		return &templates[0]
+	}
 
-	template.ProcessSchema(destDir, schemaMetadata, generatorTemplate)
-	return
+	return nil
 }
@@ -0,0 +1,25 @@
+package sqlite
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRun(t *testing.T) {
+	dir, err := ioutil.TempDir("", "jet-sqlite")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	ctx := context.Background()
+
+	_, err = Run(ctx, Options{FromMetadata: filepath.Join(dir, "missing.json"), DestDir: filepath.Join(dir, "gen")})
+	require.Error(t, err)
+
+	_, err = Run(ctx, Options{DSN: "file:" + filepath.Join(dir, "test.db"), DumpMetadata: filepath.Join(dir, "metadata.json"), Check: true})
+	require.EqualError(t, err, "DumpMetadata option can not be used together with FromMetadata or Check options")
+}
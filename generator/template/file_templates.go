@@ -22,6 +22,9 @@ package {{package}}
 
 import (
 	"github.com/go-jet/jet/v2/{{dialect.PackageName}}"
+{{- range enumImports}}
+	"{{.}}"
+{{- end}}
 )
 
 var {{tableTemplate.InstanceName}} = new{{tableTemplate.TypeName}}("{{schemaName}}", "{{.Name}}", "")
@@ -32,11 +35,17 @@ type {{structImplName}} struct {
 	//Columns
 {{- range $i, $c := .Columns}}
 {{- $field := columnField $c}}
-	{{$field.Name}} {{dialect.PackageName}}.Column{{$field.Type}}
+{{- with commentLines $field.Comment}}
+{{range .}}
+	// {{.}}
+{{- end}}
+{{- end}}
+	{{$field.Name}} {{columnType $field}}
 {{- end}}
 
-	AllColumns     {{dialect.PackageName}}.ColumnList
-	MutableColumns {{dialect.PackageName}}.ColumnList
+	AllColumns         {{dialect.PackageName}}.ColumnList
+	MutableColumns     {{dialect.PackageName}}.ColumnList
+	DefaultableColumns {{dialect.PackageName}}.ColumnList
 }
 
 type {{tableTemplate.TypeName}} struct {
@@ -64,6 +73,39 @@ func (a {{tableTemplate.TypeName}}) WithPrefix(prefix string) *{{tableTemplate.T
 func (a {{tableTemplate.TypeName}}) WithSuffix(suffix string) *{{tableTemplate.TypeName}} {
 	return new{{tableTemplate.TypeName}}(a.SchemaName(), a.TableName()+suffix, a.TableName())
 }
+{{- range relationships}}
+
+// {{.Name}} returns join condition of {{.ForeignKey.Name}} foreign key, referencing {{.ForeignKey.ReferencedTable}} table.
+// Referenced table can be replaced with an aliased one, for instance {{.Name}}({{.ReferencedInstanceName}}.AS("alias")).
+func (a {{tableTemplate.TypeName}}) {{.Name}}(referenced ...*{{.ReferencedTypeName}}) {{dialect.PackageName}}.BoolExpression {
+	ref := {{.ReferencedInstanceName}}
+	if len(referenced) > 0 {
+		ref = referenced[0]
+	}
+
+	return {{range $i, $c := .Columns}}{{if $i}}.AND({{end}}a.{{$c.Field}}.EQ(ref.{{$c.ReferencedField}}){{if $i}}){{end}}{{end}}
+}
+
+// INNER_JOIN_{{.Name}} creates inner join with referenced {{.ForeignKey.ReferencedTable}} table, using {{.Name}} join condition
+func (a {{tableTemplate.TypeName}}) INNER_JOIN_{{.Name}}(referenced ...*{{.ReferencedTypeName}}) {{dialect.PackageName}}.ReadableTable {
+	ref := {{.ReferencedInstanceName}}
+	if len(referenced) > 0 {
+		ref = referenced[0]
+	}
+
+	return a.INNER_JOIN(ref, a.{{.Name}}(ref))
+}
+
+// LEFT_JOIN_{{.Name}} creates left join with referenced {{.ForeignKey.ReferencedTable}} table, using {{.Name}} join condition
+func (a {{tableTemplate.TypeName}}) LEFT_JOIN_{{.Name}}(referenced ...*{{.ReferencedTypeName}}) {{dialect.PackageName}}.ReadableTable {
+	ref := {{.ReferencedInstanceName}}
+	if len(referenced) > 0 {
+		ref = referenced[0]
+	}
+
+	return a.LEFT_JOIN(ref, a.{{.Name}}(ref))
+}
+{{- end}}
 
 func new{{tableTemplate.TypeName}}(schemaName, tableName, alias string) *{{tableTemplate.TypeName}} {
 	return &{{tableTemplate.TypeName}}{
@@ -76,10 +118,11 @@ func new{{tableTemplate.TypeName}}Impl(schemaName, tableName, alias string) {{st
 	var (
 {{- range $i, $c := .Columns}}
 {{- $field := columnField $c}}
-		{{$field.Name}}Column = {{dialect.PackageName}}.{{$field.Type}}Column("{{$c.Name}}")
+		{{$field.Name}}Column = {{columnConstructor $field}}("{{$c.Name}}")
 {{- end}}
 		allColumns     = {{dialect.PackageName}}.ColumnList{ {{template "column-list" .Columns}} }
 		mutableColumns = {{dialect.PackageName}}.ColumnList{ {{template "column-list" .MutableColumns}} }
+		defaultableColumns = {{dialect.PackageName}}.ColumnList{ {{template "column-list" .DefaultableColumns}} }
 	)
 
 	return {{structImplName}}{
@@ -91,8 +134,9 @@ func new{{tableTemplate.TypeName}}Impl(schemaName, tableName, alias string) {{st
 		{{$field.Name}}: {{$field.Name}}Column,
 {{- end}}
 
-		AllColumns:     allColumns,
-		MutableColumns: mutableColumns,
+		AllColumns:         allColumns,
+		MutableColumns:     mutableColumns,
+		DefaultableColumns: defaultableColumns,
 	}
 }
 `
@@ -109,6 +153,104 @@ func UseSchema(schema string) {
 }
 `
 
+var routineSQLBuilderTemplate = `package {{package}}
+{{- $dialect := dialect.PackageName}}
+{{- $routineTemplate := routineTemplate}}
+
+import (
+	"github.com/go-jet/jet/v2/{{$dialect}}"
+{{- range enumImports}}
+	"{{.}}"
+{{- end}}
+)
+
+{{- if .IsProcedure}}
+
+// {{$routineTemplate.FuncName}} creates CALL statement for {{.Name}} stored procedure
+func {{$routineTemplate.FuncName}}({{range $i, $p := parameters}}{{if $i}}, {{end}}{{$p.Name}} {{$p.Type}}{{end}}) {{$dialect}}.CallStatement {
+	return {{$dialect}}.CALL(routineName("{{.Name}}"){{range parameters}}, {{.Name}}{{end}})
+}
+{{- else if .IsTableFunction}}
+
+// {{$routineTemplate.FuncName}} calls {{.Name}} database function. Returned rows can be used as a table in FROM clause.
+func {{$routineTemplate.FuncName}}({{range $i, $p := parameters}}{{if $i}}, {{end}}{{$p.Name}} {{$p.Type}}{{end}}) *{{$routineTemplate.TypeName}} {
+	return new{{$routineTemplate.TypeName}}(currentSchema, "{{.Name}}", ""{{range parameters}}, {{.Name}}{{end}})
+}
+
+type {{$routineTemplate.TypeName}} struct {
+	{{$dialect}}.TableFunction
+
+	//Columns
+{{- range $i, $c := .ResultColumns}}
+{{- $field := columnField $c}}
+	{{$field.Name}} {{columnType $field}}
+{{- end}}
+
+	AllColumns {{$dialect}}.ColumnList
+
+	args []{{$dialect}}.Expression
+}
+
+// AS creates new {{$routineTemplate.TypeName}} with assigned alias
+func (a {{$routineTemplate.TypeName}}) AS(alias string) *{{$routineTemplate.TypeName}} {
+	return new{{$routineTemplate.TypeName}}(a.SchemaName(), a.TableName(), alias, a.args...)
+}
+
+func new{{$routineTemplate.TypeName}}(schemaName, functionName, alias string, args ...{{$dialect}}.Expression) *{{$routineTemplate.TypeName}} {
+	var (
+{{- range $i, $c := .ResultColumns}}
+{{- $field := columnField $c}}
+		{{$field.Name}}Column = {{columnConstructor $field}}("{{$c.Name}}")
+{{- end}}
+		allColumns = {{$dialect}}.ColumnList{ {{- range $i, $c := .ResultColumns}}{{if $i}}, {{end}}{{(columnField $c).Name}}Column{{end}} }
+	)
+
+	return &{{$routineTemplate.TypeName}}{
+		TableFunction: {{$dialect}}.NewTableFunction(schemaName, functionName, alias, args, allColumns...),
+
+		//Columns
+{{- range $i, $c := .ResultColumns}}
+{{- $field := columnField $c}}
+		{{$field.Name}}: {{$field.Name}}Column,
+{{- end}}
+
+		AllColumns: allColumns,
+
+		args: args,
+	}
+}
+{{- else}}
+
+// {{$routineTemplate.FuncName}} calls {{.Name}} database function
+func {{$routineTemplate.FuncName}}({{range $i, $p := parameters}}{{if $i}}, {{end}}{{$p.Name}} {{$p.Type}}{{end}}) {{returnType}} {
+{{- if returnTypeWrapper}}
+	return {{returnTypeWrapper}}({{$dialect}}.Func(routineName("{{.Name}}"){{range parameters}}, {{.Name}}{{end}}))
+{{- else}}
+	return {{$dialect}}.Func(routineName("{{.Name}}"){{range parameters}}, {{.Name}}{{end}})
+{{- end}}
+}
+{{- end}}
+`
+
+var routineSQLBuilderSetSchemaTemplate = `package {{package}}
+
+var currentSchema = "{{.Name}}"
+
+// UseSchema changes schema of all the database routines in this package. Passing an empty string to this function
+// will cause routines to be called without schema prefix.
+func UseSchema(schema string) {
+	currentSchema = schema
+}
+
+func routineName(name string) string {
+	if currentSchema == "" {
+		return name
+	}
+
+	return currentSchema + "." + name
+}
+`
+
 var tableModelFileTemplate = `package {{package}}
 
 {{ with modelImports }}
@@ -120,9 +262,15 @@ import (
 {{end}}
 
 {{$modelTableTemplate := tableTemplate}}
+{{- range commentLines $modelTableTemplate.Comment}}
+// {{.}}
+{{- end}}
 type {{$modelTableTemplate.TypeName}} struct {
 {{- range .Columns}}
 {{- $field := structField .}}
+{{- range commentLines $field.Comment}}
+	// {{.}}
+{{- end}}
 	{{$field.Name}} {{$field.Type.Name}} ` + "{{$field.TagsString}}" + `
 {{- end}}
 }
@@ -130,24 +278,149 @@ type {{$modelTableTemplate.TypeName}} struct {
 `
 
 var enumSQLBuilderTemplate = `package {{package}}
+{{- $dialect := dialect.PackageName}}
+{{- $enumTemplate := enumTemplate}}
+{{- $typeName := $enumTemplate.TypeName}}
+{{- $implName := enumTypeImplName}}
+
+import "github.com/go-jet/jet/v2/{{$dialect}}"
+
+{{- if and $enumTemplate.TypedColumns $typeName}}
+
+var {{$enumTemplate.InstanceName}} = &struct {
+{{- range $index, $value := .Values}}
+	{{enumValueName $value}} {{$typeName}}Expression
+{{- end}}
+} {
+{{- range $index, $value := .Values}}
+	{{enumValueName $value}}: {{$typeName}}Exp({{$dialect}}.NewEnumValue("{{$value}}")),
+{{- end}}
+}
+
+// {{$typeName}}Expression is expression of {{.Name}} enum type. IN and NOT_IN operators are inherited from Expression.
+type {{$typeName}}Expression interface {
+	{{$dialect}}.Expression
+	{{$implName}}Operators
+}
+
+// Column{{$typeName}} is column of {{.Name}} enum type
+type Column{{$typeName}} interface {
+	{{$dialect}}.Column
+	{{$implName}}Operators
+
+	From(subQuery {{$dialect}}.SelectTable) Column{{$typeName}}
+	SET(value {{$typeName}}Expression) {{$dialect}}.ColumnAssigment
+}
+
+type {{$implName}}Operators interface {
+	EQ(rhs {{$typeName}}Expression) {{$dialect}}.BoolExpression
+	NOT_EQ(rhs {{$typeName}}Expression) {{$dialect}}.BoolExpression
+	IS_DISTINCT_FROM(rhs {{$typeName}}Expression) {{$dialect}}.BoolExpression
+	IS_NOT_DISTINCT_FROM(rhs {{$typeName}}Expression) {{$dialect}}.BoolExpression
+
+	// AsString returns enum expression as string expression, for string operators and functions
+	AsString() {{$dialect}}.StringExpression
+
+	is{{$typeName}}()
+}
+
+// {{$typeName}}Exp is {{.Name}} enum expression wrapper around arbitrary expression.
+// Allows go compiler to see any expression as {{.Name}} enum expression.
+// Does not add sql cast to generated sql builder output.
+func {{$typeName}}Exp(expression {{$dialect}}.Expression) {{$typeName}}Expression {
+	return {{$implName}}Expression{Expression: expression}
+}
+
+type {{$implName}}Expression struct {
+	{{$dialect}}.Expression
+}
+
+func (e {{$implName}}Expression) is{{$typeName}}() {}
+
+func (e {{$implName}}Expression) EQ(rhs {{$typeName}}Expression) {{$dialect}}.BoolExpression {
+	return {{$dialect}}.StringExp(e.Expression).EQ({{$dialect}}.StringExp(rhs))
+}
+
+func (e {{$implName}}Expression) NOT_EQ(rhs {{$typeName}}Expression) {{$dialect}}.BoolExpression {
+	return {{$dialect}}.StringExp(e.Expression).NOT_EQ({{$dialect}}.StringExp(rhs))
+}
+
+func (e {{$implName}}Expression) IS_DISTINCT_FROM(rhs {{$typeName}}Expression) {{$dialect}}.BoolExpression {
+	return {{$dialect}}.StringExp(e.Expression).IS_DISTINCT_FROM({{$dialect}}.StringExp(rhs))
+}
+
+func (e {{$implName}}Expression) IS_NOT_DISTINCT_FROM(rhs {{$typeName}}Expression) {{$dialect}}.BoolExpression {
+	return {{$dialect}}.StringExp(e.Expression).IS_NOT_DISTINCT_FROM({{$dialect}}.StringExp(rhs))
+}
+
+func (e {{$implName}}Expression) AsString() {{$dialect}}.StringExpression {
+	return {{$dialect}}.StringExp(e.Expression)
+}
+
+// {{$typeName}}Column creates named {{.Name}} enum column
+func {{$typeName}}Column(name string) Column{{$typeName}} {
+	return new{{$typeName}}Column({{$dialect}}.StringColumn(name))
+}
+
+type {{$implName}}Column struct {
+	{{$dialect}}.Column
+	column {{$dialect}}.ColumnString
+}
+
+func new{{$typeName}}Column(column {{$dialect}}.ColumnString) Column{{$typeName}} {
+	return {{$implName}}Column{Column: column, column: column}
+}
+
+func (c {{$implName}}Column) is{{$typeName}}() {}
+
+func (c {{$implName}}Column) EQ(rhs {{$typeName}}Expression) {{$dialect}}.BoolExpression {
+	return c.column.EQ({{$dialect}}.StringExp(rhs))
+}
+
+func (c {{$implName}}Column) NOT_EQ(rhs {{$typeName}}Expression) {{$dialect}}.BoolExpression {
+	return c.column.NOT_EQ({{$dialect}}.StringExp(rhs))
+}
+
+func (c {{$implName}}Column) IS_DISTINCT_FROM(rhs {{$typeName}}Expression) {{$dialect}}.BoolExpression {
+	return c.column.IS_DISTINCT_FROM({{$dialect}}.StringExp(rhs))
+}
+
+func (c {{$implName}}Column) IS_NOT_DISTINCT_FROM(rhs {{$typeName}}Expression) {{$dialect}}.BoolExpression {
+	return c.column.IS_NOT_DISTINCT_FROM({{$dialect}}.StringExp(rhs))
+}
+
+func (c {{$implName}}Column) AsString() {{$dialect}}.StringExpression {
+	return c.column
+}
 
-import "github.com/go-jet/jet/v2/{{dialect.PackageName}}"
+func (c {{$implName}}Column) From(subQuery {{$dialect}}.SelectTable) Column{{$typeName}} {
+	return new{{$typeName}}Column(c.column.From(subQuery))
+}
+
+func (c {{$implName}}Column) SET(value {{$typeName}}Expression) {{$dialect}}.ColumnAssigment {
+	return c.column.SET({{$dialect}}.StringExp(value))
+}
+{{- else}}
 
-var {{enumTemplate.InstanceName}} = &struct {
+var {{$enumTemplate.InstanceName}} = &struct {
 {{- range $index, $value := .Values}}
-	{{enumValueName $value}} {{dialect.PackageName}}.StringExpression
+	{{enumValueName $value}} {{$dialect}}.StringExpression
 {{- end}}
 } {
 {{- range $index, $value := .Values}}
-	{{enumValueName $value}}: {{dialect.PackageName}}.NewEnumValue("{{$value}}"),
+	{{enumValueName $value}}: {{$dialect}}.NewEnumValue("{{$value}}"),
 {{- end}}
 }
+{{- end}}
 `
 
 var enumModelTemplate = `package {{package}}
 {{- $enumTemplate := enumTemplate}}
 
-import "errors"
+import (
+	"database/sql/driver"
+	"errors"
+)
 
 type {{$enumTemplate.TypeName}} string
 
@@ -157,6 +430,25 @@ const (
 {{- end}}
 )
 
+// AllValues returns all the valid values of {{$enumTemplate.TypeName}} enum
+func (e {{$enumTemplate.TypeName}}) AllValues() []{{$enumTemplate.TypeName}} {
+	return []{{$enumTemplate.TypeName}}{
+{{- range $_, $value := .Values}}
+		{{valueName $value}},
+{{- end}}
+	}
+}
+
+// IsValid checks if e is one of the valid {{$enumTemplate.TypeName}} enum values
+func (e {{$enumTemplate.TypeName}}) IsValid() bool {
+	switch e {
+	case {{range $i, $value := .Values}}{{if $i}}, {{end}}{{valueName $value}}{{end}}:
+		return true
+	}
+
+	return false
+}
+
 func (e *{{$enumTemplate.TypeName}}) Scan(value interface{}) error {
 	var enumValue string
 	switch val := value.(type) {
@@ -165,23 +457,178 @@ func (e *{{$enumTemplate.TypeName}}) Scan(value interface{}) error {
 	case []byte:
 		enumValue = string(val)
 	default:
-		return errors.New("jet: Invalid scan value for AllTypesEnum enum. Enum value has to be of type string or []byte")
+		return errors.New("jet: Invalid scan value for {{$enumTemplate.TypeName}} enum. Enum value has to be of type string or []byte")
 	}
 
-	switch enumValue {
-{{- range $_, $value := .Values}}
-	case "{{$value}}":
-		*e = {{valueName $value}}
-{{- end}}
-	default:
+	if !{{$enumTemplate.TypeName}}(enumValue).IsValid() {
 		return errors.New("jet: Invalid scan value '" + enumValue + "' for {{$enumTemplate.TypeName}} enum")
 	}
 
+	*e = {{$enumTemplate.TypeName}}(enumValue)
+
 	return nil
 }
 
+// Value implements driver.Valuer interface. Returns an error if e is not valid {{$enumTemplate.TypeName}} enum value.
+func (e {{$enumTemplate.TypeName}}) Value() (driver.Value, error) {
+	if !e.IsValid() {
+		return nil, errors.New("jet: Invalid value '" + string(e) + "' for {{$enumTemplate.TypeName}} enum")
+	}
+
+	return string(e), nil
+}
+
 func (e {{$enumTemplate.TypeName}}) String() string {
 	return string(e)
 }
 
 `
+
+var tableRepositoryTemplate = `package {{package}}
+{{- $dialect := dialect.PackageName}}
+{{- $table := .SQLBuilder}}
+{{- $typeName := .Template.TypeName}}
+
+import (
+	"context"
+{{- range .StdImports}}
+	"{{.}}"
+{{- end}}
+
+	"github.com/go-jet/jet/v2/{{$dialect}}"
+	"github.com/go-jet/jet/v2/qrm"
+{{- range .Imports}}
+	"{{.}}"
+{{- end}}
+)
+
+{{- define "pk-params"}}{{range .}}, {{.Param}} {{.ParamType}}{{end}}{{end}}
+{{- define "pk-args"}}{{range $i, $c := .}}{{if $i}}, {{end}}{{$c.Param}}{{end}}{{end}}
+{{- define "pk-fields"}}{{range $i, $c := .}}{{if $i}}, {{end}}m.{{$c.Field}}{{end}}{{end}}
+{{- define "columns"}}{{range $i, $c := .Columns}}{{if $i}}, {{end}}{{$.Table}}.{{$c.Column}}{{end}}{{end}}
+
+// {{$typeName}} contains CRUD helpers for {{.Table.Name}} table
+type {{$typeName}} struct{}
+
+// {{.Template.InstanceName}} is {{.Table.Name}} table repository
+var {{.Template.InstanceName}} = {{$typeName}}{}
+
+// FindByPK returns {{.Table.Name}} table row with the primary key, or qrm.ErrNoRows if row does not exist
+func (r {{$typeName}}) FindByPK(ctx context.Context, db qrm.Queryable{{template "pk-params" .PrimaryKey}}) ({{.ModelType}}, error) {
+	var dest {{.ModelType}}
+
+	err := {{$dialect}}.SELECT({{$table}}.AllColumns).
+		FROM({{$table}}).
+		WHERE(r.primaryKeyCondition({{template "pk-args" .PrimaryKey}})).
+		QueryContext(ctx, db, &dest)
+
+	return dest, err
+}
+{{- if isMySQL}}
+
+// Insert inserts model into {{.Table.Name}} table.
+{{- if .DefaultOnZero}} Database default values are inserted for zero-valued fields of the
+// defaultable columns.
+{{- end}}
+{{- if and .LastInsertID .DefaultOnZero}}
+// Zero-valued auto increment primary key field is set to inserted row id.
+{{- else if .LastInsertID}}
+// Auto increment primary key is not inserted, database assigns it and model field is set to inserted row id.
+{{- end}}
+func (r {{$typeName}}) Insert(ctx context.Context, db qrm.Executable, m *{{.ModelType}}) error {
+	{{if .LastInsertID}}result{{else}}_{{end}}, err := {{$table}}.INSERT({{template "columns" (columns .InsertColumns)}}).
+		MODEL({{if .DefaultOnZero}}{{$dialect}}.DefaultOnZero(m, {{$table}}.DefaultableColumns){{else}}m{{end}}).
+		ExecContext(ctx, db)
+{{- if .LastInsertID}}
+
+	if err != nil{{if .DefaultOnZero}} || m.{{.LastInsertID.Field}} != 0{{end}} {
+		return err
+	}
+
+	lastInsertID, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+
+	m.{{.LastInsertID.Field}} = {{.LastInsertID.ParamType}}(lastInsertID)
+
+	return nil
+{{- else}}
+
+	return err
+{{- end}}
+}
+{{- else}}
+
+// Insert inserts model into {{.Table.Name}} table, and updates model with inserted row values.
+{{- if .DefaultOnZero}}
+// Database default values are inserted for zero-valued fields of the defaultable columns.
+{{- else}}
+// Primary key columns with default values are not inserted, database assigns them.
+{{- end}}
+func (r {{$typeName}}) Insert(ctx context.Context, db qrm.Queryable, m *{{.ModelType}}) error {
+	return {{$table}}.INSERT({{template "columns" (columns .InsertColumns)}}).
+		MODEL({{if .DefaultOnZero}}{{$dialect}}.DefaultOnZero(m, {{$table}}.DefaultableColumns){{else}}m{{end}}).
+		RETURNING({{$table}}.AllColumns).
+		QueryContext(ctx, db, m)
+}
+{{- end}}
+{{- if .MutableColumns}}
+
+// Update updates {{.Table.Name}} table row with the model primary key, using model field values
+func (r {{$typeName}}) Update(ctx context.Context, db qrm.Executable, m *{{.ModelType}}) error {
+	_, err := {{$table}}.UPDATE({{$table}}.MutableColumns).
+		MODEL(m).
+		WHERE(r.primaryKeyCondition({{template "pk-fields" .PrimaryKey}})).
+		ExecContext(ctx, db)
+
+	return err
+}
+{{- end}}
+
+// DeleteByPK deletes {{.Table.Name}} table row with the primary key
+func (r {{$typeName}}) DeleteByPK(ctx context.Context, db qrm.Executable{{template "pk-params" .PrimaryKey}}) error {
+	_, err := {{$table}}.DELETE().
+		WHERE(r.primaryKeyCondition({{template "pk-args" .PrimaryKey}})).
+		ExecContext(ctx, db)
+
+	return err
+}
+{{- if isMySQL}}
+
+// Upsert inserts model into {{.Table.Name}} table, or updates existing row with the same primary key
+func (r {{$typeName}}) Upsert(ctx context.Context, db qrm.Executable, m *{{.ModelType}}) error {
+	_, err := {{$table}}.INSERT({{template "columns" (columns .PrimaryKey .MutableColumns)}}).
+		MODEL(m).
+		ON_DUPLICATE_KEY_UPDATE(
+{{- range .UpdateOnConflict}}
+			{{$table}}.{{.Column}}.SET({{.Wrapper}}({{$dialect}}.Func("VALUES", {{$table}}.{{.Column}}))),
+{{- end}}
+		).
+		ExecContext(ctx, db)
+
+	return err
+}
+{{- else}}
+
+// Upsert inserts model into {{.Table.Name}} table, or updates existing row with the same primary key.
+// Model is updated with inserted or updated row values.
+func (r {{$typeName}}) Upsert(ctx context.Context, db qrm.Queryable, m *{{.ModelType}}) error {
+	return {{$table}}.INSERT({{template "columns" (columns .PrimaryKey .MutableColumns)}}).
+		MODEL(m).
+		ON_CONFLICT({{template "columns" (columns .PrimaryKey)}}).
+		DO_UPDATE({{$dialect}}.SET(
+{{- range .UpdateOnConflict}}
+			{{$table}}.{{.Column}}.SET({{$table}}.{{insertedRowAlias}}.{{.Column}}),
+{{- end}}
+		)).
+		RETURNING({{$table}}.AllColumns).
+		QueryContext(ctx, db, m)
+}
+{{- end}}
+
+func (r {{$typeName}}) primaryKeyCondition({{range $i, $c := .PrimaryKey}}{{if $i}}, {{end}}{{$c.Param}} {{$c.ParamType}}{{end}}) {{$dialect}}.BoolExpression {
+	return {{range $i, $c := .PrimaryKey}}{{if $i}}.
+		AND({{end}}{{$table}}.{{$c.Column}}.EQ({{$c.Literal}}){{if $i}}){{end}}{{end}}
+}
+`
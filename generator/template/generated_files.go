@@ -0,0 +1,200 @@
+package template
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/go-jet/jet/v2/internal/utils"
+)
+
+// generatedFiles holds generated go files content in memory, until files are saved or compared with destination
+type generatedFiles struct {
+	dirs  []string
+	files map[string][]byte
+}
+
+func newGeneratedFiles() *generatedFiles {
+	return &generatedFiles{
+		files: map[string][]byte{},
+	}
+}
+
+// addDir adds directory to be created, even if no file is generated in it
+func (g *generatedFiles) addDir(dirPath string) {
+	g.dirs = append(g.dirs, dirPath)
+}
+
+// addGoFile formats go file text and adds it at folder dirPath, with name fileName
+func (g *generatedFiles) addGoFile(dirPath, fileName string, text []byte) error {
+	filePath := filepath.Join(dirPath, fileName)
+
+	if !strings.HasSuffix(filePath, ".go") {
+		filePath += ".go"
+	}
+
+	formatted, err := format.Source(text)
+	if err != nil {
+		return fmt.Errorf("failed to format %s: %w", filePath, err)
+	}
+
+	g.files[filePath] = formatted
+
+	return nil
+}
+
+func (g *generatedFiles) filePaths() []string {
+	var ret []string
+
+	for filePath := range g.files {
+		ret = append(ret, filePath)
+	}
+
+	sort.Strings(ret)
+
+	return ret
+}
+
+// save writes generated files into destination directory dirPath. Only new files and files with changed content are
+// written, and only previously generated files, that are not generated anymore, are deleted. Files without jet
+// generated code header, including files generated by other tools, are never deleted nor overwritten.
+func (g *generatedFiles) save(dirPath string) (Diff, error) {
+	diff, err := g.diff(dirPath)
+	if err != nil {
+		return Diff{}, err
+	}
+
+	for _, filePath := range diff.Changed {
+		text, err := ioutil.ReadFile(filePath)
+		if err != nil {
+			return Diff{}, err
+		}
+
+		if !isGeneratedFile(text) {
+			return Diff{}, fmt.Errorf("file %s is not generated by jet and can not be overwritten", filePath)
+		}
+	}
+
+	for _, filePath := range diff.Removed {
+		if err := os.Remove(filePath); err != nil {
+			return Diff{}, err
+		}
+
+		removeEmptyDirs(filepath.Dir(filePath), dirPath)
+	}
+
+	for _, dirPath := range g.dirs {
+		if err := utils.EnsureDirPath(dirPath); err != nil {
+			return Diff{}, err
+		}
+	}
+
+	for _, filePath := range append(diff.Added, diff.Changed...) {
+		if err := utils.EnsureDirPath(filepath.Dir(filePath)); err != nil {
+			return Diff{}, err
+		}
+
+		if err := ioutil.WriteFile(filePath, g.files[filePath], 0644); err != nil {
+			return Diff{}, err
+		}
+	}
+
+	return diff, nil
+}
+
+// removeEmptyDirs removes dirPath and its parent directories, up to rootPath, as long as they are empty
+func removeEmptyDirs(dirPath, rootPath string) {
+	for dirPath != rootPath && strings.HasPrefix(dirPath, rootPath) {
+		if err := os.Remove(dirPath); err != nil { // directory is not empty
+			return
+		}
+
+		dirPath = filepath.Dir(dirPath)
+	}
+}
+
+var (
+	generatedCodeRegex = regexp.MustCompile(`(?m)^// Code generated by go-jet DO NOT EDIT\.$`)
+	packageClauseRegex = regexp.MustCompile(`(?m)^package `)
+)
+
+// isGeneratedFile returns true if go file has jet generated code comment before package clause
+func isGeneratedFile(text []byte) bool {
+	if packageIndex := packageClauseRegex.FindIndex(text); packageIndex != nil {
+		text = text[:packageIndex[0]]
+	}
+
+	return generatedCodeRegex.Match(text)
+}
+
+// diff compares generated files with the files at destination directory dirPath
+func (g *generatedFiles) diff(dirPath string) (Diff, error) {
+	var diff Diff
+
+	existingFiles := map[string]bool{}
+
+	err := filepath.Walk(dirPath, func(filePath string, info os.FileInfo, err error) error {
+		if os.IsNotExist(err) && filePath == dirPath {
+			return filepath.SkipDir
+		}
+
+		if err != nil || info.IsDir() || filepath.Ext(filePath) != ".go" {
+			return err
+		}
+
+		existingFiles[filePath] = true
+
+		existingText, err := ioutil.ReadFile(filePath)
+		if err != nil {
+			return err
+		}
+
+		text, generated := g.files[filePath]
+
+		if !generated {
+			if isGeneratedFile(existingText) {
+				diff.Removed = append(diff.Removed, filePath)
+			}
+			return nil
+		}
+
+		if !bytes.Equal(text, existingText) {
+			diff.Changed = append(diff.Changed, filePath)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return Diff{}, err
+	}
+
+	for _, filePath := range g.filePaths() {
+		if !existingFiles[filePath] {
+			diff.Added = append(diff.Added, filePath)
+		}
+	}
+
+	return diff, nil
+}
+
+// Diff is the difference between generated files and the files at destination directory
+type Diff struct {
+	// Added are generated files missing at destination directory
+	Added []string
+	// Removed are previously generated destination directory files, that are not generated anymore
+	Removed []string
+	// Changed are destination directory files with outdated content
+	Changed []string
+}
+
+// IsEmpty returns true if destination directory files are up-to-date
+func (d Diff) IsEmpty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
@@ -0,0 +1,190 @@
+package template
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-jet/jet/v2/generator/logger"
+	"github.com/go-jet/jet/v2/generator/metadata"
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/stretchr/testify/require"
+)
+
+const generatedFileText = `//
+// Code generated by go-jet DO NOT EDIT.
+//
+
+package model
+`
+
+var testSchema = metadata.Schema{
+	Name: "dvds",
+	TablesMetaData: []metadata.Table{
+		{
+			Name: "actor",
+			Columns: []metadata.Column{
+				{Name: "actor_id", IsPrimaryKey: true, DataType: metadata.DataType{Name: "integer", Kind: metadata.BaseType}},
+				{Name: "first_name", DataType: metadata.DataType{Name: "text", Kind: metadata.BaseType}},
+			},
+		},
+	},
+}
+
+func TestCheckSchema(t *testing.T) {
+	dir, err := ioutil.TempDir("", "jet-check")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	schema := testSchema
+	generatorTemplate := Default(postgres.Dialect)
+	schemaDir := filepath.Join(dir, "dvds")
+
+	diff := CheckSchema(dir, schema, generatorTemplate)
+	require.Equal(t, Diff{
+		Added: []string{
+			filepath.Join(schemaDir, "model", "actor.go"),
+			filepath.Join(schemaDir, "table", "actor.go"),
+			filepath.Join(schemaDir, "table", "table.go"),
+		},
+	}, diff)
+
+	_, err = os.Stat(schemaDir)
+	require.True(t, os.IsNotExist(err))
+
+	ProcessSchema(dir, schema, generatorTemplate)
+	require.True(t, CheckSchema(dir, schema, generatorTemplate).IsEmpty())
+
+	require.NoError(t, ioutil.WriteFile(filepath.Join(schemaDir, "model", "actor.go"), []byte("package model"), 0644))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(schemaDir, "model", "film.go"), []byte(generatedFileText), 0644))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(schemaDir, "model", "custom.go"), []byte("package model"), 0644))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(schemaDir, "model", "mock.go"),
+		[]byte("// Code generated by mockgen. DO NOT EDIT.\npackage model"), 0644))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(schemaDir, "model", "notes.txt"), []byte(generatedFileText), 0644))
+	require.NoError(t, os.Remove(filepath.Join(schemaDir, "table", "table.go")))
+
+	diff = CheckSchema(dir, schema, generatorTemplate)
+	require.Equal(t, Diff{
+		Added:   []string{filepath.Join(schemaDir, "table", "table.go")},
+		Removed: []string{filepath.Join(schemaDir, "model", "film.go")},
+		Changed: []string{filepath.Join(schemaDir, "model", "actor.go")},
+	}, diff)
+
+	actorModel, err := ioutil.ReadFile(filepath.Join(schemaDir, "model", "actor.go"))
+	require.NoError(t, err)
+	require.Equal(t, "package model", string(actorModel))
+}
+
+func TestCheckSchema_RepositoryTable(t *testing.T) {
+	dir, err := ioutil.TempDir("", "jet-check-repository")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "go.mod"), []byte("module example.com/app\n"), 0644))
+
+	schema := testSchema
+	schema.TablesMetaData = []metadata.Table{testSchema.TablesMetaData[0], testSchema.TablesMetaData[0]}
+	schema.TablesMetaData[1].Name = "repository"
+
+	generatorTemplate := Default(postgres.Dialect).
+		UseSchema(func(schema metadata.Schema) Schema {
+			return DefaultSchema(schema).UseRepository(DefaultRepository())
+		})
+	repositoryDir := filepath.Join(dir, "dvds", "repository")
+
+	diff := CheckSchema(dir, schema, generatorTemplate)
+	require.Contains(t, diff.Added, filepath.Join(repositoryDir, "actor.go"))
+	require.Contains(t, diff.Added, filepath.Join(repositoryDir, "repository.go"))
+
+	ProcessSchema(dir, schema, generatorTemplate)
+
+	files, err := ioutil.ReadDir(repositoryDir)
+	require.NoError(t, err)
+	require.Len(t, files, 2)
+}
+
+func TestProcessSchema_Incremental(t *testing.T) {
+	dir, err := ioutil.TempDir("", "jet-process")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	schema := testSchema
+	generatorTemplate := Default(postgres.Dialect)
+	schemaDir := filepath.Join(dir, "dvds")
+
+	ProcessSchema(dir, schema, generatorTemplate)
+
+	unchangedFile := filepath.Join(schemaDir, "table", "actor.go")
+	changedFile := filepath.Join(schemaDir, "model", "actor.go")
+	staleFile := filepath.Join(schemaDir, "enum", "mood.go")
+	customFile := filepath.Join(schemaDir, "model", "custom.go")
+
+	oldTime := time.Now().Add(-time.Hour).Truncate(time.Second)
+	require.NoError(t, os.Chtimes(unchangedFile, oldTime, oldTime))
+	require.NoError(t, ioutil.WriteFile(changedFile, []byte(generatedFileText), 0644))
+	require.NoError(t, os.MkdirAll(filepath.Dir(staleFile), 0755))
+	require.NoError(t, ioutil.WriteFile(staleFile, []byte(generatedFileText), 0644))
+	require.NoError(t, ioutil.WriteFile(customFile, []byte("package model"), 0644))
+
+	ProcessSchema(dir, schema, generatorTemplate)
+
+	require.True(t, CheckSchema(dir, schema, generatorTemplate).IsEmpty())
+
+	fileInfo, err := os.Stat(unchangedFile)
+	require.NoError(t, err)
+	require.Equal(t, oldTime, fileInfo.ModTime())
+
+	_, err = os.Stat(filepath.Dir(staleFile))
+	require.True(t, os.IsNotExist(err))
+
+	_, err = os.Stat(customFile)
+	require.NoError(t, err)
+
+	require.NoError(t, ioutil.WriteFile(changedFile, []byte("package model"), 0644))
+
+	require.PanicsWithError(t, "file "+changedFile+" is not generated by jet and can not be overwritten", func() {
+		ProcessSchema(dir, schema, generatorTemplate)
+	})
+}
+
+func TestIsGeneratedFile(t *testing.T) {
+	require.True(t, isGeneratedFile([]byte(generatedFileText)))
+	require.False(t, isGeneratedFile([]byte("// Code generated by mockgen. DO NOT EDIT.\npackage mock")))
+	require.False(t, isGeneratedFile([]byte("package model\n\n// Code generated by go-jet DO NOT EDIT.\n")))
+	require.False(t, isGeneratedFile([]byte("package model")))
+}
+
+func TestProcessSchemaContext(t *testing.T) {
+	dir, err := ioutil.TempDir("", "jet-process-context")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	var messages []string
+	ctx := logger.WithLogger(context.Background(), logger.Func(func(level logger.Level, message string) {
+		messages = append(messages, level.String()+": "+message)
+	}))
+
+	schema := testSchema
+	schema.TablesMetaData = append([]metadata.Table{}, testSchema.TablesMetaData...)
+	schema.TablesMetaData[0].Columns = append(schema.TablesMetaData[0].Columns,
+		metadata.Column{Name: "location", DataType: metadata.DataType{Name: "geometry", Kind: metadata.BaseType}})
+
+	require.NoError(t, ProcessSchemaContext(ctx, dir, schema, Default(postgres.Dialect)))
+	require.Contains(t, messages, "info: Destination directory: "+filepath.Join(dir, "dvds"))
+	require.Contains(t, messages, "warning: - [Model      ] Unsupported sql column 'location geometry', using string instead.")
+	require.Contains(t, messages, "warning: - [SQL Builder] Unsupported sql column 'location geometry', using StringColumn instead.")
+	require.Contains(t, messages, "info: \t3 file(s) added, 0 file(s) changed, 0 file(s) removed")
+
+	canceledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+
+	require.ErrorIs(t, ProcessSchemaContext(canceledCtx, filepath.Join(dir, "canceled"), schema, Default(postgres.Dialect)), context.Canceled)
+	_, err = os.Stat(filepath.Join(dir, "canceled"))
+	require.True(t, os.IsNotExist(err))
+
+	invalidFilters := Default(postgres.Dialect).UseFilters(metadata.Filters{Tables: metadata.Filter{Include: []string{"/(/"}}})
+	_, err = CheckSchemaContext(ctx, dir, schema, invalidFilters)
+	require.EqualError(t, err, "invalid filter pattern /(/: error parsing regexp: missing closing ): `(`")
+}
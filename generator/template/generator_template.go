@@ -9,6 +9,8 @@ import (
 type Template struct {
 	Dialect jet.Dialect
 	Schema  func(schemaMetaData metadata.Schema) Schema
+	// Filters are applied while schema metadata is retrieved from database (or loaded from snapshot file)
+	Filters metadata.Filters
 }
 
 // Default is default generator template implementation
@@ -25,11 +27,23 @@ func (t Template) UseSchema(schemaFunc func(schemaMetaData metadata.Schema) Sche
 	return t
 }
 
+// UseFilters returns new generator template with schema metadata filters set. Filtered out tables, views, enums and
+// columns are not generated, and filtered out tables and views are not even queried for columns, for instance:
+//
+//	template.Default(postgres.Dialect).UseFilters(metadata.Filters{
+//		Tables: metadata.Filter{Exclude: []string{"audit_*", `/^log_\d+$/`}},
+//	})
+func (t Template) UseFilters(filters metadata.Filters) Template {
+	t.Filters = filters
+	return t
+}
+
 // Schema is schema generator template used to generate schema(model and sql builder) files
 type Schema struct {
 	Path       string
 	Model      Model
 	SQLBuilder SQLBuilder
+	Repository Repository
 }
 
 // UsePath replaces path and returns new schema template
@@ -50,6 +64,15 @@ func (s Schema) UseSQLBuilder(sqlBuilder SQLBuilder) Schema {
 	return s
 }
 
+// UseRepository returns new schema with replaced template for repository files generation.
+// Repository files are generated only if schema template has repository template set, for instance:
+//
+//	template.DefaultSchema(schema).UseRepository(template.DefaultRepository())
+func (s Schema) UseRepository(repository Repository) Schema {
+	s.Repository = repository
+	return s
+}
+
 // DefaultSchema returns default schema template implementation
 func DefaultSchema(schemaMetaData metadata.Schema) Schema {
 	return Schema{
@@ -1,9 +1,12 @@
 package template
 
 import (
+	"database/sql"
 	"fmt"
 	"github.com/go-jet/jet/v2/generator/metadata"
 	"github.com/go-jet/jet/v2/internal/utils"
+	"github.com/go-jet/jet/v2/null"
+	"github.com/go-jet/jet/v2/postgres"
 	"github.com/google/uuid"
 	"path"
 	"reflect"
@@ -65,6 +68,7 @@ type TableModel struct {
 	Skip     bool
 	FileName string
 	TypeName string
+	Comment  string
 	Field    func(columnMetaData metadata.Column) TableModelField
 }
 
@@ -79,6 +83,7 @@ func DefaultTableModel(tableMetaData metadata.Table) TableModel {
 	return TableModel{
 		FileName: utils.ToGoFileName(tableMetaData.Name),
 		TypeName: utils.ToGoIdentifier(tableMetaData.Name),
+		Comment:  tableMetaData.Comment,
 		Field:    DefaultTableModelField,
 	}
 }
@@ -95,6 +100,12 @@ func (t TableModel) UseTypeName(typeName string) TableModel {
 	return t
 }
 
+// UseComment returns new TableModel with new type doc comment set
+func (t TableModel) UseComment(comment string) TableModel {
+	t.Comment = comment
+	return t
+}
+
 // UseField returns new TableModel with new TableModelField template function
 func (t TableModel) UseField(structFieldFunc func(columnMetaData metadata.Column) TableModelField) TableModel {
 	t.Field = structFieldFunc
@@ -105,9 +116,8 @@ func getTableModelImports(modelType TableModel, tableMetaData metadata.Table) []
 	importPaths := map[string]bool{}
 	for _, columnMetaData := range tableMetaData.Columns {
 		field := modelType.Field(columnMetaData)
-		importPath := field.Type.ImportPath
 
-		if importPath != "" {
+		for _, importPath := range field.Type.ImportPaths() {
 			importPaths[importPath] = true
 		}
 	}
@@ -155,13 +165,28 @@ func DefaultEnumModel(enumMetaData metadata.Enum) EnumModel {
 
 // TableModelField is template for table model field generation
 type TableModelField struct {
-	Name string
-	Type Type
-	Tags []string
+	Name    string
+	Type    Type
+	Tags    []string
+	Comment string
 }
 
-// DefaultTableModelField returns default TableModelField implementation
+// DefaultTableModelField returns default TableModelField implementation. Nullable column fields are pointers.
 func DefaultTableModelField(columnMetaData metadata.Column) TableModelField {
+	return newTableModelField(columnMetaData, NullPointer)
+}
+
+// TableModelFieldWithNullType returns TableModelField template function, with nullable column field types generated
+// for nullType. For instance:
+//
+//	template.DefaultTableModel(table).UseField(template.TableModelFieldWithNullType(template.NullGeneric))
+func TableModelFieldWithNullType(nullType NullType) func(columnMetaData metadata.Column) TableModelField {
+	return func(columnMetaData metadata.Column) TableModelField {
+		return newTableModelField(columnMetaData, nullType)
+	}
+}
+
+func newTableModelField(columnMetaData metadata.Column, nullType NullType) TableModelField {
 	var tags []string
 
 	if columnMetaData.IsPrimaryKey {
@@ -169,9 +194,10 @@ func DefaultTableModelField(columnMetaData metadata.Column) TableModelField {
 	}
 
 	return TableModelField{
-		Name: utils.ToGoIdentifier(columnMetaData.Name),
-		Type: getType(columnMetaData),
-		Tags: tags,
+		Name:    utils.ToGoIdentifier(columnMetaData.Name),
+		Type:    getType(columnMetaData, nullType),
+		Tags:    tags,
+		Comment: columnMetaData.Comment,
 	}
 }
 
@@ -193,6 +219,12 @@ func (f TableModelField) UseTags(tags ...string) TableModelField {
 	return f
 }
 
+// UseComment returns new TableModelField implementation with new field doc comment set
+func (f TableModelField) UseComment(comment string) TableModelField {
+	f.Comment = comment
+	return f
+}
+
 // TagsString returns tags string representation
 func (f TableModelField) TagsString() string {
 	if len(f.Tags) == 0 {
@@ -205,7 +237,66 @@ func (f TableModelField) TagsString() string {
 // Type represents type of the struct field
 type Type struct {
 	ImportPath string
-	Name       string
+	// AdditionalImportPaths are import paths of type arguments, for instance time for null.Null[time.Time]
+	AdditionalImportPaths []string
+	Name                  string
+}
+
+// ImportPaths returns all the import paths needed by the type
+func (t Type) ImportPaths() []string {
+	var ret []string
+
+	for _, importPath := range append([]string{t.ImportPath}, t.AdditionalImportPaths...) {
+		if importPath != "" && !utils.StringSliceContains(ret, importPath) {
+			ret = append(ret, importPath)
+		}
+	}
+
+	return ret
+}
+
+// NullType determines go type of nullable column model fields
+type NullType string
+
+const (
+	// NullPointer nullable column fields are pointers, for instance *int32
+	NullPointer NullType = "pointer"
+	// NullSQL nullable column fields are database/sql null types, for instance sql.NullInt32. Fields of nullable
+	// columns without matching database/sql null type are pointers.
+	NullSQL NullType = "sql"
+	// NullGeneric nullable column fields are generic null.Null types, for instance null.Null[int32]
+	NullGeneric NullType = "generic"
+)
+
+var sqlNullTypes = map[string]Type{
+	"bool":      NewType(sql.NullBool{}),
+	"uint8":     NewType(sql.NullByte{}),
+	"int16":     NewType(sql.NullInt16{}),
+	"int32":     NewType(sql.NullInt32{}),
+	"int64":     NewType(sql.NullInt64{}),
+	"float64":   NewType(sql.NullFloat64{}),
+	"string":    NewType(sql.NullString{}),
+	"time.Time": NewType(sql.NullTime{}),
+}
+
+// NullableType returns type of the nullable column model field, with value of type valueType, for the nullType
+func NullableType(valueType Type, nullType NullType) Type {
+	switch nullType {
+	case NullSQL:
+		if sqlNullType, ok := sqlNullTypes[valueType.Name]; ok {
+			return sqlNullType
+		}
+	case NullGeneric:
+		return Type{
+			ImportPath:            getImportPath(null.Null[int]{}),
+			AdditionalImportPaths: valueType.ImportPaths(),
+			Name:                  "null.Null[" + valueType.Name + "]",
+		}
+	}
+
+	valueType.Name = "*" + valueType.Name
+
+	return valueType
 }
 
 // NewType creates new type for dummy object
@@ -225,23 +316,37 @@ func getTypeName(t interface{}) string {
 
 func getImportPath(dummyData interface{}) string {
 	dataType := reflect.TypeOf(dummyData)
-	if dataType.Kind() == reflect.Ptr {
-		return dataType.Elem().PkgPath()
+	for dataType.Kind() == reflect.Ptr || dataType.Kind() == reflect.Slice {
+		dataType = dataType.Elem()
 	}
 	return dataType.PkgPath()
 }
 
-func getType(columnMetadata metadata.Column) Type {
-	userDefinedType := getUserDefinedType(columnMetadata)
+func getType(columnMetadata metadata.Column, nullType NullType) Type {
+	if arrayType := getArrayGoType(columnMetadata); arrayType != nil {
+		return NewType(arrayType)
+	}
 
-	if userDefinedType != "" {
-		if columnMetadata.IsNullable {
-			return Type{Name: "*" + userDefinedType}
-		}
-		return Type{Name: userDefinedType}
+	var valueType Type
+
+	if userDefinedType := getUserDefinedType(columnMetadata); userDefinedType != "" {
+		valueType = Type{Name: userDefinedType}
+	} else if goType := toGoType(columnMetadata); goType != nil {
+		valueType = NewType(goType)
+	} else {
+		valueType = NewType("") // unsupported column types are mapped to string
+	}
+
+	if columnMetadata.IsNullable {
+		return NullableType(valueType, nullType)
 	}
 
-	return NewType(getGoType(columnMetadata))
+	return valueType
+}
+
+// isUnsupportedModelType returns true if there is no model type for the column database type
+func isUnsupportedModelType(column metadata.Column) bool {
+	return getArrayGoType(column) == nil && getUserDefinedType(column) == "" && toGoType(column) == nil
 }
 
 func getUserDefinedType(column metadata.Column) string {
@@ -255,17 +360,48 @@ func getUserDefinedType(column metadata.Column) string {
 	return ""
 }
 
-func getGoType(column metadata.Column) interface{} {
-	defaultGoType := toGoType(column)
+// getArrayGoType returns slice model type for single dimensional array columns,
+// or nil if column is not an array or array element type is not supported.
+func getArrayGoType(column metadata.Column) interface{} {
+	if column.DataType.Kind != metadata.ArrayType || column.DataType.Dimensions > 1 {
+		return nil
+	}
+
+	var elemType interface{}
+
+	switch strings.ToLower(column.DataType.Name) {
+	case "bool":
+		elemType = false
+	case "int2":
+		elemType = int16(0)
+	case "int4":
+		elemType = int32(0)
+	case "int8":
+		elemType = int64(0)
+	case "float4":
+		elemType = float32(0.0)
+	case "float8", "numeric":
+		elemType = float64(0.0)
+	case "text", "bpchar", "varchar":
+		elemType = ""
+	case "uuid":
+		elemType = uuid.UUID{}
+	case "date", "time", "timetz", "timestamp", "timestamptz":
+		elemType = time.Time{}
+	default:
+		return nil
+	}
+
+	sliceType := reflect.SliceOf(reflect.TypeOf(elemType))
 
 	if column.IsNullable {
-		return reflect.New(reflect.TypeOf(defaultGoType)).Interface()
+		return reflect.New(sliceType).Interface()
 	}
 
-	return defaultGoType
+	return reflect.Zero(sliceType).Interface()
 }
 
-// toGoType returns model type for column info.
+// toGoType returns model type for column info, or nil if column type is not supported.
 func toGoType(column metadata.Column) interface{} {
 	switch strings.ToLower(column.DataType.Name) {
 	case "user-defined", "enum":
@@ -307,7 +443,7 @@ func toGoType(column metadata.Column) interface{} {
 	case "text",
 		"character", "bpchar",
 		"character varying", "varchar", "nvarchar",
-		"tsvector", "bit", "bit varying", "varbit",
+		"tsvector", "tsquery", "bit", "bit varying", "varbit",
 		"money", "json", "jsonb",
 		"xml", "point", "interval", "line", "array",
 		"char", "tinytext", "mediumtext", "longtext": // MySQL
@@ -320,8 +456,23 @@ func toGoType(column metadata.Column) interface{} {
 		return float64(0.0)
 	case "uuid":
 		return uuid.UUID{}
+	case "int4range":
+		return postgres.Int32Range{}
+	case "int8range":
+		return postgres.Int64Range{}
+	case "numrange":
+		return postgres.Float64Range{}
+	case "daterange", "tsrange", "tstzrange":
+		return postgres.TimeRange{}
+	case "int4multirange":
+		return postgres.Int32Multirange{}
+	case "int8multirange":
+		return postgres.Int64Multirange{}
+	case "nummultirange":
+		return postgres.Float64Multirange{}
+	case "datemultirange", "tsmultirange", "tstzmultirange":
+		return postgres.TimeMultirange{}
 	default:
-		fmt.Println("- [Model      ] Unsupported sql column '" + column.Name + " " + column.DataType.Name + "', using string instead.")
-		return ""
+		return nil
 	}
 }
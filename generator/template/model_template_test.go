@@ -43,3 +43,106 @@ func Test_TableModelField(t *testing.T) {
 		Tags: nil,
 	})
 }
+
+func Test_TableModelField_NullType(t *testing.T) {
+	nullableColumn := func(typeName string, kind metadata.DataTypeKind) metadata.Column {
+		return metadata.Column{
+			Name:       "column",
+			IsNullable: true,
+			DataType:   metadata.DataType{Name: typeName, Kind: kind},
+		}
+	}
+
+	sqlField := TableModelFieldWithNullType(NullSQL)
+	require.Equal(t, sqlField(nullableColumn("integer", metadata.BaseType)).Type,
+		Type{ImportPath: "database/sql", Name: "sql.NullInt32"})
+	require.Equal(t, sqlField(nullableColumn("timestamp", metadata.BaseType)).Type,
+		Type{ImportPath: "database/sql", Name: "sql.NullTime"})
+	require.Equal(t, sqlField(nullableColumn("uuid", metadata.BaseType)).Type,
+		Type{ImportPath: "github.com/google/uuid", Name: "*uuid.UUID"})
+	require.Equal(t, sqlField(nullableColumn("mood", metadata.EnumType)).Type, Type{Name: "*Mood"})
+
+	genericField := TableModelFieldWithNullType(NullGeneric)
+	require.Equal(t, genericField(nullableColumn("integer", metadata.BaseType)).Type,
+		Type{ImportPath: "github.com/go-jet/jet/v2/null", Name: "null.Null[int32]"})
+	require.Equal(t, genericField(nullableColumn("mood", metadata.EnumType)).Type,
+		Type{ImportPath: "github.com/go-jet/jet/v2/null", Name: "null.Null[Mood]"})
+
+	timeField := genericField(nullableColumn("timestamp", metadata.BaseType))
+	require.Equal(t, timeField.Type, Type{
+		ImportPath:            "github.com/go-jet/jet/v2/null",
+		AdditionalImportPaths: []string{"time"},
+		Name:                  "null.Null[time.Time]",
+	})
+	require.Equal(t, timeField.Type.ImportPaths(), []string{"github.com/go-jet/jet/v2/null", "time"})
+
+	require.Equal(t, genericField(metadata.Column{Name: "id", DataType: metadata.DataType{Name: "integer", Kind: metadata.BaseType}}).Type,
+		Type{Name: "int32"})
+}
+
+func Test_TableModelField_Array(t *testing.T) {
+	arrayColumn := func(elemType string, isNullable bool, dimensions int) metadata.Column {
+		return metadata.Column{
+			Name:       "array_column",
+			IsNullable: isNullable,
+			DataType: metadata.DataType{
+				Name:       elemType,
+				Kind:       metadata.ArrayType,
+				Dimensions: dimensions,
+			},
+		}
+	}
+
+	require.Equal(t, DefaultTableModelField(arrayColumn("int4", false, 1)).Type, Type{Name: "[]int32"})
+	require.Equal(t, DefaultTableModelField(arrayColumn("text", true, 1)).Type, Type{Name: "*[]string"})
+	require.Equal(t, DefaultTableModelField(arrayColumn("uuid", false, 1)).Type,
+		Type{ImportPath: "github.com/google/uuid", Name: "[]uuid.UUID"})
+	require.Equal(t, DefaultTableModelField(arrayColumn("timestamptz", true, 0)).Type,
+		Type{ImportPath: "time", Name: "*[]time.Time"})
+	require.Equal(t, DefaultTableModelField(arrayColumn("text", false, 2)).Type, Type{Name: "string"})
+	require.Equal(t, DefaultTableModelField(arrayColumn("jsonb", true, 1)).Type, Type{Name: "*string"})
+}
+
+func Test_TableModelField_Range(t *testing.T) {
+	rangeColumn := func(rangeType string, isNullable bool) metadata.Column {
+		return metadata.Column{
+			Name:       "range_column",
+			IsNullable: isNullable,
+			DataType: metadata.DataType{
+				Name: rangeType,
+				Kind: metadata.BaseType,
+			},
+		}
+	}
+
+	require.Equal(t, DefaultTableModelField(rangeColumn("int4range", false)).Type,
+		Type{ImportPath: "github.com/go-jet/jet/v2/postgres", Name: "postgres.Int32Range"})
+	require.Equal(t, DefaultTableModelField(rangeColumn("int8range", true)).Type,
+		Type{ImportPath: "github.com/go-jet/jet/v2/postgres", Name: "*postgres.Int64Range"})
+	require.Equal(t, DefaultTableModelField(rangeColumn("numrange", false)).Type,
+		Type{ImportPath: "github.com/go-jet/jet/v2/postgres", Name: "postgres.Float64Range"})
+	require.Equal(t, DefaultTableModelField(rangeColumn("tstzrange", false)).Type,
+		Type{ImportPath: "github.com/go-jet/jet/v2/postgres", Name: "postgres.TimeRange"})
+	require.Equal(t, DefaultTableModelField(rangeColumn("int4multirange", false)).Type,
+		Type{ImportPath: "github.com/go-jet/jet/v2/postgres", Name: "postgres.Int32Multirange"})
+	require.Equal(t, DefaultTableModelField(rangeColumn("tstzmultirange", true)).Type,
+		Type{ImportPath: "github.com/go-jet/jet/v2/postgres", Name: "*postgres.TimeMultirange"})
+}
+
+func Test_TableModelComment(t *testing.T) {
+	table := metadata.Table{
+		Name:    "film",
+		Comment: "Film catalog",
+		Columns: []metadata.Column{
+			{Name: "title", DataType: metadata.DataType{Name: "text", Kind: "base"}, Comment: "Film title"},
+		},
+	}
+
+	require.Equal(t, DefaultTableModel(table).Comment, "Film catalog")
+	require.Equal(t, DefaultTableModel(table).UseComment("").Comment, "")
+
+	field := DefaultTableModelField(table.Columns[0])
+	require.Equal(t, field.Comment, "Film title")
+	require.Equal(t, field.UseComment("Title").Comment, "Title")
+	require.Equal(t, DefaultTableSQLBuilderColumn(table.Columns[0]).Comment, "Film title")
+}
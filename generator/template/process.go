@@ -2,74 +2,179 @@ package template
 
 import (
 	"bytes"
+	"context"
 	"fmt"
+	"go/token"
+	"go/types"
 	"path"
 	"strings"
 	"text/template"
+	"unicode"
 
+	"github.com/go-jet/jet/v2/generator/logger"
 	"github.com/go-jet/jet/v2/generator/metadata"
 	"github.com/go-jet/jet/v2/internal/jet"
 	"github.com/go-jet/jet/v2/internal/utils"
 	"github.com/go-jet/jet/v2/internal/utils/throw"
 )
 
-// ProcessSchema will process schema metadata and constructs go files using generator Template
+// ProcessSchema will process schema metadata and constructs go files using generator Template. Only files with
+// changed content are written to the destination directory, and previously generated files that are not generated
+// anymore are deleted. ProcessSchema panics on error.
 func ProcessSchema(dirPath string, schemaMetaData metadata.Schema, generatorTemplate Template) {
+	throw.OnError(ProcessSchemaContext(context.Background(), dirPath, schemaMetaData, generatorTemplate))
+}
+
+// ProcessSchemaContext processes schema metadata same as ProcessSchema, but reports progress to the context logger,
+// and returns an error instead of panicking. Context cancellation is checked before the files are saved.
+func ProcessSchemaContext(ctx context.Context, dirPath string, schemaMetaData metadata.Schema, generatorTemplate Template) error {
 	if schemaMetaData.IsEmpty() {
-		return
+		return nil
+	}
+
+	schemaPath, files, err := renderSchema(ctx, dirPath, schemaMetaData, generatorTemplate)
+	if err != nil {
+		return err
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
 	}
 
+	logger.Infof(ctx, "Saving changed files...")
+	diff, err := files.save(schemaPath)
+
+	if err != nil {
+		return err
+	}
+
+	logger.Infof(ctx, "\t%d file(s) added, %d file(s) changed, %d file(s) removed", len(diff.Added), len(diff.Changed), len(diff.Removed))
+
+	return nil
+}
+
+// CheckSchema renders schema files in memory, using generator Template, and compares them with the files at
+// destination directory. Files on disk are not modified. CheckSchema panics on error.
+func CheckSchema(dirPath string, schemaMetaData metadata.Schema, generatorTemplate Template) Diff {
+	diff, err := CheckSchemaContext(context.Background(), dirPath, schemaMetaData, generatorTemplate)
+	throw.OnError(err)
+
+	return diff
+}
+
+// CheckSchemaContext compares rendered schema files with the files at destination directory same as CheckSchema,
+// but reports progress to the context logger, and returns an error instead of panicking.
+func CheckSchemaContext(ctx context.Context, dirPath string, schemaMetaData metadata.Schema, generatorTemplate Template) (Diff, error) {
+	if schemaMetaData.IsEmpty() {
+		return Diff{}, nil
+	}
+
+	schemaPath, files, err := renderSchema(ctx, dirPath, schemaMetaData, generatorTemplate)
+	if err != nil {
+		return Diff{}, err
+	}
+
+	if err := ctx.Err(); err != nil {
+		return Diff{}, err
+	}
+
+	return files.diff(schemaPath)
+}
+
+func renderSchema(ctx context.Context, dirPath string, schemaMetaData metadata.Schema, generatorTemplate Template) (string, *generatedFiles, error) {
+	if err := generatorTemplate.Filters.Validate(); err != nil {
+		return "", nil, err
+	}
+
+	schemaMetaData = generatorTemplate.Filters.Apply(schemaMetaData) // schema metadata can be loaded from snapshot file
+
 	schemaTemplate := generatorTemplate.Schema(schemaMetaData)
 	schemaPath := path.Join(dirPath, schemaTemplate.Path)
 
-	fmt.Println("Destination directory:", schemaPath)
-	fmt.Println("Cleaning up destination directory...")
-	err := utils.CleanUpGeneratedFiles(schemaPath)
-	throw.OnError(err)
+	logger.Infof(ctx, "Destination directory: %s", schemaPath)
+
+	files := newGeneratedFiles()
+
+	if err := processModel(ctx, files, schemaPath, schemaMetaData, schemaTemplate); err != nil {
+		return "", nil, err
+	}
+
+	enumTypes, err := processSQLBuilder(ctx, files, schemaPath, generatorTemplate.Dialect, schemaMetaData, schemaTemplate)
+	if err != nil {
+		return "", nil, err
+	}
+
+	err = processRepository(ctx, files, schemaPath, generatorTemplate.Dialect, schemaMetaData, schemaTemplate, enumTypes)
+	if err != nil {
+		return "", nil, err
+	}
 
-	processModel(schemaPath, schemaMetaData, schemaTemplate)
-	processSQLBuilder(schemaPath, generatorTemplate.Dialect, schemaMetaData, schemaTemplate)
+	return schemaPath, files, nil
 }
 
-func processModel(dirPath string, schemaMetaData metadata.Schema, schemaTemplate Schema) {
+func processModel(ctx context.Context, files *generatedFiles, dirPath string, schemaMetaData metadata.Schema, schemaTemplate Schema) error {
 	modelTemplate := schemaTemplate.Model
 
 	if modelTemplate.Skip {
-		fmt.Println("Skipping the generation of model types.")
-		return
+		logger.Infof(ctx, "Skipping the generation of model types.")
+		return nil
 	}
 
 	modelDirPath := path.Join(dirPath, modelTemplate.Path)
 
-	err := utils.EnsureDirPath(modelDirPath)
-	throw.OnError(err)
+	files.addDir(modelDirPath)
+
+	if err := processTableModels(ctx, files, "table", modelDirPath, schemaMetaData.TablesMetaData, modelTemplate); err != nil {
+		return err
+	}
 
-	processTableModels("table", modelDirPath, schemaMetaData.TablesMetaData, modelTemplate)
-	processTableModels("view", modelDirPath, schemaMetaData.ViewsMetaData, modelTemplate)
-	processEnumModels(modelDirPath, schemaMetaData.EnumsMetaData, modelTemplate)
+	if err := processTableModels(ctx, files, "view", modelDirPath, schemaMetaData.ViewsMetaData, modelTemplate); err != nil {
+		return err
+	}
+
+	return processEnumModels(ctx, files, modelDirPath, schemaMetaData.EnumsMetaData, modelTemplate)
 }
 
-func processSQLBuilder(dirPath string, dialect jet.Dialect, schemaMetaData metadata.Schema, schemaTemplate Schema) {
+// processSQLBuilder generates sql builder files, and returns typed enum columns used in generated files
+func processSQLBuilder(ctx context.Context, files *generatedFiles, dirPath string, dialect jet.Dialect, schemaMetaData metadata.Schema, schemaTemplate Schema) (map[string]enumSQLBuilderType, error) {
 	sqlBuilderTemplate := schemaTemplate.SQLBuilder
 
 	if sqlBuilderTemplate.Skip {
-		fmt.Println("Skipping the generation of SQL Builder types.")
-		return
+		logger.Infof(ctx, "Skipping the generation of SQL Builder types.")
+		return nil, nil
 	}
 
 	sqlBuilderPath := path.Join(dirPath, sqlBuilderTemplate.Path)
 
-	processTableSQLBuilder("table", sqlBuilderPath, dialect, schemaMetaData, schemaMetaData.TablesMetaData, sqlBuilderTemplate)
-	processTableSQLBuilder("view", sqlBuilderPath, dialect, schemaMetaData, schemaMetaData.ViewsMetaData, sqlBuilderTemplate)
-	processEnumSQLBuilder(sqlBuilderPath, dialect, schemaMetaData.EnumsMetaData, sqlBuilderTemplate)
+	enumTypes := getEnumSQLBuilderTypes(ctx, sqlBuilderPath, schemaMetaData.EnumsMetaData, sqlBuilderTemplate)
+
+	err := processTableSQLBuilder(ctx, files, "table", sqlBuilderPath, dialect, schemaMetaData, schemaMetaData.TablesMetaData, sqlBuilderTemplate, enumTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	err = processTableSQLBuilder(ctx, files, "view", sqlBuilderPath, dialect, schemaMetaData, schemaMetaData.ViewsMetaData, sqlBuilderTemplate, enumTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := processEnumSQLBuilder(ctx, files, sqlBuilderPath, dialect, schemaMetaData.EnumsMetaData, sqlBuilderTemplate); err != nil {
+		return nil, err
+	}
+
+	if err := processRoutineSQLBuilder(ctx, files, sqlBuilderPath, dialect, schemaMetaData, sqlBuilderTemplate, enumTypes); err != nil {
+		return nil, err
+	}
+
+	return enumTypes, nil
 }
 
-func processEnumSQLBuilder(dirPath string, dialect jet.Dialect, enumsMetaData []metadata.Enum, sqlBuilder SQLBuilder) {
+func processEnumSQLBuilder(ctx context.Context, files *generatedFiles, dirPath string, dialect jet.Dialect, enumsMetaData []metadata.Enum, sqlBuilder SQLBuilder) error {
 	if len(enumsMetaData) == 0 {
-		return
+		return nil
 	}
 
-	fmt.Printf("Generating enum sql builder files\n")
+	logger.Infof(ctx, "Generating enum sql builder files")
 
 	for _, enumMetaData := range enumsMetaData {
 		enumTemplate := sqlBuilder.Enum(enumMetaData)
@@ -80,8 +185,7 @@ func processEnumSQLBuilder(dirPath string, dialect jet.Dialect, enumsMetaData []
 
 		enumSQLBuilderPath := path.Join(dirPath, enumTemplate.Path)
 
-		err := utils.EnsureDirPath(enumSQLBuilderPath)
-		throw.OnError(err)
+		files.addDir(enumSQLBuilderPath)
 
 		text, err := generateTemplate(
 			autoGenWarningTemplate+enumSQLBuilderTemplate,
@@ -99,25 +203,34 @@ func processEnumSQLBuilder(dirPath string, dialect jet.Dialect, enumsMetaData []
 				"enumValueName": func(enumValue string) string {
 					return enumTemplate.ValueName(enumValue)
 				},
+				"enumTypeImplName": func() string {
+					return lowerFirst(enumTemplate.TypeName)
+				},
 			})
-		throw.OnError(err)
+		if err != nil {
+			return fmt.Errorf("failed to generate %s file: %w", path.Join(enumSQLBuilderPath, enumTemplate.FileName), err)
+		}
 
-		err = utils.SaveGoFile(enumSQLBuilderPath, enumTemplate.FileName, text)
-		throw.OnError(err)
+		if err := files.addGoFile(enumSQLBuilderPath, enumTemplate.FileName, text); err != nil {
+			return err
+		}
 	}
+
+	return nil
 }
 
-func processTableSQLBuilder(fileTypes, dirPath string,
+func processTableSQLBuilder(ctx context.Context, files *generatedFiles, fileTypes, dirPath string,
 	dialect jet.Dialect,
 	schemaMetaData metadata.Schema,
 	tablesMetaData []metadata.Table,
-	sqlBuilderTemplate SQLBuilder) {
+	sqlBuilderTemplate SQLBuilder,
+	enumTypes map[string]enumSQLBuilderType) error {
 
 	if len(tablesMetaData) == 0 {
-		return
+		return nil
 	}
 
-	fmt.Printf("Generating %s sql builder files\n", fileTypes)
+	logger.Infof(ctx, "Generating %s sql builder files", fileTypes)
 
 	var generatedBuilders []TableSQLBuilder
 
@@ -135,10 +248,25 @@ func processTableSQLBuilder(fileTypes, dirPath string,
 			continue
 		}
 
+		for _, column := range tableMetaData.Columns {
+			if isUnsupportedSQLBuilderType(column) {
+				logger.Warnf(ctx, "- [SQL Builder] Unsupported sql column '%s %s', using StringColumn instead.",
+					column.Name, column.DataType.Name)
+			}
+		}
+
 		tableSQLBuilderPath := path.Join(dirPath, tableSQLBuilder.Path)
 
-		err := utils.EnsureDirPath(tableSQLBuilderPath)
-		throw.OnError(err)
+		files.addDir(tableSQLBuilderPath)
+
+		columnTypes := newSQLBuilderColumnTypes(dialect, tableSQLBuilderPath, enumTypes)
+
+		var relationships []tableRelationship
+
+		if fileTypes == "table" {
+			relationships = getTableRelationships(ctx, dialect, schemaMetaData.Name, tableMetaData, tableSQLBuilder,
+				tablesMetaData, sqlBuilderTemplate)
+		}
 
 		text, err := generateTemplate(
 			autoGenWarningTemplate+tableSQLBuilderTemplate,
@@ -157,35 +285,327 @@ func processTableSQLBuilder(fileTypes, dirPath string,
 					return tableSQLBuilder
 				},
 				"structImplName": func() string { // postgres only
-					structName := tableSQLBuilder.TypeName
-					return string(strings.ToLower(structName)[0]) + structName[1:]
+					return lowerFirst(tableSQLBuilder.TypeName)
 				},
 				"columnField": func(columnMetaData metadata.Column) TableSQLBuilderColumn {
 					return tableSQLBuilder.Column(columnMetaData)
 				},
-				"toUpper": strings.ToUpper,
+				"columnType":        columnTypes.columnType,
+				"columnConstructor": columnTypes.columnConstructor,
+				"enumImports": func() []string {
+					return columnTypes.imports(tableMetaData.Columns, tableSQLBuilder.Column)
+				},
+				"relationships": func() []tableRelationship {
+					return relationships
+				},
+				"commentLines": commentLines,
+				"toUpper":      strings.ToUpper,
 				"insertedRowAlias": func() string {
 					return insertedRowAlias(dialect)
 				},
 			})
-		throw.OnError(err)
+		if err != nil {
+			return fmt.Errorf("failed to generate %s file: %w", path.Join(tableSQLBuilderPath, tableSQLBuilder.FileName), err)
+		}
 
-		err = utils.SaveGoFile(tableSQLBuilderPath, tableSQLBuilder.FileName, text)
-		throw.OnError(err)
+		if err := files.addGoFile(tableSQLBuilderPath, tableSQLBuilder.FileName, text); err != nil {
+			return err
+		}
 
 		generatedBuilders = append(generatedBuilders, tableSQLBuilder)
 	}
 
 	if len(generatedBuilders) > 0 {
-		generateUseSchemaFunc(dirPath, fileTypes, generatedBuilders)
+		return generateUseSchemaFunc(ctx, files, dirPath, fileTypes, generatedBuilders)
+	}
+
+	return nil
+}
+
+// tableRelationship is foreign key relationship between generated table sql builder types
+type tableRelationship struct {
+	Name                   string
+	ForeignKey             metadata.ForeignKey
+	ReferencedTypeName     string
+	ReferencedInstanceName string
+	Columns                []tableRelationshipColumn
+}
+
+type tableRelationshipColumn struct {
+	Field           string
+	ReferencedField string
+}
+
+// getTableRelationships returns table foreign key relationships, for which join helpers can be generated.
+// Referenced table has to be generated in the same package as the table, and relationship name must not
+// clash with table columns, table type methods or other table relationships.
+func getTableRelationships(ctx context.Context, dialect jet.Dialect,
+	schemaName string,
+	tableMetaData metadata.Table,
+	tableSQLBuilder TableSQLBuilder,
+	tablesMetaData []metadata.Table,
+	sqlBuilderTemplate SQLBuilder) []tableRelationship {
+
+	if tableSQLBuilder.ForeignKey == nil {
+		return nil
+	}
+
+	usedNames := map[string]bool{
+		"Table": true, "AS": true, "FromSchema": true, "WithPrefix": true, "WithSuffix": true,
+		"SchemaName": true, "TableName": true, "Alias": true, "AllColumns": true, "MutableColumns": true,
+		strings.ToUpper(insertedRowAlias(dialect)): true,
+	}
+
+	for _, column := range tableMetaData.Columns {
+		usedNames[tableSQLBuilder.Column(column).Name] = true
+	}
+
+	var ret []tableRelationship
+
+	for _, foreignKey := range tableMetaData.ForeignKeys {
+		foreignKeyTemplate := tableSQLBuilder.ForeignKey(foreignKey)
+
+		if foreignKeyTemplate.Skip || foreignKeyTemplate.Name == "" {
+			continue
+		}
+
+		relationship, ok := newTableRelationship(schemaName, foreignKey, tableMetaData, tableSQLBuilder,
+			tablesMetaData, sqlBuilderTemplate)
+
+		if !ok {
+			continue
+		}
+
+		if usedNames[foreignKeyTemplate.Name] {
+			logger.Warnf(ctx, "- [SQL Builder] Foreign key '%s' relationship name '%s' is already used in %s, skipping join helpers.",
+				foreignKey.Name, foreignKeyTemplate.Name, tableSQLBuilder.TypeName)
+			continue
+		}
+
+		usedNames[foreignKeyTemplate.Name] = true
+		relationship.Name = foreignKeyTemplate.Name
+		ret = append(ret, relationship)
+	}
+
+	return ret
+}
+
+func newTableRelationship(schemaName string,
+	foreignKey metadata.ForeignKey,
+	tableMetaData metadata.Table,
+	tableSQLBuilder TableSQLBuilder,
+	tablesMetaData []metadata.Table,
+	sqlBuilderTemplate SQLBuilder) (tableRelationship, bool) {
+
+	if foreignKey.ReferencedSchema != schemaName || len(foreignKey.Columns) != len(foreignKey.ReferencedColumns) {
+		return tableRelationship{}, false
+	}
+
+	for _, referencedTable := range tablesMetaData {
+		if referencedTable.Name != foreignKey.ReferencedTable {
+			continue
+		}
+
+		referencedSQLBuilder := sqlBuilderTemplate.Table(referencedTable)
+
+		if referencedSQLBuilder.Skip || referencedSQLBuilder.Path != tableSQLBuilder.Path {
+			return tableRelationship{}, false
+		}
+
+		relationship := tableRelationship{
+			ForeignKey:             foreignKey,
+			ReferencedTypeName:     referencedSQLBuilder.TypeName,
+			ReferencedInstanceName: referencedSQLBuilder.InstanceName,
+		}
+
+		for i, columnName := range foreignKey.Columns {
+			column, ok := tableMetaData.Column(columnName)
+			referencedColumn, refOk := referencedTable.Column(foreignKey.ReferencedColumns[i])
+
+			if !ok || !refOk {
+				return tableRelationship{}, false
+			}
+
+			relationship.Columns = append(relationship.Columns, tableRelationshipColumn{
+				Field:           tableSQLBuilder.Column(column).Name,
+				ReferencedField: referencedSQLBuilder.Column(referencedColumn).Name,
+			})
+		}
+
+		return relationship, true
+	}
+
+	return tableRelationship{}, false
+}
+
+func processRoutineSQLBuilder(ctx context.Context, files *generatedFiles, dirPath string,
+	dialect jet.Dialect,
+	schemaMetaData metadata.Schema,
+	sqlBuilderTemplate SQLBuilder,
+	enumTypes map[string]enumSQLBuilderType) error {
+
+	if len(schemaMetaData.RoutinesMetaData) == 0 || sqlBuilderTemplate.Routine == nil {
+		return nil
+	}
+
+	logger.Infof(ctx, "Generating routine sql builder files")
+
+	generatedFuncNames := map[string]bool{}
+	var routinePaths []string
+
+	for _, routineMetaData := range schemaMetaData.RoutinesMetaData {
+		routineTemplate := sqlBuilderTemplate.Routine(routineMetaData)
+
+		if routineTemplate.Skip {
+			continue
+		}
+
+		if routineMetaData.IsTableFunction() && dialect.Name() != "PostgreSQL" {
+			logger.Warnf(ctx, "- [SQL Builder] Table functions are not supported by %s, skipping '%s'.",
+				dialect.Name(), routineMetaData.Name)
+			continue
+		}
+
+		routineSQLBuilderPath := path.Join(dirPath, routineTemplate.Path)
+		funcKey := path.Join(routineSQLBuilderPath, routineTemplate.FuncName)
+
+		if generatedFuncNames[funcKey] { // overloaded function
+			logger.Warnf(ctx, "- [SQL Builder] Routine '%s' is overloaded, only the first overload is generated.",
+				routineMetaData.Name)
+			continue
+		}
+
+		generatedFuncNames[funcKey] = true
+
+		files.addDir(routineSQLBuilderPath)
+
+		columnTypes := newSQLBuilderColumnTypes(dialect, routineSQLBuilderPath, enumTypes)
+
+		text, err := generateTemplate(
+			autoGenWarningTemplate+routineSQLBuilderTemplate,
+			routineMetaData,
+			template.FuncMap{
+				"package": func() string {
+					return routineTemplate.PackageName()
+				},
+				"dialect": func() jet.Dialect {
+					return dialect
+				},
+				"routineTemplate": func() RoutineSQLBuilder {
+					return routineTemplate
+				},
+				"parameters": func() []routineSQLBuilderParameter {
+					return newRoutineSQLBuilderParameters(dialect, routineMetaData, columnTypes)
+				},
+				"returnType": func() string {
+					return columnTypes.expressionType(routineReturnColumn(routineMetaData))
+				},
+				"returnTypeWrapper": func() string {
+					return columnTypes.expressionWrapper(routineReturnColumn(routineMetaData))
+				},
+				"columnField": func(columnMetaData metadata.Column) TableSQLBuilderColumn {
+					return routineTemplate.Column(columnMetaData)
+				},
+				"columnType":        columnTypes.columnType,
+				"columnConstructor": columnTypes.columnConstructor,
+				"enumImports": func() []string {
+					return columnTypes.importsOf(routineSQLBuilderColumns(routineMetaData, routineTemplate))
+				},
+				"structImplName": func() string {
+					return lowerFirst(routineTemplate.TypeName)
+				},
+			})
+		if err != nil {
+			return fmt.Errorf("failed to generate %s file: %w", path.Join(routineSQLBuilderPath, routineTemplate.FileName), err)
+		}
+
+		if err := files.addGoFile(routineSQLBuilderPath, routineTemplate.FileName, text); err != nil {
+			return err
+		}
+
+		if !utils.StringSliceContains(routinePaths, routineSQLBuilderPath) {
+			routinePaths = append(routinePaths, routineSQLBuilderPath)
+		}
 	}
+
+	for _, routinePath := range routinePaths {
+		logger.Infof(ctx, "Generating global `UseSchema` method for %s", path.Base(routinePath))
+		text, err := generateTemplate(
+			autoGenWarningTemplate+routineSQLBuilderSetSchemaTemplate,
+			schemaMetaData,
+			template.FuncMap{
+				"package": func() string { return path.Base(routinePath) },
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("failed to generate %s file: %w", path.Join(routinePath, path.Base(routinePath)), err)
+		}
+
+		if err := files.addGoFile(routinePath, path.Base(routinePath), text); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
-func generateUseSchemaFunc(dirPath, fileTypes string, builders []TableSQLBuilder) {
+// routineSQLBuilderParameter is go function parameter of generated routine sql builder
+type routineSQLBuilderParameter struct {
+	Name string
+	Type string
+}
+
+func newRoutineSQLBuilderParameters(dialect jet.Dialect, routine metadata.Routine, columnTypes sqlBuilderColumnTypes) []routineSQLBuilderParameter {
+	var ret []routineSQLBuilderParameter
+
+	for i, parameter := range routine.InputParameters() {
+		name := lowerFirst(utils.ToGoIdentifier(parameter.Name))
+
+		if name == "" {
+			name = fmt.Sprintf("arg%d", i+1)
+		} else if token.Lookup(name).IsKeyword() || name == dialect.PackageName() ||
+			name == "currentSchema" || name == "routineName" {
+			name += "_"
+		}
+
+		ret = append(ret, routineSQLBuilderParameter{
+			Name: name,
+			Type: columnTypes.expressionType(DefaultTableSQLBuilderColumn(metadata.Column{DataType: parameter.DataType})),
+		})
+	}
+
+	return ret
+}
+
+func routineReturnColumn(routine metadata.Routine) TableSQLBuilderColumn {
+	return DefaultTableSQLBuilderColumn(metadata.Column{DataType: routine.ReturnType})
+}
+
+// routineSQLBuilderColumns returns result columns, input parameters and return value of the routine, as a list of
+// sql builder columns
+func routineSQLBuilderColumns(routine metadata.Routine, routineTemplate RoutineSQLBuilder) []TableSQLBuilderColumn {
+	var columns []TableSQLBuilderColumn
+
+	for _, column := range routine.ResultColumns {
+		columns = append(columns, routineTemplate.Column(column))
+	}
+
+	for _, parameter := range routine.InputParameters() {
+		columns = append(columns, DefaultTableSQLBuilderColumn(metadata.Column{DataType: parameter.DataType}))
+	}
+
+	if !routine.IsProcedure() && !routine.IsTableFunction() {
+		columns = append(columns, routineReturnColumn(routine))
+	}
+
+	return columns
+}
+
+func generateUseSchemaFunc(ctx context.Context, files *generatedFiles, dirPath, fileTypes string, builders []TableSQLBuilder) error {
 
 	basePath := path.Join(dirPath, builders[0].Path)
 
-	fmt.Printf("Generating global `UseSchema` method for %s\n", fileTypes)
+	logger.Infof(ctx, "Generating global `UseSchema` method for %s", fileTypes)
 	text, err := generateTemplate(
 		autoGenWarningTemplate+tableSqlBuilderSetSchemaTemplate,
 		builders,
@@ -193,10 +613,542 @@ func generateUseSchemaFunc(dirPath, fileTypes string, builders []TableSQLBuilder
 			"package": func() string { return builders[0].PackageName() },
 		},
 	)
-	throw.OnError(err)
+	if err != nil {
+		return fmt.Errorf("failed to generate %s file: %w", path.Join(basePath, fileTypes), err)
+	}
 
-	err = utils.SaveGoFile(basePath, fileTypes, text)
-	throw.OnError(err)
+	if err := files.addGoFile(basePath, fileTypes, text); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func processRepository(ctx context.Context, files *generatedFiles,
+	dirPath string,
+	dialect jet.Dialect,
+	schemaMetaData metadata.Schema,
+	schemaTemplate Schema,
+	enumTypes map[string]enumSQLBuilderType) error {
+
+	repositoryTemplate := schemaTemplate.Repository
+
+	if repositoryTemplate.Skip || repositoryTemplate.Table == nil || len(schemaMetaData.TablesMetaData) == 0 {
+		return nil
+	}
+
+	if schemaTemplate.Model.Skip || schemaTemplate.SQLBuilder.Skip {
+		logger.Warnf(ctx, "- [Repository] Repository types require model and SQL Builder types, skipping the generation of repository types.")
+		return nil
+	}
+
+	logger.Infof(ctx, "Generating table repository files")
+
+	repositoryPath := path.Join(dirPath, repositoryTemplate.Path)
+
+	for _, tableMetaData := range schemaMetaData.TablesMetaData {
+		repository, ok := newTableRepository(ctx, dialect, dirPath, tableMetaData, schemaTemplate, enumTypes)
+
+		if !ok {
+			continue
+		}
+
+		text, err := generateTemplate(
+			autoGenWarningTemplate+tableRepositoryTemplate,
+			repository,
+			template.FuncMap{
+				"package": func() string {
+					return repositoryTemplate.PackageName()
+				},
+				"dialect": func() jet.Dialect {
+					return dialect
+				},
+				"insertedRowAlias": func() string {
+					return strings.ToUpper(insertedRowAlias(dialect))
+				},
+				"isMySQL": func() bool {
+					return dialect.Name() == "MySQL"
+				},
+				"columns": func(columnLists ...[]repositoryColumn) repositoryColumnList {
+					columnList := repositoryColumnList{Table: repository.SQLBuilder}
+
+					for _, columns := range columnLists {
+						columnList.Columns = append(columnList.Columns, columns...)
+					}
+
+					return columnList
+				},
+			})
+		if err != nil {
+			return fmt.Errorf("failed to generate %s file: %w", path.Join(repositoryPath, repository.Template.FileName), err)
+		}
+
+		if err := files.addGoFile(repositoryPath, repository.Template.FileName, text); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// tableRepository is template data of generated table repository file
+type tableRepository struct {
+	Table    metadata.Table
+	Template TableRepository
+	// ModelType is qualified model type, for instance model.Actor
+	ModelType string
+	// SQLBuilder is qualified table sql builder instance, for instance table.Actor
+	SQLBuilder string
+	// StdImports are standard library imports, other than context
+	StdImports []string
+	Imports    []string
+
+	PrimaryKey     []repositoryColumn
+	MutableColumns []repositoryColumn
+	// InsertColumns are columns set by Insert method
+	InsertColumns []repositoryColumn
+	// UpdateOnConflict are columns updated by Upsert method, if row with the same primary key already exists
+	UpdateOnConflict []repositoryColumn
+	// LastInsertID is auto increment primary key column set from sql.Result, if RETURNING clause is not supported
+	LastInsertID *repositoryColumn
+	// DefaultOnZero is true if Insert method inserts database default values instead of zero-valued fields
+	DefaultOnZero bool
+}
+
+// repositoryColumn is table column used in generated repository methods
+type repositoryColumn struct {
+	// Field is model field name
+	Field string
+	// Column is sql builder column field name
+	Column string
+	// Param is go function parameter name
+	Param string
+	// ParamType is go function parameter type
+	ParamType string
+	// Wrapper is go function that wraps arbitrary expression into column expression type, for instance postgres.IntExp
+	Wrapper string
+	// Literal is go expression of typed primary key parameter literal, for instance postgres.Int32(id)
+	Literal string
+}
+
+// repositoryColumnList is list of table sql builder columns
+type repositoryColumnList struct {
+	Table   string
+	Columns []repositoryColumn
+}
+
+// newTableRepository returns template data for table repository file, or false if repository for the table
+// can not be generated.
+func newTableRepository(ctx context.Context, dialect jet.Dialect,
+	dirPath string,
+	tableMetaData metadata.Table,
+	schemaTemplate Schema,
+	enumTypes map[string]enumSQLBuilderType) (tableRepository, bool) {
+
+	repositoryTemplate := schemaTemplate.Repository.Table(tableMetaData)
+	tableModel := schemaTemplate.Model.Table(tableMetaData)
+	tableSQLBuilder := schemaTemplate.SQLBuilder.Table(tableMetaData)
+
+	if repositoryTemplate.Skip || tableModel.Skip || tableSQLBuilder.Skip {
+		return tableRepository{}, false
+	}
+
+	var primaryKey []metadata.Column
+
+	for _, column := range tableMetaData.Columns {
+		if column.IsPrimaryKey {
+			primaryKey = append(primaryKey, column)
+		}
+	}
+
+	if len(primaryKey) == 0 {
+		logger.Warnf(ctx, "- [Repository] Table '%s' does not have a primary key, skipping.", tableMetaData.Name)
+		return tableRepository{}, false
+	}
+
+	repositoryPath := path.Join(dirPath, schemaTemplate.Repository.Path)
+	modelPath := path.Join(dirPath, schemaTemplate.Model.Path)
+	tableSQLBuilderPath := path.Join(dirPath, schemaTemplate.SQLBuilder.Path, tableSQLBuilder.Path)
+
+	var imports []string
+
+	for _, packagePath := range []string{modelPath, tableSQLBuilderPath} {
+		importPath, ok := utils.GoImportPath(packagePath)
+
+		if !ok {
+			logger.Warnf(ctx, "- [Repository] Can't resolve go import path of '%s', skipping table '%s'.",
+				packagePath, tableMetaData.Name)
+			return tableRepository{}, false
+		}
+
+		imports = append(imports, importPath)
+	}
+
+	columnTypes := newSQLBuilderColumnTypes(dialect, repositoryPath, enumTypes)
+	usedParams := map[string]bool{
+		"ctx": true, "db": true, "m": true, "r": true, "dest": true, "err": true, "result": true, "lastInsertID": true,
+		"context": true, "qrm": true, dialect.PackageName(): true,
+		schemaTemplate.Model.PackageName(): true, tableSQLBuilder.PackageName(): true,
+	}
+
+	newColumn := func(column metadata.Column) repositoryColumn {
+		sqlBuilderColumn := tableSQLBuilder.Column(column)
+
+		return repositoryColumn{
+			Field:   tableModel.Field(column).Name,
+			Column:  sqlBuilderColumn.Name,
+			Wrapper: columnTypes.expressionWrapper(sqlBuilderColumn),
+		}
+	}
+
+	// primary key columns are also used as go function parameters
+	newPrimaryKeyColumn := func(column metadata.Column) repositoryColumn {
+		field := tableModel.Field(column)
+		sqlBuilderColumn := tableSQLBuilder.Column(column)
+
+		param := paramName(field.Name)
+		for token.Lookup(param).IsKeyword() || usedParams[param] {
+			param += "_"
+		}
+		usedParams[param] = true
+
+		for _, importPath := range append(columnTypes.importsOf([]TableSQLBuilderColumn{sqlBuilderColumn}), field.Type.ImportPaths()...) {
+			if importPath != "" && !utils.StringSliceContains(imports, importPath) {
+				imports = append(imports, importPath)
+			}
+		}
+
+		repositoryColumn := newColumn(column)
+		repositoryColumn.Param = param
+		repositoryColumn.ParamType = qualifiedTypeName(strings.TrimPrefix(field.Type.Name, "*"), schemaTemplate.Model.PackageName())
+		repositoryColumn.Literal = columnTypes.literal(sqlBuilderColumn, column, param, repositoryColumn.ParamType)
+
+		return repositoryColumn
+	}
+
+	repository := tableRepository{
+		Table:         tableMetaData,
+		Template:      repositoryTemplate,
+		ModelType:     schemaTemplate.Model.PackageName() + "." + tableModel.TypeName,
+		SQLBuilder:    tableSQLBuilder.PackageName() + "." + tableSQLBuilder.InstanceName,
+		DefaultOnZero: repositoryTemplate.DefaultOnZero && dialect.Name() != "SQLite",
+	}
+
+	for _, column := range primaryKey {
+		repositoryColumn := newPrimaryKeyColumn(column)
+
+		if repositoryColumn.Literal == "" {
+			logger.Warnf(ctx, "- [Repository] Unsupported primary key '%s' parameter type '%s', skipping table '%s'.",
+				column.Name, repositoryColumn.ParamType, tableMetaData.Name)
+			return tableRepository{}, false
+		}
+
+		repository.PrimaryKey = append(repository.PrimaryKey, repositoryColumn)
+	}
+
+	if dialect.Name() == "MySQL" && len(primaryKey) == 1 && primaryKey[0].HasDefault &&
+		isIntegerType(tableModel.Field(primaryKey[0]).Type.Name) {
+		repository.LastInsertID = &repository.PrimaryKey[0]
+	}
+
+	for i, column := range primaryKey {
+		// Primary key columns with default values (for instance serial or auto increment columns) are assigned by
+		// database, and read back with RETURNING clause or from sql.Result. MySQL can't read back other defaults.
+		assignedByDB := column.HasDefault && (dialect.Name() != "MySQL" || repository.LastInsertID != nil)
+
+		if repository.DefaultOnZero || !assignedByDB {
+			repository.InsertColumns = append(repository.InsertColumns, repository.PrimaryKey[i])
+		}
+	}
+
+	for _, column := range tableMetaData.MutableColumns() {
+		repository.MutableColumns = append(repository.MutableColumns, newColumn(column))
+	}
+
+	repository.InsertColumns = append(repository.InsertColumns, repository.MutableColumns...)
+	repository.UpdateOnConflict = repository.MutableColumns
+
+	if len(repository.UpdateOnConflict) == 0 { // no-op update, so that existing row is returned
+		repository.UpdateOnConflict = repository.PrimaryKey[:1]
+	}
+
+	for _, importPath := range imports {
+		if strings.Contains(strings.Split(importPath, "/")[0], ".") {
+			repository.Imports = append(repository.Imports, importPath)
+		} else {
+			repository.StdImports = append(repository.StdImports, importPath)
+		}
+	}
+
+	return repository, true
+}
+
+// paramName converts go field name into go function parameter name, for instance ID -> id, UUIDKey -> uuidKey
+func paramName(fieldName string) string {
+	upper := 0
+	for upper < len(fieldName) && unicode.IsUpper(rune(fieldName[upper])) {
+		upper++
+	}
+
+	if upper > 1 && upper < len(fieldName) { // last upper case letter starts next word
+		upper--
+	}
+
+	return strings.ToLower(fieldName[:upper]) + fieldName[upper:]
+}
+
+// qualifiedTypeName prefixes go type declared in model package (for instance enum type) with model package name
+func qualifiedTypeName(goType, modelPackage string) string {
+	baseType := strings.TrimLeft(goType, "[]*")
+
+	if baseType == "" || strings.Contains(baseType, ".") || types.Universe.Lookup(baseType) != nil {
+		return goType
+	}
+
+	return goType[:len(goType)-len(baseType)] + modelPackage + "." + baseType
+}
+
+// literal returns go expression that creates typed literal of the primary key parameter, for instance
+// postgres.Int32(id). Literal is wrapped into column expression type, if literal and column types differ (for instance
+// for enum columns). Returns empty string if there is no literal constructor for the parameter type.
+func (s sqlBuilderColumnTypes) literal(column TableSQLBuilderColumn, columnMetaData metadata.Column, param, paramType string) string {
+	dialect := s.dialect.PackageName()
+	literalType, constructor := "", ""
+
+	switch paramType {
+	case "bool":
+		literalType, constructor = "Bool", "Bool(%s)"
+	case "int8", "int16", "int32", "int64", "uint8", "uint16", "uint32", "uint64":
+		literalType, constructor = "Integer", strings.ToUpper(paramType[:1])+paramType[1:]+"(%s)"
+	case "int":
+		literalType, constructor = "Integer", "Int(int64(%s))"
+	case "uint":
+		literalType, constructor = "Integer", "Uint64(uint64(%s))"
+	case "float64":
+		literalType, constructor = "Float", "Float(%s)"
+	case "float32":
+		literalType, constructor = "Float", "Float(float64(%s))"
+	case "string":
+		literalType, constructor = "String", "String(%s)"
+	case "uuid.UUID":
+		literalType, constructor = "String", "UUID(%s)"
+	case "[]byte":
+		if dialect == "postgres" {
+			literalType, constructor = "String", "Bytea(%s)"
+		}
+	case "time.Time":
+		if dialect == "sqlite" {
+			break // sqlite does not have time literals from time.Time
+		}
+
+		switch column.Type {
+		case "Date", "Time", "Timestamp":
+			literalType, constructor = column.Type, column.Type+"T(%s)"
+		case "Timez", "Timestampz":
+			if dialect == "postgres" {
+				literalType, constructor = column.Type, column.Type+"T(%s)"
+			}
+		}
+	default:
+		if columnMetaData.DataType.Kind == metadata.EnumType { // model enum types are string types
+			literalType, constructor = "String", "String(string(%s))"
+		}
+	}
+
+	if constructor == "" {
+		return ""
+	}
+
+	literalExp := dialect + "." + fmt.Sprintf(constructor, param)
+
+	if wrapper := s.expressionWrapper(column); wrapper != s.expressionWrapper(TableSQLBuilderColumn{Type: literalType}) {
+		return wrapper + "(" + literalExp + ")"
+	}
+
+	return literalExp
+}
+
+func isIntegerType(goType string) bool {
+	switch goType {
+	case "int", "int8", "int16", "int32", "int64", "uint", "uint8", "uint16", "uint32", "uint64":
+		return true
+	}
+
+	return false
+}
+
+// enumSQLBuilderType is typed enum column generated in enum sql builder package
+type enumSQLBuilderType struct {
+	dirPath    string
+	importPath string
+	pkgName    string
+	typeName   string
+}
+
+// getEnumSQLBuilderTypes returns typed enum columns, mapped by database enum name, for all the enums whose sql builder
+// files will be generated. Enum is left out if go import path of enum sql builder package can not be resolved.
+func getEnumSQLBuilderTypes(ctx context.Context, dirPath string, enumsMetaData []metadata.Enum, sqlBuilder SQLBuilder) map[string]enumSQLBuilderType {
+	enumTypes := map[string]enumSQLBuilderType{}
+
+	for _, enumMetaData := range enumsMetaData {
+		enumTemplate := sqlBuilder.Enum(enumMetaData)
+
+		if enumTemplate.Skip || !enumTemplate.TypedColumns || enumTemplate.TypeName == "" {
+			continue
+		}
+
+		enumSQLBuilderPath := path.Join(dirPath, enumTemplate.Path)
+		importPath, ok := utils.GoImportPath(enumSQLBuilderPath)
+
+		if !ok {
+			logger.Warnf(ctx, "- [SQL Builder] Can't resolve go import path of '%s', enum '%s' columns will be generated as StringColumn.",
+				enumSQLBuilderPath, enumMetaData.Name)
+			continue
+		}
+
+		enumTypes[enumMetaData.Name] = enumSQLBuilderType{
+			dirPath:    path.Clean(enumSQLBuilderPath),
+			importPath: importPath,
+			pkgName:    enumTemplate.PackageName(),
+			typeName:   enumTemplate.TypeName,
+		}
+	}
+
+	return enumTypes
+}
+
+// sqlBuilderColumnTypes resolves go types of table sql builder columns
+type sqlBuilderColumnTypes struct {
+	dialect   jet.Dialect
+	dirPath   string
+	enumTypes map[string]enumSQLBuilderType
+}
+
+func newSQLBuilderColumnTypes(dialect jet.Dialect, dirPath string, enumTypes map[string]enumSQLBuilderType) sqlBuilderColumnTypes {
+	return sqlBuilderColumnTypes{
+		dialect:   dialect,
+		dirPath:   path.Clean(dirPath),
+		enumTypes: enumTypes,
+	}
+}
+
+func (s sqlBuilderColumnTypes) columnType(column TableSQLBuilderColumn) string {
+	if enumType, ok := s.enumType(column); ok {
+		return s.qualifier(enumType) + "Column" + enumType.typeName
+	}
+
+	return s.dialect.PackageName() + ".Column" + column.Type
+}
+
+func (s sqlBuilderColumnTypes) columnConstructor(column TableSQLBuilderColumn) string {
+	if enumType, ok := s.enumType(column); ok {
+		return s.qualifier(enumType) + enumType.typeName + "Column"
+	}
+
+	return s.dialect.PackageName() + "." + column.Type + "Column"
+}
+
+// expressionType returns go expression type of the column, for instance postgres.IntegerExpression
+func (s sqlBuilderColumnTypes) expressionType(column TableSQLBuilderColumn) string {
+	if enumType, ok := s.enumType(column); ok {
+		return s.qualifier(enumType) + enumType.typeName + "Expression"
+	}
+
+	if isUntypedRoutineResult(column) {
+		return s.dialect.PackageName() + ".Expression"
+	}
+
+	return s.dialect.PackageName() + "." + column.Type + "Expression"
+}
+
+// expressionWrapper returns go function that wraps arbitrary expression into column expression type, for instance
+// postgres.IntExp. Returns empty string if expression does not need to be wrapped.
+func (s sqlBuilderColumnTypes) expressionWrapper(column TableSQLBuilderColumn) string {
+	if enumType, ok := s.enumType(column); ok {
+		return s.qualifier(enumType) + enumType.typeName + "Exp"
+	}
+
+	if isUntypedRoutineResult(column) {
+		return ""
+	}
+
+	if column.Type == "Integer" {
+		return s.dialect.PackageName() + ".IntExp"
+	}
+
+	return s.dialect.PackageName() + "." + column.Type + "Exp"
+}
+
+func isUntypedRoutineResult(column TableSQLBuilderColumn) bool {
+	return column.Type == "Void"
+}
+
+func (s sqlBuilderColumnTypes) imports(columns []metadata.Column, columnField func(metadata.Column) TableSQLBuilderColumn) []string {
+	var sqlBuilderColumns []TableSQLBuilderColumn
+
+	for _, column := range columns {
+		sqlBuilderColumns = append(sqlBuilderColumns, columnField(column))
+	}
+
+	return s.importsOf(sqlBuilderColumns)
+}
+
+func (s sqlBuilderColumnTypes) importsOf(columns []TableSQLBuilderColumn) []string {
+	var ret []string
+
+	for _, column := range columns {
+		enumType, ok := s.enumType(column)
+
+		if !ok || s.qualifier(enumType) == "" || utils.StringSliceContains(ret, enumType.importPath) {
+			continue
+		}
+
+		ret = append(ret, enumType.importPath)
+	}
+
+	return ret
+}
+
+func (s sqlBuilderColumnTypes) enumType(column TableSQLBuilderColumn) (enumSQLBuilderType, bool) {
+	if column.Enum == "" {
+		return enumSQLBuilderType{}, false
+	}
+
+	enumType, ok := s.enumTypes[column.Enum]
+	return enumType, ok
+}
+
+func (s sqlBuilderColumnTypes) qualifier(enumType enumSQLBuilderType) string {
+	if enumType.dirPath == s.dirPath { // enum sql builder types are in the same package
+		return ""
+	}
+
+	return enumType.pkgName + "."
+}
+
+func lowerFirst(name string) string {
+	if name == "" {
+		return ""
+	}
+
+	return string(strings.ToLower(name)[0]) + name[1:]
+}
+
+// commentLines splits comment into lines, so it can be rendered as go doc comment
+func commentLines(comment string) []string {
+	comment = strings.TrimSpace(strings.Replace(comment, "\r\n", "\n", -1))
+
+	if comment == "" {
+		return nil
+	}
+
+	var ret []string
+
+	for _, line := range strings.Split(comment, "\n") {
+		ret = append(ret, strings.TrimRightFunc(line, unicode.IsSpace))
+	}
+
+	return ret
 }
 
 func insertedRowAlias(dialect jet.Dialect) string {
@@ -207,11 +1159,11 @@ func insertedRowAlias(dialect jet.Dialect) string {
 	return "excluded"
 }
 
-func processTableModels(fileTypes, modelDirPath string, tablesMetaData []metadata.Table, modelTemplate Model) {
+func processTableModels(ctx context.Context, files *generatedFiles, fileTypes, modelDirPath string, tablesMetaData []metadata.Table, modelTemplate Model) error {
 	if len(tablesMetaData) == 0 {
-		return
+		return nil
 	}
-	fmt.Printf("Generating %s model files...\n", fileTypes)
+	logger.Infof(ctx, "Generating %s model files...", fileTypes)
 
 	for _, tableMetaData := range tablesMetaData {
 		var tableTemplate TableModel
@@ -226,6 +1178,13 @@ func processTableModels(fileTypes, modelDirPath string, tablesMetaData []metadat
 			continue
 		}
 
+		for _, column := range tableMetaData.Columns {
+			if isUnsupportedModelType(column) {
+				logger.Warnf(ctx, "- [Model      ] Unsupported sql column '%s %s', using string instead.",
+					column.Name, column.DataType.Name)
+			}
+		}
+
 		text, err := generateTemplate(
 			autoGenWarningTemplate+tableModelFileTemplate,
 			tableMetaData,
@@ -242,19 +1201,25 @@ func processTableModels(fileTypes, modelDirPath string, tablesMetaData []metadat
 				"structField": func(columnMetaData metadata.Column) TableModelField {
 					return tableTemplate.Field(columnMetaData)
 				},
+				"commentLines": commentLines,
 			})
-		throw.OnError(err)
+		if err != nil {
+			return fmt.Errorf("failed to generate %s file: %w", path.Join(modelDirPath, tableTemplate.FileName), err)
+		}
 
-		err = utils.SaveGoFile(modelDirPath, tableTemplate.FileName, text)
-		throw.OnError(err)
+		if err := files.addGoFile(modelDirPath, tableTemplate.FileName, text); err != nil {
+			return err
+		}
 	}
+
+	return nil
 }
 
-func processEnumModels(modelDir string, enumsMetaData []metadata.Enum, modelTemplate Model) {
+func processEnumModels(ctx context.Context, files *generatedFiles, modelDir string, enumsMetaData []metadata.Enum, modelTemplate Model) error {
 	if len(enumsMetaData) == 0 {
-		return
+		return nil
 	}
-	fmt.Print("Generating enum model files...\n")
+	logger.Infof(ctx, "Generating enum model files...")
 
 	for _, enumMetaData := range enumsMetaData {
 		enumTemplate := modelTemplate.Enum(enumMetaData)
@@ -277,11 +1242,16 @@ func processEnumModels(modelDir string, enumsMetaData []metadata.Enum, modelTemp
 					return enumTemplate.ValueName(value)
 				},
 			})
-		throw.OnError(err)
+		if err != nil {
+			return fmt.Errorf("failed to generate %s file: %w", path.Join(modelDir, enumTemplate.FileName), err)
+		}
 
-		err = utils.SaveGoFile(modelDir, enumTemplate.FileName, text)
-		throw.OnError(err)
+		if err := files.addGoFile(modelDir, enumTemplate.FileName, text); err != nil {
+			return err
+		}
 	}
+
+	return nil
 }
 
 func generateTemplate(templateText string, templateData interface{}, funcMap template.FuncMap) ([]byte, error) {
@@ -0,0 +1,240 @@
+package template
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-jet/jet/v2/generator/metadata"
+	"github.com/go-jet/jet/v2/mysql"
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/go-jet/jet/v2/sqlite"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSQLBuilderColumnTypes(t *testing.T) {
+	enums := []metadata.Enum{{Name: "order_status"}, {Name: "mood"}}
+	sqlBuilder := DefaultSQLBuilder().UseEnum(func(enum metadata.Enum) EnumSQLBuilder {
+		return DefaultEnumSQLBuilder(enum).UseTypeName("Typed" + DefaultEnumSQLBuilder(enum).TypeName).UseTypedColumns(true)
+	})
+
+	enumTypes := getEnumSQLBuilderTypes(context.Background(), "./gen/jetdb/sql", enums, sqlBuilder)
+	require.Equal(t, enumTypes["mood"].importPath, "github.com/go-jet/jet/v2/generator/template/gen/jetdb/sql/enum")
+
+	columnTypes := newSQLBuilderColumnTypes(postgres.Dialect, "./gen/jetdb/sql/table", enumTypes)
+
+	statusColumn := TableSQLBuilderColumn{Name: "Status", Type: "String", Enum: "order_status"}
+	require.Equal(t, columnTypes.columnType(statusColumn), "enum.ColumnTypedOrderStatus")
+	require.Equal(t, columnTypes.columnConstructor(statusColumn), "enum.TypedOrderStatusColumn")
+
+	unknownEnumColumn := TableSQLBuilderColumn{Name: "Level", Type: "String", Enum: "level"}
+	require.Equal(t, columnTypes.columnType(unknownEnumColumn), "postgres.ColumnString")
+	require.Equal(t, columnTypes.columnConstructor(unknownEnumColumn), "postgres.StringColumn")
+
+	columns := []metadata.Column{
+		{Name: "status", DataType: metadata.DataType{Name: "order_status", Kind: metadata.EnumType}},
+		{Name: "mood", DataType: metadata.DataType{Name: "mood", Kind: metadata.EnumType}},
+		{Name: "name", DataType: metadata.DataType{Name: "text", Kind: metadata.BaseType}},
+	}
+	require.Equal(t, columnTypes.imports(columns, DefaultTableSQLBuilderColumn),
+		[]string{"github.com/go-jet/jet/v2/generator/template/gen/jetdb/sql/enum"})
+
+	sameDirColumnTypes := newSQLBuilderColumnTypes(postgres.Dialect, "./gen/jetdb/sql/enum", enumTypes)
+	require.Equal(t, sameDirColumnTypes.columnType(statusColumn), "ColumnTypedOrderStatus")
+	require.Empty(t, sameDirColumnTypes.imports(columns, DefaultTableSQLBuilderColumn))
+}
+
+func TestSQLBuilderColumnTypes_UntypedEnum(t *testing.T) {
+	enumTypes := getEnumSQLBuilderTypes(context.Background(), "./gen", []metadata.Enum{{Name: "mood"}}, DefaultSQLBuilder())
+	require.Empty(t, enumTypes, "typed enum columns are not generated by default")
+}
+
+func TestSQLBuilderColumnTypes_SkippedEnum(t *testing.T) {
+	sqlBuilder := DefaultSQLBuilder().UseEnum(func(enum metadata.Enum) EnumSQLBuilder {
+		enumSQLBuilder := DefaultEnumSQLBuilder(enum)
+		enumSQLBuilder.Skip = true
+		return enumSQLBuilder
+	})
+
+	enumTypes := getEnumSQLBuilderTypes(context.Background(), "./gen", []metadata.Enum{{Name: "mood"}}, sqlBuilder)
+	require.Empty(t, enumTypes)
+}
+
+func TestRoutineSQLBuilderParameters(t *testing.T) {
+	routine := metadata.Routine{
+		Name: "film_in_stock",
+		Type: metadata.FunctionRoutine,
+		Parameters: []metadata.RoutineParameter{
+			{Name: "p_film_id", Mode: metadata.InParameter, DataType: metadata.DataType{Name: "integer", Kind: metadata.BaseType}},
+			{Name: "type", Mode: metadata.InParameter, DataType: metadata.DataType{Name: "text", Kind: metadata.BaseType}},
+			{Name: "", Mode: metadata.InOutParameter, DataType: metadata.DataType{Name: "int4", Kind: metadata.ArrayType}},
+			{Name: "p_count", Mode: metadata.OutParameter, DataType: metadata.DataType{Name: "integer", Kind: metadata.BaseType}},
+		},
+		ReturnType: metadata.DataType{Name: "integer", Kind: metadata.BaseType},
+	}
+
+	columnTypes := newSQLBuilderColumnTypes(postgres.Dialect, "./gen/function", nil)
+
+	require.Equal(t, newRoutineSQLBuilderParameters(postgres.Dialect, routine, columnTypes), []routineSQLBuilderParameter{
+		{Name: "pFilmID", Type: "postgres.IntegerExpression"},
+		{Name: "type_", Type: "postgres.StringExpression"},
+		{Name: "arg3", Type: "postgres.IntegerArrayExpression"},
+	})
+
+	require.Equal(t, columnTypes.expressionType(routineReturnColumn(routine)), "postgres.IntegerExpression")
+	require.Equal(t, columnTypes.expressionWrapper(routineReturnColumn(routine)), "postgres.IntExp")
+
+	voidRoutine := metadata.Routine{Name: "refresh", ReturnType: metadata.DataType{Name: "void", Kind: metadata.BaseType}}
+	require.Equal(t, columnTypes.expressionType(routineReturnColumn(voidRoutine)), "postgres.Expression")
+	require.Equal(t, columnTypes.expressionWrapper(routineReturnColumn(voidRoutine)), "")
+}
+
+func TestGetTableRelationships(t *testing.T) {
+	language := metadata.Table{Name: "language", Columns: []metadata.Column{{Name: "language_id"}, {Name: "name"}}}
+	film := metadata.Table{
+		Name:    "film",
+		Columns: []metadata.Column{{Name: "film_id"}, {Name: "language_id"}, {Name: "language"}},
+		ForeignKeys: []metadata.ForeignKey{
+			{Name: "film_language_id_fkey", Columns: []string{"language_id"}, ReferencedSchema: "dvds",
+				ReferencedTable: "language", ReferencedColumns: []string{"language_id"}},
+			{Name: "film_other_fkey", Columns: []string{"film_id"}, ReferencedSchema: "other",
+				ReferencedTable: "language", ReferencedColumns: []string{"language_id"}},
+		},
+	}
+	tables := []metadata.Table{film, language}
+
+	sqlBuilder := DefaultSQLBuilder()
+	filmBuilder := sqlBuilder.Table(film)
+
+	// relationship name clashes with Language column field
+	require.Empty(t, getTableRelationships(context.Background(), postgres.Dialect, "dvds", film, filmBuilder, tables, sqlBuilder))
+
+	filmBuilder = filmBuilder.UseForeignKey(func(foreignKey metadata.ForeignKey) TableSQLBuilderForeignKey {
+		return DefaultTableSQLBuilderForeignKey(film, foreignKey).UseName("FilmLanguage")
+	})
+
+	relationships := getTableRelationships(context.Background(), postgres.Dialect, "dvds", film, filmBuilder, tables, sqlBuilder)
+	require.Equal(t, relationships, []tableRelationship{
+		{
+			Name:                   "FilmLanguage",
+			ForeignKey:             film.ForeignKeys[0],
+			ReferencedTypeName:     "LanguageTable",
+			ReferencedInstanceName: "Language",
+			Columns:                []tableRelationshipColumn{{Field: "LanguageID", ReferencedField: "LanguageID"}},
+		},
+	})
+
+	skipLanguage := sqlBuilder.UseTable(func(table metadata.Table) TableSQLBuilder {
+		return TableSQLBuilder{Skip: table.Name == "language"}
+	})
+	require.Empty(t, getTableRelationships(context.Background(), postgres.Dialect, "dvds", film, filmBuilder, tables, skipLanguage))
+}
+
+func TestCommentLines(t *testing.T) {
+	require.Nil(t, commentLines(""))
+	require.Nil(t, commentLines(" \n "))
+	require.Equal(t, commentLines("Film title"), []string{"Film title"})
+	require.Equal(t, commentLines("Film catalog. \r\n\r\nOne row per title.\n"),
+		[]string{"Film catalog.", "", "One row per title."})
+}
+
+func TestNewTableRepository(t *testing.T) {
+	table := metadata.Table{
+		Name: "item",
+		Columns: []metadata.Column{
+			{Name: "id", IsPrimaryKey: true, HasDefault: true, DataType: metadata.DataType{Name: "integer", Kind: metadata.BaseType}},
+			{Name: "mood", IsPrimaryKey: true, DataType: metadata.DataType{Name: "mood", Kind: metadata.EnumType}},
+			{Name: "type", DataType: metadata.DataType{Name: "text", Kind: metadata.BaseType}},
+		},
+	}
+	schema := DefaultSchema(metadata.Schema{}).UseRepository(DefaultRepository())
+
+	repository, ok := newTableRepository(context.Background(), postgres.Dialect, "./gen/jetdb", table, schema, nil)
+	require.True(t, ok)
+	require.Equal(t, "model.Item", repository.ModelType)
+	require.Equal(t, "table.Item", repository.SQLBuilder)
+	require.Equal(t, []repositoryColumn{
+		{Field: "ID", Column: "ID", Param: "id", ParamType: "int32", Wrapper: "postgres.IntExp", Literal: "postgres.Int32(id)"},
+		{Field: "Mood", Column: "Mood", Param: "mood", ParamType: "model.Mood", Wrapper: "postgres.StringExp", Literal: "postgres.String(string(mood))"},
+	}, repository.PrimaryKey)
+	require.Equal(t, []repositoryColumn{{Field: "Type", Column: "Type", Wrapper: "postgres.StringExp"}}, repository.MutableColumns)
+	require.Len(t, repository.InsertColumns, 2) // default valued primary key is assigned by database
+	require.False(t, repository.DefaultOnZero)
+	require.Equal(t, []string{
+		"github.com/go-jet/jet/v2/generator/template/gen/jetdb/model",
+		"github.com/go-jet/jet/v2/generator/template/gen/jetdb/table",
+	}, repository.Imports)
+	require.Nil(t, repository.LastInsertID)
+
+	defaultOnZeroSchema := schema.UseRepository(DefaultRepository().UseTable(func(table metadata.Table) TableRepository {
+		return DefaultTableRepository(table).UseDefaultOnZero(true)
+	}))
+
+	repository, ok = newTableRepository(context.Background(), postgres.Dialect, "./gen/jetdb", table, defaultOnZeroSchema, nil)
+	require.True(t, ok)
+	require.True(t, repository.DefaultOnZero)
+	require.Len(t, repository.InsertColumns, 3)
+
+	sqliteRepository, ok := newTableRepository(context.Background(), sqlite.Dialect, "./gen/jetdb", table, defaultOnZeroSchema, nil)
+	require.True(t, ok)
+	require.False(t, sqliteRepository.DefaultOnZero, "sqlite does not support DEFAULT in VALUES list")
+	require.Len(t, sqliteRepository.InsertColumns, 2)
+
+	table.Columns = table.Columns[:1]
+	mysqlRepository, ok := newTableRepository(context.Background(), mysql.Dialect, "./gen/jetdb", table, schema, nil)
+	require.True(t, ok)
+	require.Equal(t, []repositoryColumn{{Field: "ID", Column: "ID", Param: "id", ParamType: "int32", Wrapper: "mysql.IntExp", Literal: "mysql.Int32(id)"}},
+		mysqlRepository.UpdateOnConflict)
+	require.Equal(t, &mysqlRepository.PrimaryKey[0], mysqlRepository.LastInsertID)
+	require.Empty(t, mysqlRepository.InsertColumns)
+
+	table.Columns = []metadata.Column{{Name: "id", IsPrimaryKey: true, DataType: metadata.DataType{Name: "point", Kind: metadata.BaseType}}}
+	schema = schema.UseModel(DefaultModel().UseTable(func(table metadata.Table) TableModel {
+		return DefaultTableModel(table).UseField(func(column metadata.Column) TableModelField {
+			return DefaultTableModelField(column).UseType(Type{Name: "Point"})
+		})
+	}))
+	_, ok = newTableRepository(context.Background(), postgres.Dialect, "./gen/jetdb", table, schema, nil)
+	require.False(t, ok, "no literal constructor for primary key parameter type")
+
+	table.Columns = []metadata.Column{{Name: "name", DataType: metadata.DataType{Name: "text", Kind: metadata.BaseType}}}
+	_, ok = newTableRepository(context.Background(), postgres.Dialect, "./gen/jetdb", table, schema, nil)
+	require.False(t, ok)
+}
+
+func TestParamName(t *testing.T) {
+	require.Equal(t, "id", paramName("ID"))
+	require.Equal(t, "actorID", paramName("ActorID"))
+	require.Equal(t, "uuidKey", paramName("UUIDKey"))
+	require.Equal(t, "a", paramName("A"))
+}
+
+func TestQualifiedTypeName(t *testing.T) {
+	require.Equal(t, "int64", qualifiedTypeName("int64", "model"))
+	require.Equal(t, "[]byte", qualifiedTypeName("[]byte", "model"))
+	require.Equal(t, "time.Time", qualifiedTypeName("time.Time", "model"))
+	require.Equal(t, "model.Mood", qualifiedTypeName("Mood", "model"))
+	require.Equal(t, "[]model.Mood", qualifiedTypeName("[]Mood", "model"))
+}
+
+func TestCheckSchemaContext_Error(t *testing.T) {
+	schema := metadata.Schema{
+		Name: "dvds",
+		TablesMetaData: []metadata.Table{{Name: "actor", Columns: []metadata.Column{
+			{Name: "actor_id", DataType: metadata.DataType{Name: "integer", Kind: metadata.BaseType}},
+		}}},
+	}
+
+	invalidTemplate := Default(postgres.Dialect).UseSchema(func(schemaMetaData metadata.Schema) Schema {
+		return DefaultSchema(schemaMetaData).UseModel(DefaultModel().UseTable(func(table metadata.Table) TableModel {
+			return DefaultTableModel(table).UseTypeName("Invalid Type")
+		}))
+	})
+
+	_, err := CheckSchemaContext(context.Background(), t.TempDir(), schema, invalidTemplate)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "failed to format")
+
+	_, err = CheckSchemaContext(context.Background(), t.TempDir(), schema,
+		Default(postgres.Dialect).UseFilters(metadata.Filters{Tables: metadata.Filter{Include: []string{"actor_["}}}))
+	require.EqualError(t, err, "invalid filter pattern actor_[: syntax error in pattern")
+}
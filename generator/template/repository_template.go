@@ -0,0 +1,87 @@
+package template
+
+import (
+	"path"
+
+	"github.com/go-jet/jet/v2/generator/metadata"
+	"github.com/go-jet/jet/v2/internal/utils"
+)
+
+// Repository is template for generating table repository files. Repository files contain CRUD helpers
+// (FindByPK, Insert, Update, DeleteByPK and Upsert) for each table with primary key, built on top of generated
+// model and sql builder types. Repository files are not generated by default, see Schema.UseRepository.
+type Repository struct {
+	Skip  bool
+	Path  string
+	Table func(table metadata.Table) TableRepository
+}
+
+// DefaultRepository returns default Repository implementation
+func DefaultRepository() Repository {
+	return Repository{
+		Path:  "/repository",
+		Table: DefaultTableRepository,
+	}
+}
+
+// PackageName returns package name of repository types
+func (r Repository) PackageName() string {
+	return path.Base(r.Path)
+}
+
+// UsePath returns new Repository with new relative path set
+func (r Repository) UsePath(path string) Repository {
+	r.Path = path
+	return r
+}
+
+// UseTable returns new Repository with new TableRepository template function set
+func (r Repository) UseTable(tableFunc func(table metadata.Table) TableRepository) Repository {
+	r.Table = tableFunc
+	return r
+}
+
+// TableRepository is template for table repository file generation
+type TableRepository struct {
+	Skip         bool
+	FileName     string
+	TypeName     string
+	InstanceName string
+	// DefaultOnZero, if set, makes Insert method insert database default values instead of zero-valued fields of
+	// the defaultable columns. Note that explicit zero values (false, 0, "") are then replaced with defaults as well.
+	// Not supported for SQLite, which does not have DEFAULT keyword in VALUES list.
+	DefaultOnZero bool
+}
+
+// DefaultTableRepository returns default TableRepository implementation
+func DefaultTableRepository(tableMetaData metadata.Table) TableRepository {
+	return TableRepository{
+		FileName:     utils.ToGoFileName(tableMetaData.Name),
+		TypeName:     utils.ToGoIdentifier(tableMetaData.Name) + "Repository",
+		InstanceName: utils.ToGoIdentifier(tableMetaData.Name),
+	}
+}
+
+// UseFileName returns new TableRepository with new file name set
+func (t TableRepository) UseFileName(fileName string) TableRepository {
+	t.FileName = fileName
+	return t
+}
+
+// UseTypeName returns new TableRepository with new type name set
+func (t TableRepository) UseTypeName(typeName string) TableRepository {
+	t.TypeName = typeName
+	return t
+}
+
+// UseInstanceName returns new TableRepository with new instance name set
+func (t TableRepository) UseInstanceName(instanceName string) TableRepository {
+	t.InstanceName = instanceName
+	return t
+}
+
+// UseDefaultOnZero returns new TableRepository with DefaultOnZero flag set
+func (t TableRepository) UseDefaultOnZero(defaultOnZero bool) TableRepository {
+	t.DefaultOnZero = defaultOnZero
+	return t
+}
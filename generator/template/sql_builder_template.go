@@ -1,7 +1,6 @@
 package template
 
 import (
-	"fmt"
 	"github.com/go-jet/jet/v2/generator/metadata"
 	"github.com/go-jet/jet/v2/internal/utils"
 	"path"
@@ -11,14 +10,16 @@ import (
 
 // SQLBuilder is template for generating sql builder files
 type SQLBuilder struct {
-	Skip  bool
-	Path  string
-	Table func(table metadata.Table) TableSQLBuilder
-	View  func(view metadata.Table) TableSQLBuilder
-	Enum  func(enum metadata.Enum) EnumSQLBuilder
+	Skip    bool
+	Path    string
+	Table   func(table metadata.Table) TableSQLBuilder
+	View    func(view metadata.Table) TableSQLBuilder
+	Enum    func(enum metadata.Enum) EnumSQLBuilder
+	Routine func(routine metadata.Routine) RoutineSQLBuilder
 }
 
-// DefaultSQLBuilder returns default SQLBuilder implementation
+// DefaultSQLBuilder returns default SQLBuilder implementation. Routine (function and stored procedure) sql builder
+// files are not generated by default, see UseRoutine and DefaultRoutineSQLBuilder.
 func DefaultSQLBuilder() SQLBuilder {
 	return SQLBuilder{
 		Path:  "",
@@ -52,6 +53,15 @@ func (sb SQLBuilder) UseEnum(enumFunc func(enum metadata.Enum) EnumSQLBuilder) S
 	return sb
 }
 
+// UseRoutine returns new SQLBuilder with new RoutineSQLBuilder template function set. For instance, to generate
+// routine sql builder files with default settings:
+//
+//	template.DefaultSQLBuilder().UseRoutine(template.DefaultRoutineSQLBuilder)
+func (sb SQLBuilder) UseRoutine(routineFunc func(routine metadata.Routine) RoutineSQLBuilder) SQLBuilder {
+	sb.Routine = routineFunc
+	return sb
+}
+
 // TableSQLBuilder is template for generating table SQLBuilder files
 type TableSQLBuilder struct {
 	Skip         bool
@@ -60,6 +70,7 @@ type TableSQLBuilder struct {
 	InstanceName string
 	TypeName     string
 	Column       func(columnMetaData metadata.Column) TableSQLBuilderColumn
+	ForeignKey   func(foreignKeyMetaData metadata.ForeignKey) TableSQLBuilderForeignKey
 }
 
 // ViewSQLBuilder is template for generating view SQLBuilder files
@@ -73,6 +84,9 @@ func DefaultTableSQLBuilder(tableMetaData metadata.Table) TableSQLBuilder {
 		InstanceName: utils.ToGoIdentifier(tableMetaData.Name),
 		TypeName:     utils.ToGoIdentifier(tableMetaData.Name) + "Table",
 		Column:       DefaultTableSQLBuilderColumn,
+		ForeignKey: func(foreignKeyMetaData metadata.ForeignKey) TableSQLBuilderForeignKey {
+			return DefaultTableSQLBuilderForeignKey(tableMetaData, foreignKeyMetaData)
+		},
 	}
 }
 
@@ -118,27 +132,124 @@ func (tb TableSQLBuilder) UseColumn(columnsFunc func(column metadata.Column) Tab
 	return tb
 }
 
+// UseForeignKey returns new TableSQLBuilder with new foreign key template function set
+func (tb TableSQLBuilder) UseForeignKey(foreignKeyFunc func(foreignKey metadata.ForeignKey) TableSQLBuilderForeignKey) TableSQLBuilder {
+	tb.ForeignKey = foreignKeyFunc
+	return tb
+}
+
+// TableSQLBuilderForeignKey is template for table sql builder foreign key relationship helpers.
+// For each foreign key referencing a table from the same sql builder package, join condition method
+// Name and INNER_JOIN_<Name>, LEFT_JOIN_<Name> join methods are generated.
+type TableSQLBuilderForeignKey struct {
+	Skip bool
+	Name string
+}
+
+// DefaultTableSQLBuilderForeignKey returns default implementation of TableSQLBuilderForeignKey.
+// Relationship is named after the referenced table, or after the foreign key columns, without
+// the id suffix, if the foreign key is self-referencing or if the table has more than one foreign key
+// referencing the same table.
+func DefaultTableSQLBuilderForeignKey(tableMetaData metadata.Table, foreignKeyMetaData metadata.ForeignKey) TableSQLBuilderForeignKey {
+	sameReferencedTable := 0
+
+	for _, foreignKey := range tableMetaData.ForeignKeys {
+		if foreignKey.ReferencedSchema == foreignKeyMetaData.ReferencedSchema &&
+			foreignKey.ReferencedTable == foreignKeyMetaData.ReferencedTable {
+			sameReferencedTable++
+		}
+	}
+
+	selfReferencing := foreignKeyMetaData.ReferencedTable == tableMetaData.Name
+
+	if sameReferencedTable <= 1 && !selfReferencing {
+		return TableSQLBuilderForeignKey{
+			Name: utils.ToGoIdentifier(foreignKeyMetaData.ReferencedTable),
+		}
+	}
+
+	var names []string
+
+	for _, column := range foreignKeyMetaData.Columns {
+		name := column
+
+		switch {
+		case strings.HasSuffix(strings.ToLower(column), "_id"):
+			name = column[:len(column)-3]
+		case strings.HasSuffix(column, "Id"), strings.HasSuffix(column, "ID"):
+			name = column[:len(column)-2]
+		}
+
+		if name == "" {
+			name = column
+		}
+
+		names = append(names, name)
+	}
+
+	return TableSQLBuilderForeignKey{
+		Name: utils.ToGoIdentifier(strings.Join(names, "_")),
+	}
+}
+
+// UseName returns new TableSQLBuilderForeignKey with new relationship name set
+func (fk TableSQLBuilderForeignKey) UseName(name string) TableSQLBuilderForeignKey {
+	fk.Name = name
+	return fk
+}
+
 // TableSQLBuilderColumn is template for table sql builder column
 type TableSQLBuilderColumn struct {
 	Name string
 	Type string
+	// Enum is database enum type name of the column. If enum sql builder types are generated for this enum,
+	// column is generated as typed enum column, instead of the column of Type.
+	Enum    string
+	Comment string
 }
 
 // DefaultTableSQLBuilderColumn returns default implementation of TableSQLBuilderColumn
 func DefaultTableSQLBuilderColumn(columnMetaData metadata.Column) TableSQLBuilderColumn {
-	return TableSQLBuilderColumn{
-		Name: utils.ToGoIdentifier(columnMetaData.Name),
-		Type: getSqlBuilderColumnType(columnMetaData),
+	column := TableSQLBuilderColumn{
+		Name:    utils.ToGoIdentifier(columnMetaData.Name),
+		Type:    getSqlBuilderColumnType(columnMetaData),
+		Comment: columnMetaData.Comment,
+	}
+
+	if columnMetaData.DataType.Kind == metadata.EnumType {
+		column.Enum = columnMetaData.DataType.Name
 	}
+
+	return column
 }
 
-// getSqlBuilderColumnType returns type of jet sql builder column
+// getSqlBuilderColumnType returns type of jet sql builder column. Unsupported column types are mapped to StringColumn.
 func getSqlBuilderColumnType(columnMetaData metadata.Column) string {
+	if columnType := sqlBuilderColumnType(columnMetaData); columnType != "" {
+		return columnType
+	}
+
+	return "String"
+}
+
+// isUnsupportedSQLBuilderType returns true if there is no sql builder column type for the column database type
+func isUnsupportedSQLBuilderType(columnMetaData metadata.Column) bool {
+	return sqlBuilderColumnType(columnMetaData) == ""
+}
+
+// sqlBuilderColumnType returns type of jet sql builder column, or empty string if column type is not supported
+func sqlBuilderColumnType(columnMetaData metadata.Column) string {
+	if columnMetaData.DataType.Kind == metadata.ArrayType {
+		return getSqlBuilderArrayColumnType(columnMetaData)
+	}
+
 	if columnMetaData.DataType.Kind != metadata.BaseType {
 		return "String"
 	}
 
 	switch strings.ToLower(columnMetaData.DataType.Name) {
+	case "void": // return type of functions without result
+		return "Void"
 	case "boolean":
 		return "Bool"
 	case "smallint", "integer", "bigint",
@@ -158,8 +269,24 @@ func getSqlBuilderColumnType(columnMetaData metadata.Column) string {
 		return "Timez"
 	case "interval":
 		return "Interval"
+	case "json", "jsonb":
+		return "Json"
+	case "tsvector":
+		return "TsVector"
+	case "tsquery":
+		return "TsQuery"
+	case "int4range", "int8range", "int4multirange", "int8multirange":
+		return "IntegerRange"
+	case "numrange", "nummultirange":
+		return "FloatRange"
+	case "daterange", "datemultirange":
+		return "DateRange"
+	case "tsrange", "tsmultirange":
+		return "TimestampRange"
+	case "tstzrange", "tstzmultirange":
+		return "TimestampzRange"
 	case "user-defined", "enum", "text", "character", "character varying", "bytea", "uuid",
-		"tsvector", "bit", "bit varying", "money", "json", "jsonb", "xml", "point", "line", "ARRAY",
+		"bit", "bit varying", "money", "xml", "point", "line", "ARRAY",
 		"char", "varchar", "nvarchar", "binary", "varbinary",
 		"tinyblob", "blob", "mediumblob", "longblob", "tinytext", "mediumtext", "longtext": // MySQL
 		return "String"
@@ -167,7 +294,37 @@ func getSqlBuilderColumnType(columnMetaData metadata.Column) string {
 		"double": // MySQL
 		return "Float"
 	default:
-		fmt.Println("- [SQL Builder] Unsupported sql column '" + columnMetaData.Name + " " + columnMetaData.DataType.Name + "', using StringColumn instead.")
+		return ""
+	}
+}
+
+// getSqlBuilderArrayColumnType returns type of jet sql builder array column. Array data type name is the name of
+// the array element type. Multidimensional arrays, and arrays of unsupported element types, are mapped to StringColumn.
+func getSqlBuilderArrayColumnType(columnMetaData metadata.Column) string {
+	if columnMetaData.DataType.Dimensions > 1 {
+		return "String"
+	}
+
+	switch strings.ToLower(columnMetaData.DataType.Name) {
+	case "bool":
+		return "BoolArray"
+	case "int2", "int4", "int8":
+		return "IntegerArray"
+	case "float4", "float8", "numeric":
+		return "FloatArray"
+	case "text", "bpchar", "varchar", "uuid":
+		return "StringArray"
+	case "date":
+		return "DateArray"
+	case "time":
+		return "TimeArray"
+	case "timetz":
+		return "TimezArray"
+	case "timestamp":
+		return "TimestampArray"
+	case "timestamptz":
+		return "TimestampzArray"
+	default:
 		return "String"
 	}
 }
@@ -178,7 +335,12 @@ type EnumSQLBuilder struct {
 	Path         string
 	FileName     string
 	InstanceName string
+	TypeName     string // base name of enum expression and column types, for instance MpaaRatingExpression and ColumnMpaaRating
 	ValueName    func(enumValue string) string
+	// TypedColumns, if set, generates enum expression and column types, and enum columns of table and view sql
+	// builders are generated as typed enum columns instead of string columns. Typed enum columns are not
+	// compatible with string columns, use AsString() to apply string operators.
+	TypedColumns bool
 }
 
 // DefaultEnumSQLBuilder returns default implementation of EnumSQLBuilder
@@ -187,6 +349,7 @@ func DefaultEnumSQLBuilder(enumMetaData metadata.Enum) EnumSQLBuilder {
 		Path:         "/enum",
 		FileName:     utils.ToGoFileName(enumMetaData.Name),
 		InstanceName: utils.ToGoIdentifier(enumMetaData.Name),
+		TypeName:     utils.ToGoIdentifier(enumMetaData.Name),
 		ValueName: func(enumValue string) string {
 			return defaultEnumValueName(enumMetaData.Name, enumValue)
 		},
@@ -216,6 +379,18 @@ func (e EnumSQLBuilder) UseInstanceName(name string) EnumSQLBuilder {
 	return e
 }
 
+// UseTypeName returns new EnumSQLBuilder with new type name set
+func (e EnumSQLBuilder) UseTypeName(name string) EnumSQLBuilder {
+	e.TypeName = name
+	return e
+}
+
+// UseTypedColumns returns new EnumSQLBuilder with TypedColumns flag set
+func (e EnumSQLBuilder) UseTypedColumns(typedColumns bool) EnumSQLBuilder {
+	e.TypedColumns = typedColumns
+	return e
+}
+
 func defaultEnumValueName(enumName, enumValue string) string {
 	enumValueName := utils.ToGoIdentifier(enumValue)
 	if !unicode.IsLetter([]rune(enumValueName)[0]) {
@@ -224,3 +399,66 @@ func defaultEnumValueName(enumName, enumValue string) string {
 
 	return enumValueName
 }
+
+// RoutineSQLBuilder is template for generating database function and stored procedure SQLBuilder files
+type RoutineSQLBuilder struct {
+	Skip     bool
+	Path     string
+	FileName string
+	FuncName string // name of the generated go function
+	TypeName string // name of the generated table type, for functions returning rows
+	Column   func(columnMetaData metadata.Column) TableSQLBuilderColumn
+}
+
+// DefaultRoutineSQLBuilder returns default implementation of RoutineSQLBuilder. Functions are generated in
+// 'function' package, and stored procedures in 'procedure' package.
+func DefaultRoutineSQLBuilder(routineMetaData metadata.Routine) RoutineSQLBuilder {
+	routinePath := "/function"
+
+	if routineMetaData.IsProcedure() {
+		routinePath = "/procedure"
+	}
+
+	return RoutineSQLBuilder{
+		Path:     routinePath,
+		FileName: utils.ToGoFileName(routineMetaData.Name),
+		FuncName: utils.ToGoIdentifier(routineMetaData.Name),
+		TypeName: utils.ToGoIdentifier(routineMetaData.Name) + "Table",
+		Column:   DefaultTableSQLBuilderColumn,
+	}
+}
+
+// PackageName returns package name of routine sql builder types
+func (r RoutineSQLBuilder) PackageName() string {
+	return path.Base(r.Path)
+}
+
+// UsePath returns new RoutineSQLBuilder with new relative path set
+func (r RoutineSQLBuilder) UsePath(path string) RoutineSQLBuilder {
+	r.Path = path
+	return r
+}
+
+// UseFileName returns new RoutineSQLBuilder with new file name set
+func (r RoutineSQLBuilder) UseFileName(name string) RoutineSQLBuilder {
+	r.FileName = name
+	return r
+}
+
+// UseFuncName returns new RoutineSQLBuilder with new go function name set
+func (r RoutineSQLBuilder) UseFuncName(name string) RoutineSQLBuilder {
+	r.FuncName = name
+	return r
+}
+
+// UseTypeName returns new RoutineSQLBuilder with new table type name set
+func (r RoutineSQLBuilder) UseTypeName(name string) RoutineSQLBuilder {
+	r.TypeName = name
+	return r
+}
+
+// UseColumn returns new RoutineSQLBuilder with new result column template function set
+func (r RoutineSQLBuilder) UseColumn(columnFunc func(column metadata.Column) TableSQLBuilderColumn) RoutineSQLBuilder {
+	r.Column = columnFunc
+	return r
+}
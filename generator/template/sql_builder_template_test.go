@@ -1,6 +1,7 @@
 package template
 
 import (
+	"github.com/go-jet/jet/v2/generator/metadata"
 	"github.com/stretchr/testify/require"
 	"testing"
 )
@@ -9,3 +10,80 @@ func TestToGoEnumValueIdentifier(t *testing.T) {
 	require.Equal(t, defaultEnumValueName("enum_name", "enum_value"), "EnumValue")
 	require.Equal(t, defaultEnumValueName("NumEnum", "100"), "NumEnum100")
 }
+
+func TestGetSqlBuilderColumnType(t *testing.T) {
+	column := func(dataType string) metadata.Column {
+		return metadata.Column{Name: "col", DataType: metadata.DataType{Name: dataType, Kind: metadata.BaseType}}
+	}
+
+	require.Equal(t, getSqlBuilderColumnType(column("text")), "String")
+	require.Equal(t, getSqlBuilderColumnType(column("interval")), "Interval")
+	require.Equal(t, getSqlBuilderColumnType(column("json")), "Json")
+	require.Equal(t, getSqlBuilderColumnType(column("jsonb")), "Json")
+	require.Equal(t, getSqlBuilderColumnType(column("tsvector")), "TsVector")
+	require.Equal(t, getSqlBuilderColumnType(column("tsquery")), "TsQuery")
+	require.Equal(t, getSqlBuilderColumnType(column("int4range")), "IntegerRange")
+	require.Equal(t, getSqlBuilderColumnType(column("int8multirange")), "IntegerRange")
+	require.Equal(t, getSqlBuilderColumnType(column("numrange")), "FloatRange")
+	require.Equal(t, getSqlBuilderColumnType(column("daterange")), "DateRange")
+	require.Equal(t, getSqlBuilderColumnType(column("tsrange")), "TimestampRange")
+	require.Equal(t, getSqlBuilderColumnType(column("tstzrange")), "TimestampzRange")
+	require.Equal(t, getSqlBuilderColumnType(column("tstzmultirange")), "TimestampzRange")
+
+	arrayColumn := func(elemType string, dimensions int) metadata.Column {
+		return metadata.Column{Name: "col", DataType: metadata.DataType{Name: elemType, Kind: metadata.ArrayType, Dimensions: dimensions}}
+	}
+
+	require.Equal(t, getSqlBuilderColumnType(arrayColumn("int4", 1)), "IntegerArray")
+	require.Equal(t, getSqlBuilderColumnType(arrayColumn("text", 0)), "StringArray")
+	require.Equal(t, getSqlBuilderColumnType(arrayColumn("timestamptz", 1)), "TimestampzArray")
+	require.Equal(t, getSqlBuilderColumnType(arrayColumn("text", 2)), "String")
+	require.Equal(t, getSqlBuilderColumnType(arrayColumn("jsonb", 1)), "String")
+}
+
+func TestDefaultTableSQLBuilderColumn_Enum(t *testing.T) {
+	column := DefaultTableSQLBuilderColumn(metadata.Column{
+		Name:     "status",
+		DataType: metadata.DataType{Name: "order_status", Kind: metadata.EnumType},
+	})
+
+	require.Equal(t, column.Name, "Status")
+	require.Equal(t, column.Type, "String")
+	require.Equal(t, column.Enum, "order_status")
+
+	require.Equal(t, DefaultEnumSQLBuilder(metadata.Enum{Name: "order_status"}).TypeName, "OrderStatus")
+	require.False(t, DefaultEnumSQLBuilder(metadata.Enum{Name: "order_status"}).TypedColumns)
+}
+
+func TestDefaultRoutineSQLBuilder(t *testing.T) {
+	function := DefaultRoutineSQLBuilder(metadata.Routine{Name: "rentals_by_customer", Type: metadata.FunctionRoutine})
+	require.Equal(t, function.Path, "/function")
+	require.Equal(t, function.PackageName(), "function")
+	require.Equal(t, function.FileName, "rentals_by_customer")
+	require.Equal(t, function.FuncName, "RentalsByCustomer")
+	require.Equal(t, function.TypeName, "RentalsByCustomerTable")
+
+	procedure := DefaultRoutineSQLBuilder(metadata.Routine{Name: "update_stats", Type: metadata.ProcedureRoutine})
+	require.Equal(t, procedure.PackageName(), "procedure")
+	require.Equal(t, procedure.FuncName, "UpdateStats")
+}
+
+func TestDefaultTableSQLBuilderForeignKey(t *testing.T) {
+	languageFk := metadata.ForeignKey{Name: "film_language_id_fkey", Columns: []string{"language_id"},
+		ReferencedSchema: "dvds", ReferencedTable: "language", ReferencedColumns: []string{"language_id"}}
+	originalLanguageFk := metadata.ForeignKey{Name: "film_original_language_id_fkey", Columns: []string{"original_language_id"},
+		ReferencedSchema: "dvds", ReferencedTable: "language", ReferencedColumns: []string{"language_id"}}
+
+	film := metadata.Table{Name: "film", ForeignKeys: []metadata.ForeignKey{languageFk}}
+	require.Equal(t, DefaultTableSQLBuilderForeignKey(film, languageFk).Name, "Language")
+	require.Equal(t, DefaultTableSQLBuilder(film).ForeignKey(languageFk).Name, "Language")
+
+	film.ForeignKeys = append(film.ForeignKeys, originalLanguageFk)
+	require.Equal(t, DefaultTableSQLBuilderForeignKey(film, languageFk).Name, "Language")
+	require.Equal(t, DefaultTableSQLBuilderForeignKey(film, originalLanguageFk).Name, "OriginalLanguage")
+
+	managerFk := metadata.ForeignKey{Columns: []string{"managerId", "paid"}, ReferencedSchema: "dvds",
+		ReferencedTable: "staff", ReferencedColumns: []string{"id", "paid"}}
+	staff := metadata.Table{Name: "staff", ForeignKeys: []metadata.ForeignKey{managerFk}}
+	require.Equal(t, DefaultTableSQLBuilderForeignKey(staff, managerFk).Name, "ManagerPaid")
+}
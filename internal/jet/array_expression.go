@@ -0,0 +1,1167 @@
+package jet
+
+// ArrayExpression is common interface for all array expressions
+type ArrayExpression interface {
+	Expression
+
+	isArray()
+}
+
+// Array operators
+const (
+	ArrayContainsOperator    = "@>"
+	ArrayContainedByOperator = "<@"
+	ArrayOverlapOperator     = "&&"
+	ArrayConcatOperator      = "||"
+)
+
+type arrayInterfaceImpl struct{}
+
+func (a *arrayInterfaceImpl) isArray() {}
+
+type arrayExpressionWrapper struct {
+	arrayInterfaceImpl
+	Expression
+}
+
+// ArrayExp is array expression wrapper around arbitrary expression.
+// Allows go compiler to see any expression as array expression.
+// Does not add sql cast to generated sql builder output.
+func ArrayExp(expression Expression) ArrayExpression {
+	return &arrayExpressionWrapper{Expression: expression}
+}
+
+//---------------------------------------------------//
+
+type arraySubscriptExpression struct {
+	ExpressionInterfaceImpl
+
+	array        Expression
+	lower, upper Expression
+}
+
+// newArraySubscriptExpression creates array element access expression - (array)[lower],
+// or array slice expression - (array)[lower:upper], if upper is not nil.
+func newArraySubscriptExpression(array Expression, lower, upper Expression) Expression {
+	subscriptExpression := &arraySubscriptExpression{
+		array: array,
+		lower: lower,
+		upper: upper,
+	}
+	subscriptExpression.ExpressionInterfaceImpl.Parent = subscriptExpression
+
+	return subscriptExpression
+}
+
+func (a *arraySubscriptExpression) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
+	out.WriteString("(")
+	a.array.serialize(statement, out, NoWrap.WithFallTrough(options)...)
+	out.WriteString(")[")
+	a.lower.serialize(statement, out, FallTrough(options)...)
+
+	if a.upper != nil {
+		out.WriteString(":")
+		a.upper.serialize(statement, out, FallTrough(options)...)
+	}
+
+	out.WriteString("]")
+}
+
+type arrayConstructor struct {
+	ExpressionInterfaceImpl
+
+	elems []Expression
+}
+
+func newArrayConstructor(elems []Expression) Expression {
+	constructor := &arrayConstructor{elems: elems}
+	constructor.ExpressionInterfaceImpl.Parent = constructor
+
+	return constructor
+}
+
+func (a *arrayConstructor) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
+	out.WriteString("ARRAY[")
+	serializeExpressionList(statement, a.elems, ", ", out)
+	out.WriteString("]")
+}
+
+//---------------------------------------------------//
+
+// BoolArrayExpression is interface for SQL boolean arrays
+type BoolArrayExpression interface {
+	ArrayExpression
+
+	EQ(rhs BoolArrayExpression) BoolExpression
+	NOT_EQ(rhs BoolArrayExpression) BoolExpression
+	IS_DISTINCT_FROM(rhs BoolArrayExpression) BoolExpression
+	IS_NOT_DISTINCT_FROM(rhs BoolArrayExpression) BoolExpression
+
+	// CONTAINS checks if this array contains all the elements of rhs array
+	CONTAINS(rhs BoolArrayExpression) BoolExpression
+	// IS_CONTAINED_BY checks if all the elements of this array are contained in rhs array
+	IS_CONTAINED_BY(rhs BoolArrayExpression) BoolExpression
+	// OVERLAP checks if this array and rhs array have any elements in common
+	OVERLAP(rhs BoolArrayExpression) BoolExpression
+
+	// CONCAT concatenates two arrays
+	CONCAT(rhs BoolArrayExpression) BoolArrayExpression
+	// APPEND appends element to the end of array
+	APPEND(elem BoolExpression) BoolArrayExpression
+
+	// AT returns array element at the given index
+	AT(index IntegerExpression) BoolExpression
+	// SLICE returns sub-array from lower to upper index (inclusive)
+	SLICE(lower, upper IntegerExpression) BoolArrayExpression
+
+	// ANY compares left hand side expression with each of array elements. Result is true if any comparison yields true.
+	ANY() BoolExpression
+	// ALL compares left hand side expression with each of array elements. Result is true if all comparisons yield true.
+	ALL() BoolExpression
+}
+
+type boolArrayInterfaceImpl struct {
+	arrayInterfaceImpl
+	parent BoolArrayExpression
+}
+
+func (a *boolArrayInterfaceImpl) EQ(rhs BoolArrayExpression) BoolExpression {
+	return Eq(a.parent, rhs)
+}
+
+func (a *boolArrayInterfaceImpl) NOT_EQ(rhs BoolArrayExpression) BoolExpression {
+	return NotEq(a.parent, rhs)
+}
+
+func (a *boolArrayInterfaceImpl) IS_DISTINCT_FROM(rhs BoolArrayExpression) BoolExpression {
+	return IsDistinctFrom(a.parent, rhs)
+}
+
+func (a *boolArrayInterfaceImpl) IS_NOT_DISTINCT_FROM(rhs BoolArrayExpression) BoolExpression {
+	return IsNotDistinctFrom(a.parent, rhs)
+}
+
+func (a *boolArrayInterfaceImpl) CONTAINS(rhs BoolArrayExpression) BoolExpression {
+	return newBinaryBoolOperatorExpression(a.parent, rhs, ArrayContainsOperator)
+}
+
+func (a *boolArrayInterfaceImpl) IS_CONTAINED_BY(rhs BoolArrayExpression) BoolExpression {
+	return newBinaryBoolOperatorExpression(a.parent, rhs, ArrayContainedByOperator)
+}
+
+func (a *boolArrayInterfaceImpl) OVERLAP(rhs BoolArrayExpression) BoolExpression {
+	return newBinaryBoolOperatorExpression(a.parent, rhs, ArrayOverlapOperator)
+}
+
+func (a *boolArrayInterfaceImpl) CONCAT(rhs BoolArrayExpression) BoolArrayExpression {
+	return BoolArrayExp(NewBinaryOperatorExpression(a.parent, rhs, ArrayConcatOperator))
+}
+
+func (a *boolArrayInterfaceImpl) APPEND(elem BoolExpression) BoolArrayExpression {
+	return BoolArrayExp(NewBinaryOperatorExpression(a.parent, elem, ArrayConcatOperator))
+}
+
+func (a *boolArrayInterfaceImpl) AT(index IntegerExpression) BoolExpression {
+	return BoolExp(newArraySubscriptExpression(a.parent, index, nil))
+}
+
+func (a *boolArrayInterfaceImpl) SLICE(lower, upper IntegerExpression) BoolArrayExpression {
+	return BoolArrayExp(newArraySubscriptExpression(a.parent, lower, upper))
+}
+
+func (a *boolArrayInterfaceImpl) ANY() BoolExpression {
+	return newBoolFunc("ANY", a.parent)
+}
+
+func (a *boolArrayInterfaceImpl) ALL() BoolExpression {
+	return newBoolFunc("ALL", a.parent)
+}
+
+type boolArrayExpressionWrapper struct {
+	boolArrayInterfaceImpl
+	Expression
+}
+
+func newBoolArrayExpressionWrap(expression Expression) BoolArrayExpression {
+	arrayExpressionWrap := boolArrayExpressionWrapper{Expression: expression}
+	arrayExpressionWrap.boolArrayInterfaceImpl.parent = &arrayExpressionWrap
+	return &arrayExpressionWrap
+}
+
+// BoolArrayExp is bool array expression wrapper around arbitrary expression.
+// Allows go compiler to see any expression as bool array expression.
+// Does not add sql cast to generated sql builder output.
+func BoolArrayExp(expression Expression) BoolArrayExpression {
+	return newBoolArrayExpressionWrap(expression)
+}
+
+// BoolArray creates ARRAY[...] constructor from the list of bool expressions
+func BoolArray(elems ...BoolExpression) BoolArrayExpression {
+	var expressions []Expression
+
+	for _, elem := range elems {
+		expressions = append(expressions, elem)
+	}
+
+	return BoolArrayExp(newArrayConstructor(expressions))
+}
+
+//---------------------------------------------------//
+
+// IntegerArrayExpression is interface for SQL smallint, integer and bigint arrays
+type IntegerArrayExpression interface {
+	ArrayExpression
+
+	EQ(rhs IntegerArrayExpression) BoolExpression
+	NOT_EQ(rhs IntegerArrayExpression) BoolExpression
+	IS_DISTINCT_FROM(rhs IntegerArrayExpression) BoolExpression
+	IS_NOT_DISTINCT_FROM(rhs IntegerArrayExpression) BoolExpression
+
+	// CONTAINS checks if this array contains all the elements of rhs array
+	CONTAINS(rhs IntegerArrayExpression) BoolExpression
+	// IS_CONTAINED_BY checks if all the elements of this array are contained in rhs array
+	IS_CONTAINED_BY(rhs IntegerArrayExpression) BoolExpression
+	// OVERLAP checks if this array and rhs array have any elements in common
+	OVERLAP(rhs IntegerArrayExpression) BoolExpression
+
+	// CONCAT concatenates two arrays
+	CONCAT(rhs IntegerArrayExpression) IntegerArrayExpression
+	// APPEND appends element to the end of array
+	APPEND(elem IntegerExpression) IntegerArrayExpression
+
+	// AT returns array element at the given index
+	AT(index IntegerExpression) IntegerExpression
+	// SLICE returns sub-array from lower to upper index (inclusive)
+	SLICE(lower, upper IntegerExpression) IntegerArrayExpression
+
+	// ANY compares left hand side expression with each of array elements. Result is true if any comparison yields true.
+	ANY() IntegerExpression
+	// ALL compares left hand side expression with each of array elements. Result is true if all comparisons yield true.
+	ALL() IntegerExpression
+}
+
+type integerArrayInterfaceImpl struct {
+	arrayInterfaceImpl
+	parent IntegerArrayExpression
+}
+
+func (a *integerArrayInterfaceImpl) EQ(rhs IntegerArrayExpression) BoolExpression {
+	return Eq(a.parent, rhs)
+}
+
+func (a *integerArrayInterfaceImpl) NOT_EQ(rhs IntegerArrayExpression) BoolExpression {
+	return NotEq(a.parent, rhs)
+}
+
+func (a *integerArrayInterfaceImpl) IS_DISTINCT_FROM(rhs IntegerArrayExpression) BoolExpression {
+	return IsDistinctFrom(a.parent, rhs)
+}
+
+func (a *integerArrayInterfaceImpl) IS_NOT_DISTINCT_FROM(rhs IntegerArrayExpression) BoolExpression {
+	return IsNotDistinctFrom(a.parent, rhs)
+}
+
+func (a *integerArrayInterfaceImpl) CONTAINS(rhs IntegerArrayExpression) BoolExpression {
+	return newBinaryBoolOperatorExpression(a.parent, rhs, ArrayContainsOperator)
+}
+
+func (a *integerArrayInterfaceImpl) IS_CONTAINED_BY(rhs IntegerArrayExpression) BoolExpression {
+	return newBinaryBoolOperatorExpression(a.parent, rhs, ArrayContainedByOperator)
+}
+
+func (a *integerArrayInterfaceImpl) OVERLAP(rhs IntegerArrayExpression) BoolExpression {
+	return newBinaryBoolOperatorExpression(a.parent, rhs, ArrayOverlapOperator)
+}
+
+func (a *integerArrayInterfaceImpl) CONCAT(rhs IntegerArrayExpression) IntegerArrayExpression {
+	return IntegerArrayExp(NewBinaryOperatorExpression(a.parent, rhs, ArrayConcatOperator))
+}
+
+func (a *integerArrayInterfaceImpl) APPEND(elem IntegerExpression) IntegerArrayExpression {
+	return IntegerArrayExp(NewBinaryOperatorExpression(a.parent, elem, ArrayConcatOperator))
+}
+
+func (a *integerArrayInterfaceImpl) AT(index IntegerExpression) IntegerExpression {
+	return IntExp(newArraySubscriptExpression(a.parent, index, nil))
+}
+
+func (a *integerArrayInterfaceImpl) SLICE(lower, upper IntegerExpression) IntegerArrayExpression {
+	return IntegerArrayExp(newArraySubscriptExpression(a.parent, lower, upper))
+}
+
+func (a *integerArrayInterfaceImpl) ANY() IntegerExpression {
+	return newIntegerFunc("ANY", a.parent)
+}
+
+func (a *integerArrayInterfaceImpl) ALL() IntegerExpression {
+	return newIntegerFunc("ALL", a.parent)
+}
+
+type integerArrayExpressionWrapper struct {
+	integerArrayInterfaceImpl
+	Expression
+}
+
+func newIntegerArrayExpressionWrap(expression Expression) IntegerArrayExpression {
+	arrayExpressionWrap := integerArrayExpressionWrapper{Expression: expression}
+	arrayExpressionWrap.integerArrayInterfaceImpl.parent = &arrayExpressionWrap
+	return &arrayExpressionWrap
+}
+
+// IntegerArrayExp is integer array expression wrapper around arbitrary expression.
+// Allows go compiler to see any expression as integer array expression.
+// Does not add sql cast to generated sql builder output.
+func IntegerArrayExp(expression Expression) IntegerArrayExpression {
+	return newIntegerArrayExpressionWrap(expression)
+}
+
+// IntegerArray creates ARRAY[...] constructor from the list of integer expressions
+func IntegerArray(elems ...IntegerExpression) IntegerArrayExpression {
+	var expressions []Expression
+
+	for _, elem := range elems {
+		expressions = append(expressions, elem)
+	}
+
+	return IntegerArrayExp(newArrayConstructor(expressions))
+}
+
+//---------------------------------------------------//
+
+// FloatArrayExpression is interface for SQL real, numeric, decimal and double precision arrays
+type FloatArrayExpression interface {
+	ArrayExpression
+
+	EQ(rhs FloatArrayExpression) BoolExpression
+	NOT_EQ(rhs FloatArrayExpression) BoolExpression
+	IS_DISTINCT_FROM(rhs FloatArrayExpression) BoolExpression
+	IS_NOT_DISTINCT_FROM(rhs FloatArrayExpression) BoolExpression
+
+	// CONTAINS checks if this array contains all the elements of rhs array
+	CONTAINS(rhs FloatArrayExpression) BoolExpression
+	// IS_CONTAINED_BY checks if all the elements of this array are contained in rhs array
+	IS_CONTAINED_BY(rhs FloatArrayExpression) BoolExpression
+	// OVERLAP checks if this array and rhs array have any elements in common
+	OVERLAP(rhs FloatArrayExpression) BoolExpression
+
+	// CONCAT concatenates two arrays
+	CONCAT(rhs FloatArrayExpression) FloatArrayExpression
+	// APPEND appends element to the end of array
+	APPEND(elem FloatExpression) FloatArrayExpression
+
+	// AT returns array element at the given index
+	AT(index IntegerExpression) FloatExpression
+	// SLICE returns sub-array from lower to upper index (inclusive)
+	SLICE(lower, upper IntegerExpression) FloatArrayExpression
+
+	// ANY compares left hand side expression with each of array elements. Result is true if any comparison yields true.
+	ANY() FloatExpression
+	// ALL compares left hand side expression with each of array elements. Result is true if all comparisons yield true.
+	ALL() FloatExpression
+}
+
+type floatArrayInterfaceImpl struct {
+	arrayInterfaceImpl
+	parent FloatArrayExpression
+}
+
+func (a *floatArrayInterfaceImpl) EQ(rhs FloatArrayExpression) BoolExpression {
+	return Eq(a.parent, rhs)
+}
+
+func (a *floatArrayInterfaceImpl) NOT_EQ(rhs FloatArrayExpression) BoolExpression {
+	return NotEq(a.parent, rhs)
+}
+
+func (a *floatArrayInterfaceImpl) IS_DISTINCT_FROM(rhs FloatArrayExpression) BoolExpression {
+	return IsDistinctFrom(a.parent, rhs)
+}
+
+func (a *floatArrayInterfaceImpl) IS_NOT_DISTINCT_FROM(rhs FloatArrayExpression) BoolExpression {
+	return IsNotDistinctFrom(a.parent, rhs)
+}
+
+func (a *floatArrayInterfaceImpl) CONTAINS(rhs FloatArrayExpression) BoolExpression {
+	return newBinaryBoolOperatorExpression(a.parent, rhs, ArrayContainsOperator)
+}
+
+func (a *floatArrayInterfaceImpl) IS_CONTAINED_BY(rhs FloatArrayExpression) BoolExpression {
+	return newBinaryBoolOperatorExpression(a.parent, rhs, ArrayContainedByOperator)
+}
+
+func (a *floatArrayInterfaceImpl) OVERLAP(rhs FloatArrayExpression) BoolExpression {
+	return newBinaryBoolOperatorExpression(a.parent, rhs, ArrayOverlapOperator)
+}
+
+func (a *floatArrayInterfaceImpl) CONCAT(rhs FloatArrayExpression) FloatArrayExpression {
+	return FloatArrayExp(NewBinaryOperatorExpression(a.parent, rhs, ArrayConcatOperator))
+}
+
+func (a *floatArrayInterfaceImpl) APPEND(elem FloatExpression) FloatArrayExpression {
+	return FloatArrayExp(NewBinaryOperatorExpression(a.parent, elem, ArrayConcatOperator))
+}
+
+func (a *floatArrayInterfaceImpl) AT(index IntegerExpression) FloatExpression {
+	return FloatExp(newArraySubscriptExpression(a.parent, index, nil))
+}
+
+func (a *floatArrayInterfaceImpl) SLICE(lower, upper IntegerExpression) FloatArrayExpression {
+	return FloatArrayExp(newArraySubscriptExpression(a.parent, lower, upper))
+}
+
+func (a *floatArrayInterfaceImpl) ANY() FloatExpression {
+	return NewFloatFunc("ANY", a.parent)
+}
+
+func (a *floatArrayInterfaceImpl) ALL() FloatExpression {
+	return NewFloatFunc("ALL", a.parent)
+}
+
+type floatArrayExpressionWrapper struct {
+	floatArrayInterfaceImpl
+	Expression
+}
+
+func newFloatArrayExpressionWrap(expression Expression) FloatArrayExpression {
+	arrayExpressionWrap := floatArrayExpressionWrapper{Expression: expression}
+	arrayExpressionWrap.floatArrayInterfaceImpl.parent = &arrayExpressionWrap
+	return &arrayExpressionWrap
+}
+
+// FloatArrayExp is float array expression wrapper around arbitrary expression.
+// Allows go compiler to see any expression as float array expression.
+// Does not add sql cast to generated sql builder output.
+func FloatArrayExp(expression Expression) FloatArrayExpression {
+	return newFloatArrayExpressionWrap(expression)
+}
+
+// FloatArray creates ARRAY[...] constructor from the list of float expressions
+func FloatArray(elems ...FloatExpression) FloatArrayExpression {
+	var expressions []Expression
+
+	for _, elem := range elems {
+		expressions = append(expressions, elem)
+	}
+
+	return FloatArrayExp(newArrayConstructor(expressions))
+}
+
+//---------------------------------------------------//
+
+// StringArrayExpression is interface for SQL text, character, character varying, uuid and other string arrays
+type StringArrayExpression interface {
+	ArrayExpression
+
+	EQ(rhs StringArrayExpression) BoolExpression
+	NOT_EQ(rhs StringArrayExpression) BoolExpression
+	IS_DISTINCT_FROM(rhs StringArrayExpression) BoolExpression
+	IS_NOT_DISTINCT_FROM(rhs StringArrayExpression) BoolExpression
+
+	// CONTAINS checks if this array contains all the elements of rhs array
+	CONTAINS(rhs StringArrayExpression) BoolExpression
+	// IS_CONTAINED_BY checks if all the elements of this array are contained in rhs array
+	IS_CONTAINED_BY(rhs StringArrayExpression) BoolExpression
+	// OVERLAP checks if this array and rhs array have any elements in common
+	OVERLAP(rhs StringArrayExpression) BoolExpression
+
+	// CONCAT concatenates two arrays
+	CONCAT(rhs StringArrayExpression) StringArrayExpression
+	// APPEND appends element to the end of array
+	APPEND(elem StringExpression) StringArrayExpression
+
+	// AT returns array element at the given index
+	AT(index IntegerExpression) StringExpression
+	// SLICE returns sub-array from lower to upper index (inclusive)
+	SLICE(lower, upper IntegerExpression) StringArrayExpression
+
+	// ANY compares left hand side expression with each of array elements. Result is true if any comparison yields true.
+	ANY() StringExpression
+	// ALL compares left hand side expression with each of array elements. Result is true if all comparisons yield true.
+	ALL() StringExpression
+}
+
+type stringArrayInterfaceImpl struct {
+	arrayInterfaceImpl
+	parent StringArrayExpression
+}
+
+func (a *stringArrayInterfaceImpl) EQ(rhs StringArrayExpression) BoolExpression {
+	return Eq(a.parent, rhs)
+}
+
+func (a *stringArrayInterfaceImpl) NOT_EQ(rhs StringArrayExpression) BoolExpression {
+	return NotEq(a.parent, rhs)
+}
+
+func (a *stringArrayInterfaceImpl) IS_DISTINCT_FROM(rhs StringArrayExpression) BoolExpression {
+	return IsDistinctFrom(a.parent, rhs)
+}
+
+func (a *stringArrayInterfaceImpl) IS_NOT_DISTINCT_FROM(rhs StringArrayExpression) BoolExpression {
+	return IsNotDistinctFrom(a.parent, rhs)
+}
+
+func (a *stringArrayInterfaceImpl) CONTAINS(rhs StringArrayExpression) BoolExpression {
+	return newBinaryBoolOperatorExpression(a.parent, rhs, ArrayContainsOperator)
+}
+
+func (a *stringArrayInterfaceImpl) IS_CONTAINED_BY(rhs StringArrayExpression) BoolExpression {
+	return newBinaryBoolOperatorExpression(a.parent, rhs, ArrayContainedByOperator)
+}
+
+func (a *stringArrayInterfaceImpl) OVERLAP(rhs StringArrayExpression) BoolExpression {
+	return newBinaryBoolOperatorExpression(a.parent, rhs, ArrayOverlapOperator)
+}
+
+func (a *stringArrayInterfaceImpl) CONCAT(rhs StringArrayExpression) StringArrayExpression {
+	return StringArrayExp(NewBinaryOperatorExpression(a.parent, rhs, ArrayConcatOperator))
+}
+
+func (a *stringArrayInterfaceImpl) APPEND(elem StringExpression) StringArrayExpression {
+	return StringArrayExp(NewBinaryOperatorExpression(a.parent, elem, ArrayConcatOperator))
+}
+
+func (a *stringArrayInterfaceImpl) AT(index IntegerExpression) StringExpression {
+	return StringExp(newArraySubscriptExpression(a.parent, index, nil))
+}
+
+func (a *stringArrayInterfaceImpl) SLICE(lower, upper IntegerExpression) StringArrayExpression {
+	return StringArrayExp(newArraySubscriptExpression(a.parent, lower, upper))
+}
+
+func (a *stringArrayInterfaceImpl) ANY() StringExpression {
+	return NewStringFunc("ANY", a.parent)
+}
+
+func (a *stringArrayInterfaceImpl) ALL() StringExpression {
+	return NewStringFunc("ALL", a.parent)
+}
+
+type stringArrayExpressionWrapper struct {
+	stringArrayInterfaceImpl
+	Expression
+}
+
+func newStringArrayExpressionWrap(expression Expression) StringArrayExpression {
+	arrayExpressionWrap := stringArrayExpressionWrapper{Expression: expression}
+	arrayExpressionWrap.stringArrayInterfaceImpl.parent = &arrayExpressionWrap
+	return &arrayExpressionWrap
+}
+
+// StringArrayExp is string array expression wrapper around arbitrary expression.
+// Allows go compiler to see any expression as string array expression.
+// Does not add sql cast to generated sql builder output.
+func StringArrayExp(expression Expression) StringArrayExpression {
+	return newStringArrayExpressionWrap(expression)
+}
+
+// StringArray creates ARRAY[...] constructor from the list of string expressions
+func StringArray(elems ...StringExpression) StringArrayExpression {
+	var expressions []Expression
+
+	for _, elem := range elems {
+		expressions = append(expressions, elem)
+	}
+
+	return StringArrayExp(newArrayConstructor(expressions))
+}
+
+//---------------------------------------------------//
+
+// DateArrayExpression is interface for SQL date arrays
+type DateArrayExpression interface {
+	ArrayExpression
+
+	EQ(rhs DateArrayExpression) BoolExpression
+	NOT_EQ(rhs DateArrayExpression) BoolExpression
+	IS_DISTINCT_FROM(rhs DateArrayExpression) BoolExpression
+	IS_NOT_DISTINCT_FROM(rhs DateArrayExpression) BoolExpression
+
+	// CONTAINS checks if this array contains all the elements of rhs array
+	CONTAINS(rhs DateArrayExpression) BoolExpression
+	// IS_CONTAINED_BY checks if all the elements of this array are contained in rhs array
+	IS_CONTAINED_BY(rhs DateArrayExpression) BoolExpression
+	// OVERLAP checks if this array and rhs array have any elements in common
+	OVERLAP(rhs DateArrayExpression) BoolExpression
+
+	// CONCAT concatenates two arrays
+	CONCAT(rhs DateArrayExpression) DateArrayExpression
+	// APPEND appends element to the end of array
+	APPEND(elem DateExpression) DateArrayExpression
+
+	// AT returns array element at the given index
+	AT(index IntegerExpression) DateExpression
+	// SLICE returns sub-array from lower to upper index (inclusive)
+	SLICE(lower, upper IntegerExpression) DateArrayExpression
+
+	// ANY compares left hand side expression with each of array elements. Result is true if any comparison yields true.
+	ANY() DateExpression
+	// ALL compares left hand side expression with each of array elements. Result is true if all comparisons yield true.
+	ALL() DateExpression
+}
+
+type dateArrayInterfaceImpl struct {
+	arrayInterfaceImpl
+	parent DateArrayExpression
+}
+
+func (a *dateArrayInterfaceImpl) EQ(rhs DateArrayExpression) BoolExpression {
+	return Eq(a.parent, rhs)
+}
+
+func (a *dateArrayInterfaceImpl) NOT_EQ(rhs DateArrayExpression) BoolExpression {
+	return NotEq(a.parent, rhs)
+}
+
+func (a *dateArrayInterfaceImpl) IS_DISTINCT_FROM(rhs DateArrayExpression) BoolExpression {
+	return IsDistinctFrom(a.parent, rhs)
+}
+
+func (a *dateArrayInterfaceImpl) IS_NOT_DISTINCT_FROM(rhs DateArrayExpression) BoolExpression {
+	return IsNotDistinctFrom(a.parent, rhs)
+}
+
+func (a *dateArrayInterfaceImpl) CONTAINS(rhs DateArrayExpression) BoolExpression {
+	return newBinaryBoolOperatorExpression(a.parent, rhs, ArrayContainsOperator)
+}
+
+func (a *dateArrayInterfaceImpl) IS_CONTAINED_BY(rhs DateArrayExpression) BoolExpression {
+	return newBinaryBoolOperatorExpression(a.parent, rhs, ArrayContainedByOperator)
+}
+
+func (a *dateArrayInterfaceImpl) OVERLAP(rhs DateArrayExpression) BoolExpression {
+	return newBinaryBoolOperatorExpression(a.parent, rhs, ArrayOverlapOperator)
+}
+
+func (a *dateArrayInterfaceImpl) CONCAT(rhs DateArrayExpression) DateArrayExpression {
+	return DateArrayExp(NewBinaryOperatorExpression(a.parent, rhs, ArrayConcatOperator))
+}
+
+func (a *dateArrayInterfaceImpl) APPEND(elem DateExpression) DateArrayExpression {
+	return DateArrayExp(NewBinaryOperatorExpression(a.parent, elem, ArrayConcatOperator))
+}
+
+func (a *dateArrayInterfaceImpl) AT(index IntegerExpression) DateExpression {
+	return DateExp(newArraySubscriptExpression(a.parent, index, nil))
+}
+
+func (a *dateArrayInterfaceImpl) SLICE(lower, upper IntegerExpression) DateArrayExpression {
+	return DateArrayExp(newArraySubscriptExpression(a.parent, lower, upper))
+}
+
+func (a *dateArrayInterfaceImpl) ANY() DateExpression {
+	return NewDateFunc("ANY", a.parent)
+}
+
+func (a *dateArrayInterfaceImpl) ALL() DateExpression {
+	return NewDateFunc("ALL", a.parent)
+}
+
+type dateArrayExpressionWrapper struct {
+	dateArrayInterfaceImpl
+	Expression
+}
+
+func newDateArrayExpressionWrap(expression Expression) DateArrayExpression {
+	arrayExpressionWrap := dateArrayExpressionWrapper{Expression: expression}
+	arrayExpressionWrap.dateArrayInterfaceImpl.parent = &arrayExpressionWrap
+	return &arrayExpressionWrap
+}
+
+// DateArrayExp is date array expression wrapper around arbitrary expression.
+// Allows go compiler to see any expression as date array expression.
+// Does not add sql cast to generated sql builder output.
+func DateArrayExp(expression Expression) DateArrayExpression {
+	return newDateArrayExpressionWrap(expression)
+}
+
+// DateArray creates ARRAY[...] constructor from the list of date expressions
+func DateArray(elems ...DateExpression) DateArrayExpression {
+	var expressions []Expression
+
+	for _, elem := range elems {
+		expressions = append(expressions, elem)
+	}
+
+	return DateArrayExp(newArrayConstructor(expressions))
+}
+
+//---------------------------------------------------//
+
+// TimeArrayExpression is interface for SQL time arrays
+type TimeArrayExpression interface {
+	ArrayExpression
+
+	EQ(rhs TimeArrayExpression) BoolExpression
+	NOT_EQ(rhs TimeArrayExpression) BoolExpression
+	IS_DISTINCT_FROM(rhs TimeArrayExpression) BoolExpression
+	IS_NOT_DISTINCT_FROM(rhs TimeArrayExpression) BoolExpression
+
+	// CONTAINS checks if this array contains all the elements of rhs array
+	CONTAINS(rhs TimeArrayExpression) BoolExpression
+	// IS_CONTAINED_BY checks if all the elements of this array are contained in rhs array
+	IS_CONTAINED_BY(rhs TimeArrayExpression) BoolExpression
+	// OVERLAP checks if this array and rhs array have any elements in common
+	OVERLAP(rhs TimeArrayExpression) BoolExpression
+
+	// CONCAT concatenates two arrays
+	CONCAT(rhs TimeArrayExpression) TimeArrayExpression
+	// APPEND appends element to the end of array
+	APPEND(elem TimeExpression) TimeArrayExpression
+
+	// AT returns array element at the given index
+	AT(index IntegerExpression) TimeExpression
+	// SLICE returns sub-array from lower to upper index (inclusive)
+	SLICE(lower, upper IntegerExpression) TimeArrayExpression
+
+	// ANY compares left hand side expression with each of array elements. Result is true if any comparison yields true.
+	ANY() TimeExpression
+	// ALL compares left hand side expression with each of array elements. Result is true if all comparisons yield true.
+	ALL() TimeExpression
+}
+
+type timeArrayInterfaceImpl struct {
+	arrayInterfaceImpl
+	parent TimeArrayExpression
+}
+
+func (a *timeArrayInterfaceImpl) EQ(rhs TimeArrayExpression) BoolExpression {
+	return Eq(a.parent, rhs)
+}
+
+func (a *timeArrayInterfaceImpl) NOT_EQ(rhs TimeArrayExpression) BoolExpression {
+	return NotEq(a.parent, rhs)
+}
+
+func (a *timeArrayInterfaceImpl) IS_DISTINCT_FROM(rhs TimeArrayExpression) BoolExpression {
+	return IsDistinctFrom(a.parent, rhs)
+}
+
+func (a *timeArrayInterfaceImpl) IS_NOT_DISTINCT_FROM(rhs TimeArrayExpression) BoolExpression {
+	return IsNotDistinctFrom(a.parent, rhs)
+}
+
+func (a *timeArrayInterfaceImpl) CONTAINS(rhs TimeArrayExpression) BoolExpression {
+	return newBinaryBoolOperatorExpression(a.parent, rhs, ArrayContainsOperator)
+}
+
+func (a *timeArrayInterfaceImpl) IS_CONTAINED_BY(rhs TimeArrayExpression) BoolExpression {
+	return newBinaryBoolOperatorExpression(a.parent, rhs, ArrayContainedByOperator)
+}
+
+func (a *timeArrayInterfaceImpl) OVERLAP(rhs TimeArrayExpression) BoolExpression {
+	return newBinaryBoolOperatorExpression(a.parent, rhs, ArrayOverlapOperator)
+}
+
+func (a *timeArrayInterfaceImpl) CONCAT(rhs TimeArrayExpression) TimeArrayExpression {
+	return TimeArrayExp(NewBinaryOperatorExpression(a.parent, rhs, ArrayConcatOperator))
+}
+
+func (a *timeArrayInterfaceImpl) APPEND(elem TimeExpression) TimeArrayExpression {
+	return TimeArrayExp(NewBinaryOperatorExpression(a.parent, elem, ArrayConcatOperator))
+}
+
+func (a *timeArrayInterfaceImpl) AT(index IntegerExpression) TimeExpression {
+	return TimeExp(newArraySubscriptExpression(a.parent, index, nil))
+}
+
+func (a *timeArrayInterfaceImpl) SLICE(lower, upper IntegerExpression) TimeArrayExpression {
+	return TimeArrayExp(newArraySubscriptExpression(a.parent, lower, upper))
+}
+
+func (a *timeArrayInterfaceImpl) ANY() TimeExpression {
+	return NewTimeFunc("ANY", a.parent)
+}
+
+func (a *timeArrayInterfaceImpl) ALL() TimeExpression {
+	return NewTimeFunc("ALL", a.parent)
+}
+
+type timeArrayExpressionWrapper struct {
+	timeArrayInterfaceImpl
+	Expression
+}
+
+func newTimeArrayExpressionWrap(expression Expression) TimeArrayExpression {
+	arrayExpressionWrap := timeArrayExpressionWrapper{Expression: expression}
+	arrayExpressionWrap.timeArrayInterfaceImpl.parent = &arrayExpressionWrap
+	return &arrayExpressionWrap
+}
+
+// TimeArrayExp is time array expression wrapper around arbitrary expression.
+// Allows go compiler to see any expression as time array expression.
+// Does not add sql cast to generated sql builder output.
+func TimeArrayExp(expression Expression) TimeArrayExpression {
+	return newTimeArrayExpressionWrap(expression)
+}
+
+// TimeArray creates ARRAY[...] constructor from the list of time expressions
+func TimeArray(elems ...TimeExpression) TimeArrayExpression {
+	var expressions []Expression
+
+	for _, elem := range elems {
+		expressions = append(expressions, elem)
+	}
+
+	return TimeArrayExp(newArrayConstructor(expressions))
+}
+
+//---------------------------------------------------//
+
+// TimezArrayExpression is interface for SQL time with time zone arrays
+type TimezArrayExpression interface {
+	ArrayExpression
+
+	EQ(rhs TimezArrayExpression) BoolExpression
+	NOT_EQ(rhs TimezArrayExpression) BoolExpression
+	IS_DISTINCT_FROM(rhs TimezArrayExpression) BoolExpression
+	IS_NOT_DISTINCT_FROM(rhs TimezArrayExpression) BoolExpression
+
+	// CONTAINS checks if this array contains all the elements of rhs array
+	CONTAINS(rhs TimezArrayExpression) BoolExpression
+	// IS_CONTAINED_BY checks if all the elements of this array are contained in rhs array
+	IS_CONTAINED_BY(rhs TimezArrayExpression) BoolExpression
+	// OVERLAP checks if this array and rhs array have any elements in common
+	OVERLAP(rhs TimezArrayExpression) BoolExpression
+
+	// CONCAT concatenates two arrays
+	CONCAT(rhs TimezArrayExpression) TimezArrayExpression
+	// APPEND appends element to the end of array
+	APPEND(elem TimezExpression) TimezArrayExpression
+
+	// AT returns array element at the given index
+	AT(index IntegerExpression) TimezExpression
+	// SLICE returns sub-array from lower to upper index (inclusive)
+	SLICE(lower, upper IntegerExpression) TimezArrayExpression
+
+	// ANY compares left hand side expression with each of array elements. Result is true if any comparison yields true.
+	ANY() TimezExpression
+	// ALL compares left hand side expression with each of array elements. Result is true if all comparisons yield true.
+	ALL() TimezExpression
+}
+
+type timezArrayInterfaceImpl struct {
+	arrayInterfaceImpl
+	parent TimezArrayExpression
+}
+
+func (a *timezArrayInterfaceImpl) EQ(rhs TimezArrayExpression) BoolExpression {
+	return Eq(a.parent, rhs)
+}
+
+func (a *timezArrayInterfaceImpl) NOT_EQ(rhs TimezArrayExpression) BoolExpression {
+	return NotEq(a.parent, rhs)
+}
+
+func (a *timezArrayInterfaceImpl) IS_DISTINCT_FROM(rhs TimezArrayExpression) BoolExpression {
+	return IsDistinctFrom(a.parent, rhs)
+}
+
+func (a *timezArrayInterfaceImpl) IS_NOT_DISTINCT_FROM(rhs TimezArrayExpression) BoolExpression {
+	return IsNotDistinctFrom(a.parent, rhs)
+}
+
+func (a *timezArrayInterfaceImpl) CONTAINS(rhs TimezArrayExpression) BoolExpression {
+	return newBinaryBoolOperatorExpression(a.parent, rhs, ArrayContainsOperator)
+}
+
+func (a *timezArrayInterfaceImpl) IS_CONTAINED_BY(rhs TimezArrayExpression) BoolExpression {
+	return newBinaryBoolOperatorExpression(a.parent, rhs, ArrayContainedByOperator)
+}
+
+func (a *timezArrayInterfaceImpl) OVERLAP(rhs TimezArrayExpression) BoolExpression {
+	return newBinaryBoolOperatorExpression(a.parent, rhs, ArrayOverlapOperator)
+}
+
+func (a *timezArrayInterfaceImpl) CONCAT(rhs TimezArrayExpression) TimezArrayExpression {
+	return TimezArrayExp(NewBinaryOperatorExpression(a.parent, rhs, ArrayConcatOperator))
+}
+
+func (a *timezArrayInterfaceImpl) APPEND(elem TimezExpression) TimezArrayExpression {
+	return TimezArrayExp(NewBinaryOperatorExpression(a.parent, elem, ArrayConcatOperator))
+}
+
+func (a *timezArrayInterfaceImpl) AT(index IntegerExpression) TimezExpression {
+	return TimezExp(newArraySubscriptExpression(a.parent, index, nil))
+}
+
+func (a *timezArrayInterfaceImpl) SLICE(lower, upper IntegerExpression) TimezArrayExpression {
+	return TimezArrayExp(newArraySubscriptExpression(a.parent, lower, upper))
+}
+
+func (a *timezArrayInterfaceImpl) ANY() TimezExpression {
+	return newTimezFunc("ANY", a.parent)
+}
+
+func (a *timezArrayInterfaceImpl) ALL() TimezExpression {
+	return newTimezFunc("ALL", a.parent)
+}
+
+type timezArrayExpressionWrapper struct {
+	timezArrayInterfaceImpl
+	Expression
+}
+
+func newTimezArrayExpressionWrap(expression Expression) TimezArrayExpression {
+	arrayExpressionWrap := timezArrayExpressionWrapper{Expression: expression}
+	arrayExpressionWrap.timezArrayInterfaceImpl.parent = &arrayExpressionWrap
+	return &arrayExpressionWrap
+}
+
+// TimezArrayExp is timez array expression wrapper around arbitrary expression.
+// Allows go compiler to see any expression as timez array expression.
+// Does not add sql cast to generated sql builder output.
+func TimezArrayExp(expression Expression) TimezArrayExpression {
+	return newTimezArrayExpressionWrap(expression)
+}
+
+// TimezArray creates ARRAY[...] constructor from the list of timez expressions
+func TimezArray(elems ...TimezExpression) TimezArrayExpression {
+	var expressions []Expression
+
+	for _, elem := range elems {
+		expressions = append(expressions, elem)
+	}
+
+	return TimezArrayExp(newArrayConstructor(expressions))
+}
+
+//---------------------------------------------------//
+
+// TimestampArrayExpression is interface for SQL timestamp arrays
+type TimestampArrayExpression interface {
+	ArrayExpression
+
+	EQ(rhs TimestampArrayExpression) BoolExpression
+	NOT_EQ(rhs TimestampArrayExpression) BoolExpression
+	IS_DISTINCT_FROM(rhs TimestampArrayExpression) BoolExpression
+	IS_NOT_DISTINCT_FROM(rhs TimestampArrayExpression) BoolExpression
+
+	// CONTAINS checks if this array contains all the elements of rhs array
+	CONTAINS(rhs TimestampArrayExpression) BoolExpression
+	// IS_CONTAINED_BY checks if all the elements of this array are contained in rhs array
+	IS_CONTAINED_BY(rhs TimestampArrayExpression) BoolExpression
+	// OVERLAP checks if this array and rhs array have any elements in common
+	OVERLAP(rhs TimestampArrayExpression) BoolExpression
+
+	// CONCAT concatenates two arrays
+	CONCAT(rhs TimestampArrayExpression) TimestampArrayExpression
+	// APPEND appends element to the end of array
+	APPEND(elem TimestampExpression) TimestampArrayExpression
+
+	// AT returns array element at the given index
+	AT(index IntegerExpression) TimestampExpression
+	// SLICE returns sub-array from lower to upper index (inclusive)
+	SLICE(lower, upper IntegerExpression) TimestampArrayExpression
+
+	// ANY compares left hand side expression with each of array elements. Result is true if any comparison yields true.
+	ANY() TimestampExpression
+	// ALL compares left hand side expression with each of array elements. Result is true if all comparisons yield true.
+	ALL() TimestampExpression
+}
+
+type timestampArrayInterfaceImpl struct {
+	arrayInterfaceImpl
+	parent TimestampArrayExpression
+}
+
+func (a *timestampArrayInterfaceImpl) EQ(rhs TimestampArrayExpression) BoolExpression {
+	return Eq(a.parent, rhs)
+}
+
+func (a *timestampArrayInterfaceImpl) NOT_EQ(rhs TimestampArrayExpression) BoolExpression {
+	return NotEq(a.parent, rhs)
+}
+
+func (a *timestampArrayInterfaceImpl) IS_DISTINCT_FROM(rhs TimestampArrayExpression) BoolExpression {
+	return IsDistinctFrom(a.parent, rhs)
+}
+
+func (a *timestampArrayInterfaceImpl) IS_NOT_DISTINCT_FROM(rhs TimestampArrayExpression) BoolExpression {
+	return IsNotDistinctFrom(a.parent, rhs)
+}
+
+func (a *timestampArrayInterfaceImpl) CONTAINS(rhs TimestampArrayExpression) BoolExpression {
+	return newBinaryBoolOperatorExpression(a.parent, rhs, ArrayContainsOperator)
+}
+
+func (a *timestampArrayInterfaceImpl) IS_CONTAINED_BY(rhs TimestampArrayExpression) BoolExpression {
+	return newBinaryBoolOperatorExpression(a.parent, rhs, ArrayContainedByOperator)
+}
+
+func (a *timestampArrayInterfaceImpl) OVERLAP(rhs TimestampArrayExpression) BoolExpression {
+	return newBinaryBoolOperatorExpression(a.parent, rhs, ArrayOverlapOperator)
+}
+
+func (a *timestampArrayInterfaceImpl) CONCAT(rhs TimestampArrayExpression) TimestampArrayExpression {
+	return TimestampArrayExp(NewBinaryOperatorExpression(a.parent, rhs, ArrayConcatOperator))
+}
+
+func (a *timestampArrayInterfaceImpl) APPEND(elem TimestampExpression) TimestampArrayExpression {
+	return TimestampArrayExp(NewBinaryOperatorExpression(a.parent, elem, ArrayConcatOperator))
+}
+
+func (a *timestampArrayInterfaceImpl) AT(index IntegerExpression) TimestampExpression {
+	return TimestampExp(newArraySubscriptExpression(a.parent, index, nil))
+}
+
+func (a *timestampArrayInterfaceImpl) SLICE(lower, upper IntegerExpression) TimestampArrayExpression {
+	return TimestampArrayExp(newArraySubscriptExpression(a.parent, lower, upper))
+}
+
+func (a *timestampArrayInterfaceImpl) ANY() TimestampExpression {
+	return NewTimestampFunc("ANY", a.parent)
+}
+
+func (a *timestampArrayInterfaceImpl) ALL() TimestampExpression {
+	return NewTimestampFunc("ALL", a.parent)
+}
+
+type timestampArrayExpressionWrapper struct {
+	timestampArrayInterfaceImpl
+	Expression
+}
+
+func newTimestampArrayExpressionWrap(expression Expression) TimestampArrayExpression {
+	arrayExpressionWrap := timestampArrayExpressionWrapper{Expression: expression}
+	arrayExpressionWrap.timestampArrayInterfaceImpl.parent = &arrayExpressionWrap
+	return &arrayExpressionWrap
+}
+
+// TimestampArrayExp is timestamp array expression wrapper around arbitrary expression.
+// Allows go compiler to see any expression as timestamp array expression.
+// Does not add sql cast to generated sql builder output.
+func TimestampArrayExp(expression Expression) TimestampArrayExpression {
+	return newTimestampArrayExpressionWrap(expression)
+}
+
+// TimestampArray creates ARRAY[...] constructor from the list of timestamp expressions
+func TimestampArray(elems ...TimestampExpression) TimestampArrayExpression {
+	var expressions []Expression
+
+	for _, elem := range elems {
+		expressions = append(expressions, elem)
+	}
+
+	return TimestampArrayExp(newArrayConstructor(expressions))
+}
+
+//---------------------------------------------------//
+
+// TimestampzArrayExpression is interface for SQL timestamp with time zone arrays
+type TimestampzArrayExpression interface {
+	ArrayExpression
+
+	EQ(rhs TimestampzArrayExpression) BoolExpression
+	NOT_EQ(rhs TimestampzArrayExpression) BoolExpression
+	IS_DISTINCT_FROM(rhs TimestampzArrayExpression) BoolExpression
+	IS_NOT_DISTINCT_FROM(rhs TimestampzArrayExpression) BoolExpression
+
+	// CONTAINS checks if this array contains all the elements of rhs array
+	CONTAINS(rhs TimestampzArrayExpression) BoolExpression
+	// IS_CONTAINED_BY checks if all the elements of this array are contained in rhs array
+	IS_CONTAINED_BY(rhs TimestampzArrayExpression) BoolExpression
+	// OVERLAP checks if this array and rhs array have any elements in common
+	OVERLAP(rhs TimestampzArrayExpression) BoolExpression
+
+	// CONCAT concatenates two arrays
+	CONCAT(rhs TimestampzArrayExpression) TimestampzArrayExpression
+	// APPEND appends element to the end of array
+	APPEND(elem TimestampzExpression) TimestampzArrayExpression
+
+	// AT returns array element at the given index
+	AT(index IntegerExpression) TimestampzExpression
+	// SLICE returns sub-array from lower to upper index (inclusive)
+	SLICE(lower, upper IntegerExpression) TimestampzArrayExpression
+
+	// ANY compares left hand side expression with each of array elements. Result is true if any comparison yields true.
+	ANY() TimestampzExpression
+	// ALL compares left hand side expression with each of array elements. Result is true if all comparisons yield true.
+	ALL() TimestampzExpression
+}
+
+type timestampzArrayInterfaceImpl struct {
+	arrayInterfaceImpl
+	parent TimestampzArrayExpression
+}
+
+func (a *timestampzArrayInterfaceImpl) EQ(rhs TimestampzArrayExpression) BoolExpression {
+	return Eq(a.parent, rhs)
+}
+
+func (a *timestampzArrayInterfaceImpl) NOT_EQ(rhs TimestampzArrayExpression) BoolExpression {
+	return NotEq(a.parent, rhs)
+}
+
+func (a *timestampzArrayInterfaceImpl) IS_DISTINCT_FROM(rhs TimestampzArrayExpression) BoolExpression {
+	return IsDistinctFrom(a.parent, rhs)
+}
+
+func (a *timestampzArrayInterfaceImpl) IS_NOT_DISTINCT_FROM(rhs TimestampzArrayExpression) BoolExpression {
+	return IsNotDistinctFrom(a.parent, rhs)
+}
+
+func (a *timestampzArrayInterfaceImpl) CONTAINS(rhs TimestampzArrayExpression) BoolExpression {
+	return newBinaryBoolOperatorExpression(a.parent, rhs, ArrayContainsOperator)
+}
+
+func (a *timestampzArrayInterfaceImpl) IS_CONTAINED_BY(rhs TimestampzArrayExpression) BoolExpression {
+	return newBinaryBoolOperatorExpression(a.parent, rhs, ArrayContainedByOperator)
+}
+
+func (a *timestampzArrayInterfaceImpl) OVERLAP(rhs TimestampzArrayExpression) BoolExpression {
+	return newBinaryBoolOperatorExpression(a.parent, rhs, ArrayOverlapOperator)
+}
+
+func (a *timestampzArrayInterfaceImpl) CONCAT(rhs TimestampzArrayExpression) TimestampzArrayExpression {
+	return TimestampzArrayExp(NewBinaryOperatorExpression(a.parent, rhs, ArrayConcatOperator))
+}
+
+func (a *timestampzArrayInterfaceImpl) APPEND(elem TimestampzExpression) TimestampzArrayExpression {
+	return TimestampzArrayExp(NewBinaryOperatorExpression(a.parent, elem, ArrayConcatOperator))
+}
+
+func (a *timestampzArrayInterfaceImpl) AT(index IntegerExpression) TimestampzExpression {
+	return TimestampzExp(newArraySubscriptExpression(a.parent, index, nil))
+}
+
+func (a *timestampzArrayInterfaceImpl) SLICE(lower, upper IntegerExpression) TimestampzArrayExpression {
+	return TimestampzArrayExp(newArraySubscriptExpression(a.parent, lower, upper))
+}
+
+func (a *timestampzArrayInterfaceImpl) ANY() TimestampzExpression {
+	return newTimestampzFunc("ANY", a.parent)
+}
+
+func (a *timestampzArrayInterfaceImpl) ALL() TimestampzExpression {
+	return newTimestampzFunc("ALL", a.parent)
+}
+
+type timestampzArrayExpressionWrapper struct {
+	timestampzArrayInterfaceImpl
+	Expression
+}
+
+func newTimestampzArrayExpressionWrap(expression Expression) TimestampzArrayExpression {
+	arrayExpressionWrap := timestampzArrayExpressionWrapper{Expression: expression}
+	arrayExpressionWrap.timestampzArrayInterfaceImpl.parent = &arrayExpressionWrap
+	return &arrayExpressionWrap
+}
+
+// TimestampzArrayExp is timestampz array expression wrapper around arbitrary expression.
+// Allows go compiler to see any expression as timestampz array expression.
+// Does not add sql cast to generated sql builder output.
+func TimestampzArrayExp(expression Expression) TimestampzArrayExpression {
+	return newTimestampzArrayExpressionWrap(expression)
+}
+
+// TimestampzArray creates ARRAY[...] constructor from the list of timestampz expressions
+func TimestampzArray(elems ...TimestampzExpression) TimestampzArrayExpression {
+	var expressions []Expression
+
+	for _, elem := range elems {
+		expressions = append(expressions, elem)
+	}
+
+	return TimestampzArrayExp(newArrayConstructor(expressions))
+}
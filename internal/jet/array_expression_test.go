@@ -0,0 +1,52 @@
+package jet
+
+import (
+	"testing"
+)
+
+func TestArrayEQ(t *testing.T) {
+	assertClauseSerialize(t, table3ColIntArray.EQ(table3ColIntArray), "(table3.col_int_array = table3.col_int_array)")
+	assertClauseSerialize(t, table3ColIntArray.NOT_EQ(IntegerArray(Int(1), Int(2))),
+		"(table3.col_int_array != ARRAY[$1, $2])", int64(1), int64(2))
+	assertClauseSerialize(t, table3ColStrArray.IS_DISTINCT_FROM(table3ColStrArray),
+		"(table3.col_str_array IS DISTINCT FROM table3.col_str_array)")
+	assertClauseSerialize(t, table3ColStrArray.IS_NOT_DISTINCT_FROM(table3ColStrArray),
+		"(table3.col_str_array IS NOT DISTINCT FROM table3.col_str_array)")
+}
+
+func TestArrayCONTAINS(t *testing.T) {
+	assertClauseSerialize(t, table3ColStrArray.CONTAINS(StringArray(String("a"))),
+		"(table3.col_str_array @> ARRAY[$1])", "a")
+	assertClauseSerialize(t, table3ColStrArray.IS_CONTAINED_BY(table3ColStrArray),
+		"(table3.col_str_array <@ table3.col_str_array)")
+	assertClauseSerialize(t, table3ColIntArray.OVERLAP(IntegerArray(table3Col1, table3ColInt)),
+		"(table3.col_int_array && ARRAY[table3.col1, table3.col_int])")
+}
+
+func TestArrayCONCAT(t *testing.T) {
+	assertClauseSerialize(t, table3ColIntArray.CONCAT(table3ColIntArray), "(table3.col_int_array || table3.col_int_array)")
+	assertClauseSerialize(t, table3ColIntArray.APPEND(Int(3)), "(table3.col_int_array || $1)", int64(3))
+}
+
+func TestArrayAT(t *testing.T) {
+	assertClauseSerialize(t, table3ColIntArray.AT(Int(1)), "(table3.col_int_array)[$1]", int64(1))
+	assertClauseSerialize(t, table3ColIntArray.AT(Int(1)).ADD(Int(2)), "((table3.col_int_array)[$1] + $2)", int64(1), int64(2))
+	assertClauseSerialize(t, table3ColStrArray.CONCAT(table3ColStrArray).AT(table3Col1),
+		"(table3.col_str_array || table3.col_str_array)[table3.col1]")
+}
+
+func TestArraySLICE(t *testing.T) {
+	assertClauseSerialize(t, table3ColIntArray.SLICE(Int(1), Int(3)), "(table3.col_int_array)[$1:$2]", int64(1), int64(3))
+	assertClauseSerialize(t, table3ColIntArray.SLICE(Int(1), Int(3)).AT(Int(1)),
+		"((table3.col_int_array)[$1:$2])[$3]", int64(1), int64(3), int64(1))
+}
+
+func TestArrayANY(t *testing.T) {
+	assertClauseSerialize(t, table3Col1.EQ(table3ColIntArray.ANY()), "(table3.col1 = ANY(table3.col_int_array))")
+	assertClauseSerialize(t, table3StrCol.NOT_EQ(table3ColStrArray.ALL()), "(table3.col2 != ALL(table3.col_str_array))")
+}
+
+func TestArrayExp(t *testing.T) {
+	assertClauseSerialize(t, StringArrayExp(table3StrCol).AT(Int(1)), "(table3.col2)[$1]", int64(1))
+	assertClauseSerialize(t, CARDINALITY(ArrayExp(table3StrCol)), "CARDINALITY(table3.col2)")
+}
@@ -143,19 +143,7 @@ func (c *ClauseGroupBy) Serialize(statementType StatementType, out *SQLBuilder,
 	out.WriteString("GROUP BY")
 
 	out.IncreaseIdent()
-
-	for i, c := range c.List {
-		if i > 0 {
-			out.WriteString(", ")
-		}
-
-		if c == nil {
-			panic("jet: nil clause in GROUP BY list")
-		}
-
-		c.serializeForGroupBy(statementType, out)
-	}
-
+	serializeGroupByClauseList(statementType, c.List, out)
 	out.DecreaseIdent()
 }
 
@@ -551,6 +539,25 @@ func (i *ClauseIn) Serialize(statementType StatementType, out *SQLBuilder, optio
 	out.WriteString("MODE")
 }
 
+// ClauseCall struct
+type ClauseCall struct {
+	Procedure string
+	Arguments []Expression
+}
+
+// Serialize serializes clause into SQLBuilder
+func (c *ClauseCall) Serialize(statementType StatementType, out *SQLBuilder, options ...SerializeOption) {
+	if c.Procedure == "" {
+		panic("jet: procedure name is not set for CALL statement")
+	}
+
+	out.NewLine()
+	out.WriteString("CALL")
+	out.WriteString(c.Procedure + "(")
+	serializeExpressionList(statementType, c.Arguments, ", ", out)
+	out.WriteString(")")
+}
+
 // WindowDefinition struct
 type WindowDefinition struct {
 	Name   string
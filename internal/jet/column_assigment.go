@@ -18,3 +18,11 @@ func (a columnAssigmentImpl) serialize(statement StatementType, out *SQLBuilder,
 	out.WriteString("=")
 	a.expression.serialize(statement, out, FallTrough(options)...)
 }
+
+// NewColumnAssigment creates new column assigment, used by dialect specific columns
+func NewColumnAssigment(column ColumnSerializer, expression Expression) ColumnAssigment {
+	return columnAssigmentImpl{
+		column:     column,
+		expression: expression,
+	}
+}
@@ -358,3 +358,393 @@ func DateColumn(name string) ColumnDate {
 	dateColumn.ColumnExpressionImpl = NewColumnImpl(name, "", dateColumn)
 	return dateColumn
 }
+
+//------------------------------------------------------//
+
+// ColumnJson is interface of SQL json and jsonb columns.
+type ColumnJson interface {
+	JsonExpression
+	Column
+
+	From(subQuery SelectTable) ColumnJson
+	SET(jsonExp JsonExpression) ColumnAssigment
+}
+
+type jsonColumnImpl struct {
+	jsonInterfaceImpl
+	ColumnExpressionImpl
+}
+
+func (i *jsonColumnImpl) From(subQuery SelectTable) ColumnJson {
+	newJsonColumn := JsonColumn(i.name)
+	newJsonColumn.setTableName(i.tableName)
+	newJsonColumn.setSubQuery(subQuery)
+
+	return newJsonColumn
+}
+
+func (i *jsonColumnImpl) SET(jsonExp JsonExpression) ColumnAssigment {
+	return columnAssigmentImpl{
+		column:     i,
+		expression: jsonExp,
+	}
+}
+
+// JsonColumn creates named json column.
+func JsonColumn(name string) ColumnJson {
+	jsonColumn := &jsonColumnImpl{}
+	jsonColumn.jsonInterfaceImpl.parent = jsonColumn
+	jsonColumn.ColumnExpressionImpl = NewColumnImpl(name, "", jsonColumn)
+	return jsonColumn
+}
+
+//------------------------------------------------------//
+
+// ColumnBoolArray is interface of SQL boolean array columns.
+type ColumnBoolArray interface {
+	BoolArrayExpression
+	Column
+
+	From(subQuery SelectTable) ColumnBoolArray
+	SET(arrayExp BoolArrayExpression) ColumnAssigment
+}
+
+type boolArrayColumnImpl struct {
+	boolArrayInterfaceImpl
+	ColumnExpressionImpl
+}
+
+func (i *boolArrayColumnImpl) From(subQuery SelectTable) ColumnBoolArray {
+	newBoolArrayColumn := BoolArrayColumn(i.name)
+	newBoolArrayColumn.setTableName(i.tableName)
+	newBoolArrayColumn.setSubQuery(subQuery)
+
+	return newBoolArrayColumn
+}
+
+func (i *boolArrayColumnImpl) SET(arrayExp BoolArrayExpression) ColumnAssigment {
+	return columnAssigmentImpl{
+		column:     i,
+		expression: arrayExp,
+	}
+}
+
+// BoolArrayColumn creates named bool array column.
+func BoolArrayColumn(name string) ColumnBoolArray {
+	boolArrayColumn := &boolArrayColumnImpl{}
+	boolArrayColumn.boolArrayInterfaceImpl.parent = boolArrayColumn
+	boolArrayColumn.ColumnExpressionImpl = NewColumnImpl(name, "", boolArrayColumn)
+	return boolArrayColumn
+}
+
+//------------------------------------------------------//
+
+// ColumnIntegerArray is interface of SQL smallint, integer and bigint array columns.
+type ColumnIntegerArray interface {
+	IntegerArrayExpression
+	Column
+
+	From(subQuery SelectTable) ColumnIntegerArray
+	SET(arrayExp IntegerArrayExpression) ColumnAssigment
+}
+
+type integerArrayColumnImpl struct {
+	integerArrayInterfaceImpl
+	ColumnExpressionImpl
+}
+
+func (i *integerArrayColumnImpl) From(subQuery SelectTable) ColumnIntegerArray {
+	newIntegerArrayColumn := IntegerArrayColumn(i.name)
+	newIntegerArrayColumn.setTableName(i.tableName)
+	newIntegerArrayColumn.setSubQuery(subQuery)
+
+	return newIntegerArrayColumn
+}
+
+func (i *integerArrayColumnImpl) SET(arrayExp IntegerArrayExpression) ColumnAssigment {
+	return columnAssigmentImpl{
+		column:     i,
+		expression: arrayExp,
+	}
+}
+
+// IntegerArrayColumn creates named integer array column.
+func IntegerArrayColumn(name string) ColumnIntegerArray {
+	integerArrayColumn := &integerArrayColumnImpl{}
+	integerArrayColumn.integerArrayInterfaceImpl.parent = integerArrayColumn
+	integerArrayColumn.ColumnExpressionImpl = NewColumnImpl(name, "", integerArrayColumn)
+	return integerArrayColumn
+}
+
+//------------------------------------------------------//
+
+// ColumnFloatArray is interface of SQL real, numeric, decimal and double precision array columns.
+type ColumnFloatArray interface {
+	FloatArrayExpression
+	Column
+
+	From(subQuery SelectTable) ColumnFloatArray
+	SET(arrayExp FloatArrayExpression) ColumnAssigment
+}
+
+type floatArrayColumnImpl struct {
+	floatArrayInterfaceImpl
+	ColumnExpressionImpl
+}
+
+func (i *floatArrayColumnImpl) From(subQuery SelectTable) ColumnFloatArray {
+	newFloatArrayColumn := FloatArrayColumn(i.name)
+	newFloatArrayColumn.setTableName(i.tableName)
+	newFloatArrayColumn.setSubQuery(subQuery)
+
+	return newFloatArrayColumn
+}
+
+func (i *floatArrayColumnImpl) SET(arrayExp FloatArrayExpression) ColumnAssigment {
+	return columnAssigmentImpl{
+		column:     i,
+		expression: arrayExp,
+	}
+}
+
+// FloatArrayColumn creates named float array column.
+func FloatArrayColumn(name string) ColumnFloatArray {
+	floatArrayColumn := &floatArrayColumnImpl{}
+	floatArrayColumn.floatArrayInterfaceImpl.parent = floatArrayColumn
+	floatArrayColumn.ColumnExpressionImpl = NewColumnImpl(name, "", floatArrayColumn)
+	return floatArrayColumn
+}
+
+//------------------------------------------------------//
+
+// ColumnStringArray is interface of SQL text, character, character varying, uuid and other string array columns.
+type ColumnStringArray interface {
+	StringArrayExpression
+	Column
+
+	From(subQuery SelectTable) ColumnStringArray
+	SET(arrayExp StringArrayExpression) ColumnAssigment
+}
+
+type stringArrayColumnImpl struct {
+	stringArrayInterfaceImpl
+	ColumnExpressionImpl
+}
+
+func (i *stringArrayColumnImpl) From(subQuery SelectTable) ColumnStringArray {
+	newStringArrayColumn := StringArrayColumn(i.name)
+	newStringArrayColumn.setTableName(i.tableName)
+	newStringArrayColumn.setSubQuery(subQuery)
+
+	return newStringArrayColumn
+}
+
+func (i *stringArrayColumnImpl) SET(arrayExp StringArrayExpression) ColumnAssigment {
+	return columnAssigmentImpl{
+		column:     i,
+		expression: arrayExp,
+	}
+}
+
+// StringArrayColumn creates named string array column.
+func StringArrayColumn(name string) ColumnStringArray {
+	stringArrayColumn := &stringArrayColumnImpl{}
+	stringArrayColumn.stringArrayInterfaceImpl.parent = stringArrayColumn
+	stringArrayColumn.ColumnExpressionImpl = NewColumnImpl(name, "", stringArrayColumn)
+	return stringArrayColumn
+}
+
+//------------------------------------------------------//
+
+// ColumnDateArray is interface of SQL date array columns.
+type ColumnDateArray interface {
+	DateArrayExpression
+	Column
+
+	From(subQuery SelectTable) ColumnDateArray
+	SET(arrayExp DateArrayExpression) ColumnAssigment
+}
+
+type dateArrayColumnImpl struct {
+	dateArrayInterfaceImpl
+	ColumnExpressionImpl
+}
+
+func (i *dateArrayColumnImpl) From(subQuery SelectTable) ColumnDateArray {
+	newDateArrayColumn := DateArrayColumn(i.name)
+	newDateArrayColumn.setTableName(i.tableName)
+	newDateArrayColumn.setSubQuery(subQuery)
+
+	return newDateArrayColumn
+}
+
+func (i *dateArrayColumnImpl) SET(arrayExp DateArrayExpression) ColumnAssigment {
+	return columnAssigmentImpl{
+		column:     i,
+		expression: arrayExp,
+	}
+}
+
+// DateArrayColumn creates named date array column.
+func DateArrayColumn(name string) ColumnDateArray {
+	dateArrayColumn := &dateArrayColumnImpl{}
+	dateArrayColumn.dateArrayInterfaceImpl.parent = dateArrayColumn
+	dateArrayColumn.ColumnExpressionImpl = NewColumnImpl(name, "", dateArrayColumn)
+	return dateArrayColumn
+}
+
+//------------------------------------------------------//
+
+// ColumnTimeArray is interface of SQL time array columns.
+type ColumnTimeArray interface {
+	TimeArrayExpression
+	Column
+
+	From(subQuery SelectTable) ColumnTimeArray
+	SET(arrayExp TimeArrayExpression) ColumnAssigment
+}
+
+type timeArrayColumnImpl struct {
+	timeArrayInterfaceImpl
+	ColumnExpressionImpl
+}
+
+func (i *timeArrayColumnImpl) From(subQuery SelectTable) ColumnTimeArray {
+	newTimeArrayColumn := TimeArrayColumn(i.name)
+	newTimeArrayColumn.setTableName(i.tableName)
+	newTimeArrayColumn.setSubQuery(subQuery)
+
+	return newTimeArrayColumn
+}
+
+func (i *timeArrayColumnImpl) SET(arrayExp TimeArrayExpression) ColumnAssigment {
+	return columnAssigmentImpl{
+		column:     i,
+		expression: arrayExp,
+	}
+}
+
+// TimeArrayColumn creates named time array column.
+func TimeArrayColumn(name string) ColumnTimeArray {
+	timeArrayColumn := &timeArrayColumnImpl{}
+	timeArrayColumn.timeArrayInterfaceImpl.parent = timeArrayColumn
+	timeArrayColumn.ColumnExpressionImpl = NewColumnImpl(name, "", timeArrayColumn)
+	return timeArrayColumn
+}
+
+//------------------------------------------------------//
+
+// ColumnTimezArray is interface of SQL time with time zone array columns.
+type ColumnTimezArray interface {
+	TimezArrayExpression
+	Column
+
+	From(subQuery SelectTable) ColumnTimezArray
+	SET(arrayExp TimezArrayExpression) ColumnAssigment
+}
+
+type timezArrayColumnImpl struct {
+	timezArrayInterfaceImpl
+	ColumnExpressionImpl
+}
+
+func (i *timezArrayColumnImpl) From(subQuery SelectTable) ColumnTimezArray {
+	newTimezArrayColumn := TimezArrayColumn(i.name)
+	newTimezArrayColumn.setTableName(i.tableName)
+	newTimezArrayColumn.setSubQuery(subQuery)
+
+	return newTimezArrayColumn
+}
+
+func (i *timezArrayColumnImpl) SET(arrayExp TimezArrayExpression) ColumnAssigment {
+	return columnAssigmentImpl{
+		column:     i,
+		expression: arrayExp,
+	}
+}
+
+// TimezArrayColumn creates named timez array column.
+func TimezArrayColumn(name string) ColumnTimezArray {
+	timezArrayColumn := &timezArrayColumnImpl{}
+	timezArrayColumn.timezArrayInterfaceImpl.parent = timezArrayColumn
+	timezArrayColumn.ColumnExpressionImpl = NewColumnImpl(name, "", timezArrayColumn)
+	return timezArrayColumn
+}
+
+//------------------------------------------------------//
+
+// ColumnTimestampArray is interface of SQL timestamp array columns.
+type ColumnTimestampArray interface {
+	TimestampArrayExpression
+	Column
+
+	From(subQuery SelectTable) ColumnTimestampArray
+	SET(arrayExp TimestampArrayExpression) ColumnAssigment
+}
+
+type timestampArrayColumnImpl struct {
+	timestampArrayInterfaceImpl
+	ColumnExpressionImpl
+}
+
+func (i *timestampArrayColumnImpl) From(subQuery SelectTable) ColumnTimestampArray {
+	newTimestampArrayColumn := TimestampArrayColumn(i.name)
+	newTimestampArrayColumn.setTableName(i.tableName)
+	newTimestampArrayColumn.setSubQuery(subQuery)
+
+	return newTimestampArrayColumn
+}
+
+func (i *timestampArrayColumnImpl) SET(arrayExp TimestampArrayExpression) ColumnAssigment {
+	return columnAssigmentImpl{
+		column:     i,
+		expression: arrayExp,
+	}
+}
+
+// TimestampArrayColumn creates named timestamp array column.
+func TimestampArrayColumn(name string) ColumnTimestampArray {
+	timestampArrayColumn := &timestampArrayColumnImpl{}
+	timestampArrayColumn.timestampArrayInterfaceImpl.parent = timestampArrayColumn
+	timestampArrayColumn.ColumnExpressionImpl = NewColumnImpl(name, "", timestampArrayColumn)
+	return timestampArrayColumn
+}
+
+//------------------------------------------------------//
+
+// ColumnTimestampzArray is interface of SQL timestamp with time zone array columns.
+type ColumnTimestampzArray interface {
+	TimestampzArrayExpression
+	Column
+
+	From(subQuery SelectTable) ColumnTimestampzArray
+	SET(arrayExp TimestampzArrayExpression) ColumnAssigment
+}
+
+type timestampzArrayColumnImpl struct {
+	timestampzArrayInterfaceImpl
+	ColumnExpressionImpl
+}
+
+func (i *timestampzArrayColumnImpl) From(subQuery SelectTable) ColumnTimestampzArray {
+	newTimestampzArrayColumn := TimestampzArrayColumn(i.name)
+	newTimestampzArrayColumn.setTableName(i.tableName)
+	newTimestampzArrayColumn.setSubQuery(subQuery)
+
+	return newTimestampzArrayColumn
+}
+
+func (i *timestampzArrayColumnImpl) SET(arrayExp TimestampzArrayExpression) ColumnAssigment {
+	return columnAssigmentImpl{
+		column:     i,
+		expression: arrayExp,
+	}
+}
+
+// TimestampzArrayColumn creates named timestampz array column.
+func TimestampzArrayColumn(name string) ColumnTimestampzArray {
+	timestampzArrayColumn := &timestampzArrayColumnImpl{}
+	timestampzArrayColumn.timestampzArrayInterfaceImpl.parent = timestampzArrayColumn
+	timestampzArrayColumn.ColumnExpressionImpl = NewColumnImpl(name, "", timestampzArrayColumn)
+	return timestampzArrayColumn
+}
@@ -127,6 +127,12 @@ func EVERY(boolExpression BoolExpression) boolWindowExpression {
 	return newBoolWindowFunc("EVERY", boolExpression)
 }
 
+// GROUPING returns a bit mask indicating which GROUP BY expressions are not included in the current grouping set.
+// Bits are assigned with the rightmost argument corresponding to the least-significant bit.
+func GROUPING(expressions ...Expression) IntegerExpression {
+	return newIntegerFunc("GROUPING", expressions...)
+}
+
 // MAX is aggregate function. Returns minimum value of expression across all input values.
 func MAX(expression Expression) Expression {
 	return newWindowFunc("MAX", expression)
@@ -569,6 +575,82 @@ func NOW() TimestampzExpression {
 	return newTimestampzFunc("NOW")
 }
 
+// ----------------------- JSON Functions ----------------------//
+
+// TO_JSON returns the value as json
+func TO_JSON(expression Expression) JsonExpression {
+	return NewJsonFunc("TO_JSON", expression)
+}
+
+// TO_JSONB returns the value as jsonb
+func TO_JSONB(expression Expression) JsonExpression {
+	return NewJsonFunc("TO_JSONB", expression)
+}
+
+// JSON_BUILD_OBJECT builds a json object out of a variadic argument list of alternating keys and values
+func JSON_BUILD_OBJECT(keyValues ...Expression) JsonExpression {
+	return NewJsonFunc("JSON_BUILD_OBJECT", keyValues...)
+}
+
+// JSONB_BUILD_OBJECT builds a jsonb object out of a variadic argument list of alternating keys and values
+func JSONB_BUILD_OBJECT(keyValues ...Expression) JsonExpression {
+	return NewJsonFunc("JSONB_BUILD_OBJECT", keyValues...)
+}
+
+// JSON_AGG is aggregate function. Collects all the input values, including nulls, into a json array.
+func JSON_AGG(expression Expression) JsonExpression {
+	return NewJsonFunc("JSON_AGG", expression)
+}
+
+// JSONB_AGG is aggregate function. Collects all the input values, including nulls, into a jsonb array.
+func JSONB_AGG(expression Expression) JsonExpression {
+	return NewJsonFunc("JSONB_AGG", expression)
+}
+
+// JSONB_SET returns target with the item designated by path replaced by newValue, or with newValue added
+// if createMissing is true (default is true) and the item designated by path does not exist.
+func JSONB_SET(target JsonExpression, path []string, newValue JsonExpression, createMissing ...bool) JsonExpression {
+	if len(createMissing) > 0 {
+		return NewJsonFunc("JSONB_SET", target, textArray(path), newValue, Bool(createMissing[0]))
+	}
+
+	return NewJsonFunc("JSONB_SET", target, textArray(path), newValue)
+}
+
+// JSONB_PATH_QUERY returns all json items returned by the json path for the specified json value.
+// Optional vars argument is json object used to substitute named variables in the path expression.
+func JSONB_PATH_QUERY(target JsonExpression, path string, vars ...JsonExpression) JsonExpression {
+	jsonPath := NewCastImpl(literal(path)).AS("jsonpath")
+
+	if len(vars) > 0 {
+		return NewJsonFunc("JSONB_PATH_QUERY", target, jsonPath, vars[0])
+	}
+
+	return NewJsonFunc("JSONB_PATH_QUERY", target, jsonPath)
+}
+
+// ----------------------- Array Functions ----------------------//
+
+// ARRAY_AGG is aggregate function. Collects all the input values, including nulls, into an array.
+func ARRAY_AGG(expression Expression) ArrayExpression {
+	return newArrayFunc("ARRAY_AGG", expression)
+}
+
+// UNNEST expands an array into a set of rows
+func UNNEST(array ArrayExpression) Expression {
+	return NewFunc("UNNEST", []Expression{array}, nil)
+}
+
+// CARDINALITY returns the total number of elements in the array, or 0 if the array is empty
+func CARDINALITY(array ArrayExpression) IntegerExpression {
+	return newIntegerFunc("CARDINALITY", array)
+}
+
+// ARRAY_LENGTH returns the length of the requested array dimension
+func ARRAY_LENGTH(array ArrayExpression, dimension IntegerExpression) IntegerExpression {
+	return newIntegerFunc("ARRAY_LENGTH", array, dimension)
+}
+
 // --------------- Conditional Expressions Functions -------------//
 
 // COALESCE function returns the first of its arguments that is not null.
@@ -763,6 +845,34 @@ func NewStringFunc(name string, expressions ...Expression) StringExpression {
 	return stringFunc
 }
 
+type jsonFunc struct {
+	funcExpressionImpl
+	jsonInterfaceImpl
+}
+
+// NewJsonFunc creates new json function with name and expression parameters
+func NewJsonFunc(name string, expressions ...Expression) JsonExpression {
+	jsonFunc := &jsonFunc{}
+
+	jsonFunc.funcExpressionImpl = *NewFunc(name, expressions, jsonFunc)
+	jsonFunc.jsonInterfaceImpl.parent = jsonFunc
+
+	return jsonFunc
+}
+
+type arrayFunc struct {
+	funcExpressionImpl
+	arrayInterfaceImpl
+}
+
+func newArrayFunc(name string, expressions ...Expression) ArrayExpression {
+	arrayFunc := &arrayFunc{}
+
+	arrayFunc.funcExpressionImpl = *NewFunc(name, expressions, arrayFunc)
+
+	return arrayFunc
+}
+
 type dateFunc struct {
 	funcExpressionImpl
 	dateInterfaceImpl
@@ -51,6 +51,11 @@ func TestFuncEVERY(t *testing.T) {
 	assertClauseSerialize(t, EVERY(table1ColBool), "EVERY(table1.col_bool)")
 }
 
+func TestFuncGROUPING(t *testing.T) {
+	assertClauseSerialize(t, GROUPING(table1ColInt), "GROUPING(table1.col_int)")
+	assertClauseSerialize(t, GROUPING(table1ColInt, table1ColFloat).EQ(Int(1)), "(GROUPING(table1.col_int, table1.col_float) = $1)", int64(1))
+}
+
 func TestFuncMIN(t *testing.T) {
 	t.Run("expression", func(t *testing.T) {
 		assertClauseSerialize(t, MIN(table1ColDate), "MIN(table1.col_date)")
@@ -202,3 +207,35 @@ func TestTO_ASCII(t *testing.T) {
 func TestFunc(t *testing.T) {
 	assertClauseSerialize(t, Func("FOO", String("test"), NULL, MAX(Int(1))), "FOO($1, NULL, MAX($2))", "test", int64(1))
 }
+
+func TestFuncJSON(t *testing.T) {
+	assertClauseSerialize(t, TO_JSON(table3StrCol), "TO_JSON(table3.col2)")
+	assertClauseSerialize(t, TO_JSONB(table3StrCol), "TO_JSONB(table3.col2)")
+	assertClauseSerialize(t, JSON_BUILD_OBJECT(String("id"), table3Col1), "JSON_BUILD_OBJECT($1, table3.col1)", "id")
+	assertClauseSerialize(t, JSONB_BUILD_OBJECT(String("id"), table3Col1).GET(String("id")),
+		"(JSONB_BUILD_OBJECT($1, table3.col1) -> $2)", "id", "id")
+	assertClauseSerialize(t, JSON_AGG(table3ColJson), "JSON_AGG(table3.col_json)")
+	assertClauseSerialize(t, JSONB_AGG(table3ColJson), "JSONB_AGG(table3.col_json)")
+}
+
+func TestFuncJSONB_SET(t *testing.T) {
+	assertClauseSerialize(t, JSONB_SET(table3ColJson, []string{"a", "b"}, table3ColJson),
+		"JSONB_SET(table3.col_json, CAST($1 AS text[]), table3.col_json)", `{"a","b"}`)
+	assertClauseSerialize(t, JSONB_SET(table3ColJson, []string{"a"}, table3ColJson, false),
+		"JSONB_SET(table3.col_json, CAST($1 AS text[]), table3.col_json, $2)", `{"a"}`, false)
+}
+
+func TestFuncJSONB_PATH_QUERY(t *testing.T) {
+	assertClauseSerialize(t, JSONB_PATH_QUERY(table3ColJson, "$.a[*]"),
+		"JSONB_PATH_QUERY(table3.col_json, CAST($1 AS jsonpath))", "$.a[*]")
+	assertClauseSerialize(t, JSONB_PATH_QUERY(table3ColJson, "$.a[*] ? (@ > $min)", table3ColJson),
+		"JSONB_PATH_QUERY(table3.col_json, CAST($1 AS jsonpath), table3.col_json)", "$.a[*] ? (@ > $min)")
+}
+
+func TestFuncArray(t *testing.T) {
+	assertClauseSerialize(t, ARRAY_AGG(table3Col1), "ARRAY_AGG(table3.col1)")
+	assertClauseSerialize(t, IntegerArrayExp(ARRAY_AGG(table3Col1)).AT(Int(1)), "(ARRAY_AGG(table3.col1))[$1]", int64(1))
+	assertClauseSerialize(t, UNNEST(table3ColIntArray), "UNNEST(table3.col_int_array)")
+	assertClauseSerialize(t, CARDINALITY(table3ColIntArray), "CARDINALITY(table3.col_int_array)")
+	assertClauseSerialize(t, ARRAY_LENGTH(table3ColIntArray, Int(1)), "ARRAY_LENGTH(table3.col_int_array, $1)", int64(1))
+}
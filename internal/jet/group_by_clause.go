@@ -4,3 +4,113 @@ package jet
 type GroupByClause interface {
 	serializeForGroupBy(statement StatementType, out *SQLBuilder)
 }
+
+// Grouping element names. Dialects can override grouping element serialization
+// using function serialize overrides with these names.
+const (
+	GroupByRollup       = "ROLLUP"
+	GroupByCube         = "CUBE"
+	GroupByGroupingSets = "GROUPING SETS"
+)
+
+type groupingElement struct {
+	name     string
+	elements []GroupByClause
+}
+
+func newGroupingElement(name string, elements []GroupByClause) GroupByClause {
+	return &groupingElement{
+		name:     name,
+		elements: elements,
+	}
+}
+
+func (g *groupingElement) serializeForGroupBy(statement StatementType, out *SQLBuilder) {
+	if serializeOverride := out.Dialect.FunctionSerializeOverride(g.name); serializeOverride != nil {
+		serializeOverrideFunc := serializeOverride(groupByClauseListToSerializerList(g.elements)...)
+		serializeOverrideFunc(statement, out)
+		return
+	}
+
+	out.WriteString(g.name + "(")
+	serializeGroupByClauseList(statement, g.elements, out)
+	out.WriteString(")")
+}
+
+// GroupingElementName returns name of the grouping element (ROLLUP, CUBE or GROUPING SETS), or an empty string if
+// group by clause is not a grouping element
+func GroupingElementName(clause GroupByClause) string {
+	if element, ok := clause.(*groupingElement); ok {
+		return element.name
+	}
+
+	return ""
+}
+
+// ROLLUP grouping element groups rows by all the prefixes of the listed elements, including empty prefix (grand total).
+func ROLLUP(elements ...GroupByClause) GroupByClause {
+	return newGroupingElement(GroupByRollup, elements)
+}
+
+// CUBE grouping element groups rows by all the subsets (the power set) of the listed elements.
+func CUBE(elements ...GroupByClause) GroupByClause {
+	return newGroupingElement(GroupByCube, elements)
+}
+
+// GROUPING_SETS grouping element groups rows separately by each of the listed grouping sets.
+func GROUPING_SETS(groupingSets ...GroupByClause) GroupByClause {
+	return newGroupingElement(GroupByGroupingSets, groupingSets)
+}
+
+//---------------------------------------------------//
+
+type groupingSet struct {
+	expressions []Expression
+}
+
+func (g *groupingSet) serializeForGroupBy(statement StatementType, out *SQLBuilder) {
+	out.WriteString("(")
+	serializeExpressionList(statement, g.expressions, ", ", out, NoWrap)
+	out.WriteString(")")
+}
+
+// GROUPING_SET is list of expressions grouped together inside GROUPING_SETS, ROLLUP or CUBE grouping elements.
+// GROUPING_SET without expressions is an empty grouping set '()', used to calculate grand total.
+func GROUPING_SET(expressions ...Expression) GroupByClause {
+	return &groupingSet{expressions: expressions}
+}
+
+//---------------------------------------------------//
+
+// serializeGroupByClauseList serializes list of group by clauses separated with comma
+func serializeGroupByClauseList(statement StatementType, clauses []GroupByClause, out *SQLBuilder) {
+	for i, clause := range clauses {
+		if i > 0 {
+			out.WriteString(", ")
+		}
+
+		if clause == nil {
+			panic("jet: nil clause in GROUP BY list")
+		}
+
+		clause.serializeForGroupBy(statement, out)
+	}
+}
+
+type groupByClauseSerializer struct {
+	GroupByClause
+}
+
+func (g groupByClauseSerializer) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
+	g.serializeForGroupBy(statement, out)
+}
+
+func groupByClauseListToSerializerList(clauses []GroupByClause) []Serializer {
+	var ret []Serializer
+
+	for _, clause := range clauses {
+		ret = append(ret, groupByClauseSerializer{clause})
+	}
+
+	return ret
+}
@@ -0,0 +1,155 @@
+package jet
+
+import "reflect"
+
+// JsonExpression interface
+type JsonExpression interface {
+	Expression
+
+	EQ(rhs JsonExpression) BoolExpression
+	NOT_EQ(rhs JsonExpression) BoolExpression
+	IS_DISTINCT_FROM(rhs JsonExpression) BoolExpression
+	IS_NOT_DISTINCT_FROM(rhs JsonExpression) BoolExpression
+
+	// GET extracts json object field with the given key, or json array element at the given index
+	GET(keyOrIndex Expression) JsonExpression
+	// GET_TEXT extracts json object field with the given key, or json array element at the given index, as text
+	GET_TEXT(keyOrIndex Expression) StringExpression
+	// GET_PATH extracts json sub-object at the specified path
+	GET_PATH(path ...string) JsonExpression
+	// GET_PATH_TEXT extracts json sub-object at the specified path as text
+	GET_PATH_TEXT(path ...string) StringExpression
+
+	// CONTAINS checks if this json value contains rhs json path/value entries at the top level
+	CONTAINS(rhs JsonExpression) BoolExpression
+	// IS_CONTAINED_BY checks if this json path/value entries are contained at the top level within the rhs json value
+	IS_CONTAINED_BY(rhs JsonExpression) BoolExpression
+	// HAS_KEY checks if the text string exists as a top-level key or array element within the json value
+	HAS_KEY(key StringExpression) BoolExpression
+	// HAS_ANY_KEY checks if any of the keys exist as top-level keys or array elements
+	HAS_ANY_KEY(keys ...string) BoolExpression
+	// HAS_ALL_KEYS checks if all of the keys exist as top-level keys or array elements
+	HAS_ALL_KEYS(keys ...string) BoolExpression
+
+	// CONCAT concatenates two json values
+	CONCAT(rhs JsonExpression) JsonExpression
+	// DELETE_KEY deletes key (and its value) from json object, or matching string values from json array
+	DELETE_KEY(key StringExpression) JsonExpression
+	// DELETE_PATH deletes the field or array element with the specified path
+	DELETE_PATH(path ...string) JsonExpression
+}
+
+// Json operators
+const (
+	JsonGetOperator         = "->"
+	JsonGetTextOperator     = "->>"
+	JsonGetPathOperator     = "#>"
+	JsonGetPathTextOperator = "#>>"
+	JsonContainsOperator    = "@>"
+	JsonContainedByOperator = "<@"
+	JsonHasKeyOperator      = "?"
+	JsonHasAnyKeyOperator   = "?|"
+	JsonHasAllKeysOperator  = "?&"
+	JsonDeleteOperator      = "-"
+	JsonDeletePathOperator  = "#-"
+	JsonConcatOperator      = "||"
+)
+
+type jsonInterfaceImpl struct {
+	parent JsonExpression
+}
+
+func (j *jsonInterfaceImpl) EQ(rhs JsonExpression) BoolExpression {
+	return Eq(j.parent, rhs)
+}
+
+func (j *jsonInterfaceImpl) NOT_EQ(rhs JsonExpression) BoolExpression {
+	return NotEq(j.parent, rhs)
+}
+
+func (j *jsonInterfaceImpl) IS_DISTINCT_FROM(rhs JsonExpression) BoolExpression {
+	return IsDistinctFrom(j.parent, rhs)
+}
+
+func (j *jsonInterfaceImpl) IS_NOT_DISTINCT_FROM(rhs JsonExpression) BoolExpression {
+	return IsNotDistinctFrom(j.parent, rhs)
+}
+
+func (j *jsonInterfaceImpl) GET(keyOrIndex Expression) JsonExpression {
+	return newBinaryJsonOperatorExpression(j.parent, keyOrIndex, JsonGetOperator)
+}
+
+func (j *jsonInterfaceImpl) GET_TEXT(keyOrIndex Expression) StringExpression {
+	return newBinaryStringOperatorExpression(j.parent, keyOrIndex, JsonGetTextOperator)
+}
+
+func (j *jsonInterfaceImpl) GET_PATH(path ...string) JsonExpression {
+	return newBinaryJsonOperatorExpression(j.parent, textArray(path), JsonGetPathOperator)
+}
+
+func (j *jsonInterfaceImpl) GET_PATH_TEXT(path ...string) StringExpression {
+	return newBinaryStringOperatorExpression(j.parent, textArray(path), JsonGetPathTextOperator)
+}
+
+func (j *jsonInterfaceImpl) CONTAINS(rhs JsonExpression) BoolExpression {
+	return newBinaryBoolOperatorExpression(j.parent, rhs, JsonContainsOperator)
+}
+
+func (j *jsonInterfaceImpl) IS_CONTAINED_BY(rhs JsonExpression) BoolExpression {
+	return newBinaryBoolOperatorExpression(j.parent, rhs, JsonContainedByOperator)
+}
+
+func (j *jsonInterfaceImpl) HAS_KEY(key StringExpression) BoolExpression {
+	return newBinaryBoolOperatorExpression(j.parent, key, JsonHasKeyOperator)
+}
+
+func (j *jsonInterfaceImpl) HAS_ANY_KEY(keys ...string) BoolExpression {
+	return newBinaryBoolOperatorExpression(j.parent, textArray(keys), JsonHasAnyKeyOperator)
+}
+
+func (j *jsonInterfaceImpl) HAS_ALL_KEYS(keys ...string) BoolExpression {
+	return newBinaryBoolOperatorExpression(j.parent, textArray(keys), JsonHasAllKeysOperator)
+}
+
+func (j *jsonInterfaceImpl) CONCAT(rhs JsonExpression) JsonExpression {
+	return newBinaryJsonOperatorExpression(j.parent, rhs, JsonConcatOperator)
+}
+
+func (j *jsonInterfaceImpl) DELETE_KEY(key StringExpression) JsonExpression {
+	return newBinaryJsonOperatorExpression(j.parent, key, JsonDeleteOperator)
+}
+
+func (j *jsonInterfaceImpl) DELETE_PATH(path ...string) JsonExpression {
+	return newBinaryJsonOperatorExpression(j.parent, textArray(path), JsonDeletePathOperator)
+}
+
+//---------------------------------------------------//
+
+func newBinaryJsonOperatorExpression(lhs, rhs Expression, operator string) JsonExpression {
+	return JsonExp(NewBinaryOperatorExpression(lhs, rhs, operator))
+}
+
+// textArray creates text array literal ('{"elem1","elem2"}'::text[]) from the list of strings
+func textArray(elems []string) Expression {
+	return NewCastImpl(literal(arrayLiteralString(reflect.ValueOf(elems)))).AS("text[]")
+}
+
+//---------------------------------------------------//
+
+type jsonExpressionWrapper struct {
+	jsonInterfaceImpl
+	Expression
+}
+
+func newJsonExpressionWrap(expression Expression) JsonExpression {
+	jsonExpressionWrap := jsonExpressionWrapper{Expression: expression}
+	jsonExpressionWrap.jsonInterfaceImpl.parent = &jsonExpressionWrap
+	return &jsonExpressionWrap
+}
+
+// JsonExp is json expression wrapper around arbitrary expression.
+// Allows go compiler to see any expression as json expression.
+// Does not add sql cast to generated sql builder output.
+func JsonExp(expression Expression) JsonExpression {
+	return newJsonExpressionWrap(expression)
+}
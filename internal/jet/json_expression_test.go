@@ -0,0 +1,57 @@
+package jet
+
+import (
+	"testing"
+)
+
+func TestJsonEQ(t *testing.T) {
+	assertClauseSerialize(t, table3ColJson.EQ(table3ColJson), "(table3.col_json = table3.col_json)")
+	assertClauseSerialize(t, table3ColJson.NOT_EQ(JsonExp(String(`{"a": 1}`))), "(table3.col_json != $1)", `{"a": 1}`)
+}
+
+func TestJsonIS_DISTINCT_FROM(t *testing.T) {
+	assertClauseSerialize(t, table3ColJson.IS_DISTINCT_FROM(table3ColJson), "(table3.col_json IS DISTINCT FROM table3.col_json)")
+	assertClauseSerialize(t, table3ColJson.IS_NOT_DISTINCT_FROM(table3ColJson), "(table3.col_json IS NOT DISTINCT FROM table3.col_json)")
+}
+
+func TestJsonGET(t *testing.T) {
+	assertClauseSerialize(t, table3ColJson.GET(String("name")), "(table3.col_json -> $1)", "name")
+	assertClauseSerialize(t, table3ColJson.GET(Int(2)), "(table3.col_json -> $1)", int64(2))
+	assertClauseSerialize(t, table3ColJson.GET(String("address")).GET(String("city")),
+		"((table3.col_json -> $1) -> $2)", "address", "city")
+}
+
+func TestJsonGET_TEXT(t *testing.T) {
+	assertClauseSerialize(t, table3ColJson.GET_TEXT(String("name")), "(table3.col_json ->> $1)", "name")
+	assertClauseSerialize(t, table3ColJson.GET_TEXT(String("name")).EQ(String("John")),
+		"((table3.col_json ->> $1) = $2)", "name", "John")
+}
+
+func TestJsonGET_PATH(t *testing.T) {
+	assertClauseSerialize(t, table3ColJson.GET_PATH("address", "city"),
+		"(table3.col_json #> CAST($1 AS text[]))", `{"address","city"}`)
+	assertClauseSerialize(t, table3ColJson.GET_PATH_TEXT("a\"b", `c\d`),
+		"(table3.col_json #>> CAST($1 AS text[]))", `{"a\"b","c\\d"}`)
+}
+
+func TestJsonCONTAINS(t *testing.T) {
+	assertClauseSerialize(t, table3ColJson.CONTAINS(JsonExp(String(`{"a": 1}`))), "(table3.col_json @> $1)", `{"a": 1}`)
+	assertClauseSerialize(t, table3ColJson.IS_CONTAINED_BY(table3ColJson), "(table3.col_json <@ table3.col_json)")
+}
+
+func TestJsonHAS_KEY(t *testing.T) {
+	assertClauseSerialize(t, table3ColJson.HAS_KEY(String("name")), "(table3.col_json ? $1)", "name")
+	assertClauseSerialize(t, table3ColJson.HAS_ANY_KEY("a", "b"), "(table3.col_json ?| CAST($1 AS text[]))", `{"a","b"}`)
+	assertClauseSerialize(t, table3ColJson.HAS_ALL_KEYS("a", "b"), "(table3.col_json ?& CAST($1 AS text[]))", `{"a","b"}`)
+}
+
+func TestJsonCONCAT(t *testing.T) {
+	assertClauseSerialize(t, table3ColJson.CONCAT(table3ColJson), "(table3.col_json || table3.col_json)")
+	assertClauseSerialize(t, table3ColJson.DELETE_KEY(String("a")), "(table3.col_json - $1)", "a")
+	assertClauseSerialize(t, table3ColJson.DELETE_PATH("a", "b"), "(table3.col_json #- CAST($1 AS text[]))", `{"a","b"}`)
+}
+
+func TestJsonExp(t *testing.T) {
+	assertClauseSerialize(t, JsonExp(table3StrCol).GET(String("a")), "(table3.col2 -> $1)", "a")
+	assertClauseSerialize(t, RawJson("'{}'::jsonb").HAS_KEY(String("a")), "(('{}'::jsonb) ? $1)", "a")
+}
@@ -485,6 +485,11 @@ func RawDate(raw string, namedArgs ...map[string]interface{}) DateExpression {
 	return DateExp(Raw(raw, namedArgs...))
 }
 
+// RawJson helper that for json expressions
+func RawJson(raw string, namedArgs ...map[string]interface{}) JsonExpression {
+	return JsonExp(Raw(raw, namedArgs...))
+}
+
 // UUID is a helper function to create string literal expression from uuid object
 // value can be any uuid type with a String method
 func UUID(value fmt.Stringer) StringExpression {
@@ -81,3 +81,44 @@ func (pl ProjectionList) Except(toExclude ...Column) ProjectionList {
 
 	return ret
 }
+
+// ProjectionAliases returns result column aliases of the statement projections, in the projection order, without
+// executing the statement. Unaliased expression result column name is chosen by the database, so expression debug
+// text is returned instead. It returns false for the statements without projections, for instance raw statements
+// and data modifying statements without RETURNING clause.
+func ProjectionAliases(statement Statement) ([]string, bool) {
+	hasProjections, ok := statement.(HasProjections)
+
+	if !ok {
+		return nil, false
+	}
+
+	projections := hasProjections.projections()
+
+	if len(projections) == 0 {
+		return nil, false
+	}
+
+	return projectionAliases(projections), true
+}
+
+func projectionAliases(projections []Projection) []string {
+	var ret []string
+
+	for _, projection := range projections {
+		switch p := projection.(type) {
+		case ProjectionList:
+			ret = append(ret, projectionAliases(p)...)
+		case ColumnList:
+			ret = append(ret, projectionAliases(ColumnListToProjectionList(p))...)
+		case *alias:
+			ret = append(ret, p.alias)
+		case ColumnExpression:
+			ret = append(ret, p.defaultAlias())
+		case Serializer:
+			ret = append(ret, serializeToDefaultDebugString(p))
+		}
+	}
+
+	return ret
+}
@@ -32,6 +32,8 @@ const (
 	LockStatementType   StatementType = "LOCK"
 	UnLockStatementType StatementType = "UNLOCK"
 	WithStatementType   StatementType = "WITH"
+	MergeStatementType  StatementType = "MERGE"
+	CallStatementType   StatementType = "CALL"
 )
 
 // Serializer interface
@@ -19,8 +19,10 @@ type Statement interface {
 	// If destination is pointer to struct and query result set is empty, method returns qrm.ErrNoRows.
 	Query(db qrm.Queryable, destination interface{}) error
 	// QueryContext executes statement with a context over database connection/transaction db and stores row result in destination.
-	// Destination can be either pointer to struct or pointer to a slice.
+	// Destination can be either pointer to struct or pointer to a slice. For ad-hoc queries, destination can also be
+	// pointer to a slice of map[string]interface{} or map[string]map[string]interface{} (see qrm.Query).
 	// If destination is pointer to struct and query result set is empty, method returns qrm.ErrNoRows.
+	// Strict mapping mode can be set in the context with qrm.WithStrictMode.
 	QueryContext(ctx context.Context, db qrm.Queryable, destination interface{}) error
 	// Exec executes statement over db connection/transaction without returning any rows.
 	Exec(db qrm.Executable) (sql.Result, error)
@@ -42,6 +44,14 @@ func (r *Rows) Scan(destination interface{}) error {
 	return qrm.ScanOneRowToDest(r.scanContext, r.Rows, destination)
 }
 
+// ScanGroup maps the next group of rows, with the same destination primary key values, into struct destination,
+// including nested slices of the destination. Statement has to be ordered by the destination primary key columns.
+// ScanGroup advances rows itself, and it returns false when there are no more rows. It should not be combined
+// with Next and Scan methods.
+func (r *Rows) ScanGroup(destination interface{}) (bool, error) {
+	return qrm.ScanGroupToDest(r.scanContext, r.Rows, destination)
+}
+
 // SerializerStatement interface
 type SerializerStatement interface {
 	Serializer
@@ -163,7 +173,7 @@ func (s *serializerStatementInterfaceImpl) Rows(ctx context.Context, db qrm.Quer
 		return nil, err
 	}
 
-	scanContext, err := qrm.NewScanContext(rows)
+	scanContext, err := qrm.NewScanContext(rows, qrm.StrictModeFromContext(ctx))
 
 	if err != nil {
 		return nil, err
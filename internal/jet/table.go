@@ -89,6 +89,46 @@ func (t *tableImpl) serialize(statement StatementType, out *SQLBuilder, options
 	}
 }
 
+// NewTableFunction creates new table from set-returning function call, with schema name, function name,
+// function arguments and list of result columns
+func NewTableFunction(schemaName, name, alias string, args []Expression, columns ...ColumnExpression) SerializerTable {
+	return &tableFunctionImpl{
+		tableImpl: *NewTable(schemaName, name, alias, columns...).(*tableImpl),
+		args:      args,
+	}
+}
+
+type tableFunctionImpl struct {
+	tableImpl
+	args []Expression
+}
+
+func (t *tableFunctionImpl) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
+	if t == nil {
+		panic("jet: tableFunctionImpl is nil")
+	}
+
+	if len(t.schemaName) > 0 {
+		out.WriteIdentifier(t.schemaName)
+		out.WriteString(".")
+	}
+
+	functionName := t.name
+	if out.shouldQuote(functionName) {
+		identQuoteChar := string(out.Dialect.IdentifierQuoteChar())
+		functionName = identQuoteChar + functionName + identQuoteChar
+	}
+
+	out.WriteString(functionName + "(")
+	serializeExpressionList(statement, t.args, ", ", out)
+	out.WriteString(")")
+
+	if len(t.alias) > 0 {
+		out.WriteString("AS")
+		out.WriteIdentifier(t.alias)
+	}
+}
+
 // JoinType is type of table join
 type JoinType int
 
@@ -44,11 +44,14 @@ var (
 var table2 = NewTable("db", "table2", "", table2Col3, table2Col4, table2ColInt, table2ColFloat, table2ColStr, table2ColBool, table2ColTime, table2ColTimez, table2ColDate, table2ColTimestamp, table2ColTimestampz)
 
 var (
-	table3Col1   = IntegerColumn("col1")
-	table3ColInt = IntegerColumn("col_int")
-	table3StrCol = StringColumn("col2")
+	table3Col1        = IntegerColumn("col1")
+	table3ColInt      = IntegerColumn("col_int")
+	table3StrCol      = StringColumn("col2")
+	table3ColJson     = JsonColumn("col_json")
+	table3ColIntArray = IntegerArrayColumn("col_int_array")
+	table3ColStrArray = StringArrayColumn("col_str_array")
 )
-var table3 = NewTable("db", "table3", "", table3Col1, table3ColInt, table3StrCol)
+var table3 = NewTable("db", "table3", "", table3Col1, table3ColInt, table3StrCol, table3ColJson, table3ColIntArray, table3ColStrArray)
 
 func assertClauseSerialize(t *testing.T, clause Serializer, query string, args ...interface{}) {
 	out := SQLBuilder{Dialect: defaultDialect}
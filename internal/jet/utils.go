@@ -1,9 +1,13 @@
 package jet
 
 import (
+	"database/sql/driver"
+	"fmt"
+	"github.com/go-jet/jet/v2/internal/3rdparty/pq"
 	"github.com/go-jet/jet/v2/internal/utils"
 	"reflect"
 	"strings"
+	"time"
 )
 
 // SerializeClauseList func
@@ -146,6 +150,23 @@ func ToSerializerValue(value interface{}) Serializer {
 
 // UnwindRowFromModel func
 func UnwindRowFromModel(columns []Column, data interface{}) []Serializer {
+	return unwindRowFromModel(columns, data, false)
+}
+
+// UnwindRowFromModelWithArrays is UnwindRowFromModel, which in addition converts model fields of PostgreSQL array
+// columns into array literals.
+func UnwindRowFromModelWithArrays(columns []Column, data interface{}) []Serializer {
+	return unwindRowFromModel(columns, data, true)
+}
+
+func unwindRowFromModel(columns []Column, data interface{}, withArrays bool) []Serializer {
+	var defaultableColumns map[string]bool
+
+	if model, ok := data.(modelWithDefaults); ok {
+		data = model.data
+		defaultableColumns = model.defaultableColumns
+	}
+
 	structValue := reflect.Indirect(reflect.ValueOf(data))
 
 	row := []Serializer{}
@@ -162,6 +183,11 @@ func UnwindRowFromModel(columns []Column, data interface{}) []Serializer {
 			panic("missing struct field for column : " + columnName)
 		}
 
+		if defaultableColumns[columnName] && isZeroValue(structField) {
+			row = append(row, DEFAULT)
+			continue
+		}
+
 		var field interface{}
 
 		if structField.Kind() == reflect.Ptr && structField.IsNil() {
@@ -170,23 +196,151 @@ func UnwindRowFromModel(columns []Column, data interface{}) []Serializer {
 			field = reflect.Indirect(structField).Interface()
 		}
 
+		if withArrays && isArrayValue(field) {
+			field = arrayLiteralString(reflect.ValueOf(field))
+		}
+
 		row = append(row, literal(field))
 	}
 
 	return row
 }
 
+// modelWithDefaults is model data, for which zero values of the defaultable columns fields are replaced with DEFAULT
+type modelWithDefaults struct {
+	data               interface{}
+	defaultableColumns map[string]bool
+}
+
+// DefaultOnZero wraps model data, so that DEFAULT keyword is used instead of the zero values of the defaultable columns fields.
+// Column lists, for instance generated DefaultableColumns list, are expanded into list of columns.
+func DefaultOnZero(data interface{}, defaultableColumns ...Column) interface{} {
+	columnNames := map[string]bool{}
+
+	for _, column := range defaultableColumns {
+		if columnList, ok := column.(ColumnList); ok {
+			for _, listColumn := range columnList {
+				columnNames[listColumn.Name()] = true
+			}
+		} else {
+			columnNames[column.Name()] = true
+		}
+	}
+
+	return modelWithDefaults{
+		data:               data,
+		defaultableColumns: columnNames,
+	}
+}
+
+func isZeroValue(value reflect.Value) bool {
+	return reflect.DeepEqual(value.Interface(), reflect.Zero(value.Type()).Interface())
+}
+
+var valuerType = reflect.TypeOf((*driver.Valuer)(nil)).Elem()
+var timeType = reflect.TypeOf(time.Time{})
+
+// isArrayValue returns true if value is unnamed slice of base type elements, for instance []int32 or []*string.
+// Such values are model fields of PostgreSQL array columns. Byte slices, named slice types, like json.RawMessage
+// or net.IP, and types implementing driver.Valuer, like pq.StringArray, are passed to the driver unchanged.
+func isArrayValue(value interface{}) bool {
+	if value == nil {
+		return false
+	}
+
+	valueType := reflect.TypeOf(value)
+
+	if valueType.Kind() != reflect.Slice || valueType.Name() != "" || valueType.Implements(valuerType) {
+		return false
+	}
+
+	elemType := valueType.Elem()
+
+	if elemType.Kind() == reflect.Ptr {
+		elemType = elemType.Elem()
+	}
+
+	if elemType == timeType {
+		return true
+	}
+
+	switch elemType.Kind() {
+	case reflect.Bool, reflect.String, reflect.Float32, reflect.Float64,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return !elemType.Implements(valuerType)
+	}
+
+	return false
+}
+
+// arrayLiteralString converts slice value into PostgreSQL array text representation, for instance {1,2,NULL}
+func arrayLiteralString(sliceValue reflect.Value) string {
+	var elems []string
+
+	for i := 0; i < sliceValue.Len(); i++ {
+		elem := sliceValue.Index(i)
+
+		if elem.Kind() == reflect.Ptr {
+			if elem.IsNil() {
+				elems = append(elems, "NULL")
+				continue
+			}
+			elem = elem.Elem()
+		}
+
+		var elemStr string
+
+		switch elemValue := elem.Interface().(type) {
+		case string:
+			elemStr = elemValue
+		case time.Time:
+			elemStr = string(pq.FormatTimestamp(elemValue))
+		case fmt.Stringer:
+			elemStr = elemValue.String()
+		default:
+			elemStr = fmt.Sprintf("%v", elemValue)
+		}
+
+		elemStr = strings.Replace(elemStr, `\`, `\\`, -1)
+		elemStr = strings.Replace(elemStr, `"`, `\"`, -1)
+		elems = append(elems, `"`+elemStr+`"`)
+	}
+
+	return "{" + strings.Join(elems, ",") + "}"
+}
+
 // UnwindRowsFromModels func
 func UnwindRowsFromModels(columns []Column, data interface{}) [][]Serializer {
+	return unwindRowsFromModels(columns, data, false)
+}
+
+// UnwindRowsFromModelsWithArrays is UnwindRowsFromModels, which in addition converts model fields of PostgreSQL array
+// columns into array literals.
+func UnwindRowsFromModelsWithArrays(columns []Column, data interface{}) [][]Serializer {
+	return unwindRowsFromModels(columns, data, true)
+}
+
+func unwindRowsFromModels(columns []Column, data interface{}, withArrays bool) [][]Serializer {
+	model, withDefaults := data.(modelWithDefaults)
+
+	if withDefaults {
+		data = model.data
+	}
+
 	sliceValue := reflect.Indirect(reflect.ValueOf(data))
 	utils.ValueMustBe(sliceValue, reflect.Slice, "jet: data has to be a slice.")
 
 	rows := [][]Serializer{}
 
 	for i := 0; i < sliceValue.Len(); i++ {
-		structValue := sliceValue.Index(i)
+		var rowData interface{} = sliceValue.Index(i).Interface()
+
+		if withDefaults {
+			rowData = modelWithDefaults{data: rowData, defaultableColumns: model.defaultableColumns}
+		}
 
-		rows = append(rows, UnwindRowFromModel(columns, structValue.Interface()))
+		rows = append(rows, unwindRowFromModel(columns, rowData, withArrays))
 	}
 
 	return rows
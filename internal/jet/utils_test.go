@@ -1,8 +1,14 @@
 package jet
 
 import (
+	"database/sql/driver"
+	"encoding/json"
 	"github.com/stretchr/testify/require"
+	"net"
+	"reflect"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestOptionalOrDefaultString(t *testing.T) {
@@ -17,3 +23,52 @@ func TestOptionalOrDefaultExpression(t *testing.T) {
 	require.Equal(t, OptionalOrDefaultExpression(defaultExpression), defaultExpression)
 	require.Equal(t, OptionalOrDefaultExpression(defaultExpression, optionalExpression), optionalExpression)
 }
+
+func TestArrayLiteralString(t *testing.T) {
+	str := "b"
+
+	require.Equal(t, arrayLiteralString(reflect.ValueOf([]int32{1, 2, 3})), `{"1","2","3"}`)
+	require.Equal(t, arrayLiteralString(reflect.ValueOf([]string{"a b", `c"d`, `e\f`})), `{"a b","c\"d","e\\f"}`)
+	require.Equal(t, arrayLiteralString(reflect.ValueOf([]*string{nil, &str})), `{NULL,"b"}`)
+	require.Equal(t, arrayLiteralString(reflect.ValueOf([]int64{})), `{}`)
+}
+
+func TestUnwindRowFromModel_Array(t *testing.T) {
+	type Table3 struct {
+		ColIntArray []int32
+		ColStrArray *[]string
+	}
+
+	row := UnwindRowFromModelWithArrays([]Column{table3ColIntArray, table3ColStrArray}, Table3{ColIntArray: []int32{1, 2}})
+
+	require.Len(t, row, 2)
+	assertClauseSerialize(t, row[0], "$1", `{"1","2"}`)
+	assertClauseSerialize(t, row[1], "$1", nil)
+
+	row = UnwindRowFromModel([]Column{table3ColIntArray}, Table3{ColIntArray: []int32{1, 2}})
+	assertClauseSerialize(t, row[0], "$1", []int32{1, 2})
+}
+
+type stringArray []string
+
+func (a stringArray) Value() (driver.Value, error) {
+	return "{" + strings.Join(a, ",") + "}", nil
+}
+
+func TestIsArrayValue(t *testing.T) {
+	str := "a"
+
+	require.True(t, isArrayValue([]int32{1}))
+	require.True(t, isArrayValue([]*string{&str}))
+	require.True(t, isArrayValue([]time.Time{}))
+	require.True(t, isArrayValue([]float64(nil)))
+
+	require.False(t, isArrayValue(nil))
+	require.False(t, isArrayValue("a"))
+	require.False(t, isArrayValue([]byte("a")))
+	require.False(t, isArrayValue(json.RawMessage(`{}`)))
+	require.False(t, isArrayValue(net.ParseIP("127.0.0.1")))
+	require.False(t, isArrayValue(stringArray{"a"}))
+	require.False(t, isArrayValue([][]int32{{1}}))
+	require.False(t, isArrayValue([]struct{}{}))
+}
@@ -50,7 +50,11 @@ func (w withImpl) serialize(statement StatementType, out *SQLBuilder, options ..
 }
 
 func (w withImpl) projections() ProjectionList {
-	return ProjectionList{}
+	if w.primaryStatement == nil {
+		return nil
+	}
+
+	return w.primaryStatement.projections()
 }
 
 // CommonTableExpression contains information about a CTE.
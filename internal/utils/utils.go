@@ -4,8 +4,9 @@ import (
 	"database/sql"
 	"fmt"
 	"github.com/go-jet/jet/v2/internal/3rdparty/snaker"
-	"go/format"
+	"io/ioutil"
 	"os"
+	"path"
 	"path/filepath"
 	"reflect"
 	"strings"
@@ -22,36 +23,6 @@ func ToGoFileName(databaseIdentifier string) string {
 	return strings.ToLower(replaceInvalidChars(databaseIdentifier))
 }
 
-// SaveGoFile saves go file at folder dir, with name fileName and contents text.
-func SaveGoFile(dirPath, fileName string, text []byte) error {
-	newGoFilePath := filepath.Join(dirPath, fileName)
-
-	if !strings.HasSuffix(newGoFilePath, ".go") {
-		newGoFilePath += ".go"
-	}
-
-	file, err := os.Create(newGoFilePath)
-
-	if err != nil {
-		return err
-	}
-
-	defer file.Close()
-
-	p, err := format.Source(text)
-	if err != nil {
-		return err
-	}
-
-	_, err = file.Write(p)
-
-	if err != nil {
-		return err
-	}
-
-	return nil
-}
-
 // EnsureDirPath ensures dir path exists. If path does not exist, creates new path.
 func EnsureDirPath(dirPath string) error {
 	if _, err := os.Stat(dirPath); os.IsNotExist(err) {
@@ -65,25 +36,6 @@ func EnsureDirPath(dirPath string) error {
 	return nil
 }
 
-// CleanUpGeneratedFiles deletes everything at folder dir.
-func CleanUpGeneratedFiles(dir string) error {
-	exist, err := DirExists(dir)
-
-	if err != nil {
-		return err
-	}
-
-	if exist {
-		err := os.RemoveAll(dir)
-
-		if err != nil {
-			return err
-		}
-	}
-
-	return nil
-}
-
 // DBClose closes non nil db connection
 func DBClose(db *sql.DB) {
 	if db == nil {
@@ -105,6 +57,49 @@ func DirExists(path string) (bool, error) {
 	return true, err
 }
 
+// GoImportPath returns go import path of the package at dirPath, resolved using module path from the nearest
+// go.mod file found in dirPath or any of its parent folders. Returns false if go.mod file can not be found.
+func GoImportPath(dirPath string) (string, bool) {
+	absDirPath, err := filepath.Abs(dirPath)
+	if err != nil {
+		return "", false
+	}
+
+	for moduleDir := absDirPath; ; moduleDir = filepath.Dir(moduleDir) {
+		goMod, err := ioutil.ReadFile(filepath.Join(moduleDir, "go.mod"))
+
+		if err == nil {
+			modulePath := goModulePath(goMod)
+			if modulePath == "" {
+				return "", false
+			}
+
+			relPath, err := filepath.Rel(moduleDir, absDirPath)
+			if err != nil {
+				return "", false
+			}
+
+			return path.Join(modulePath, filepath.ToSlash(relPath)), true
+		}
+
+		if filepath.Dir(moduleDir) == moduleDir {
+			return "", false
+		}
+	}
+}
+
+func goModulePath(goMod []byte) string {
+	for _, line := range strings.Split(string(goMod), "\n") {
+		fields := strings.Fields(line)
+
+		if len(fields) >= 2 && fields[0] == "module" {
+			return strings.Trim(fields[1], `"`)
+		}
+	}
+
+	return ""
+}
+
 func replaceInvalidChars(str string) string {
 	str = strings.Replace(str, " ", "_", -1)
 	str = strings.Replace(str, "-", "_", -1)
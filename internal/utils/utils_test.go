@@ -3,6 +3,7 @@ package utils
 import (
 	"fmt"
 	"github.com/stretchr/testify/require"
+	"os"
 	"testing"
 )
 
@@ -48,3 +49,16 @@ func TestErrorCatchNonErr(t *testing.T) {
 
 	require.Error(t, err, "11")
 }
+
+func TestGoImportPath(t *testing.T) {
+	importPath, ok := GoImportPath("./not/existing/dir")
+	require.True(t, ok)
+	require.Equal(t, importPath, "github.com/go-jet/jet/v2/internal/utils/not/existing/dir")
+
+	importPath, ok = GoImportPath(".")
+	require.True(t, ok)
+	require.Equal(t, importPath, "github.com/go-jet/jet/v2/internal/utils")
+
+	_, ok = GoImportPath(os.TempDir())
+	require.False(t, ok)
+}
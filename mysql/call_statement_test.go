@@ -0,0 +1,14 @@
+package mysql
+
+import (
+	"testing"
+)
+
+func TestCall(t *testing.T) {
+	assertStatementSql(t, CALL("db.refresh_stats"), `
+CALL db.refresh_stats();
+`)
+	assertStatementSql(t, CALL("db.transfer", Int(11), table1ColInt, String("note")), `
+CALL db.transfer(?, table1.col_int, ?);
+`, int64(11), "note")
+}
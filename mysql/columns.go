@@ -56,3 +56,10 @@ type ColumnTimestamp = jet.ColumnTimestamp
 
 // TimestampColumn creates named timestamp column
 var TimestampColumn = jet.TimestampColumn
+
+// ColumnJson is interface of SQL json columns. MySQL json columns are string columns, json operators are
+// PostgreSQL only.
+type ColumnJson = jet.ColumnString
+
+// JsonColumn creates named json column.
+var JsonColumn = jet.StringColumn
@@ -17,10 +17,16 @@ func newDialect() jet.Dialect {
 	operatorSerializeOverrides["#"] = mysqlBitXor
 	operatorSerializeOverrides[jet.StringConcatOperator] = mysqlCONCAToperator
 
+	functionSerializeOverrides := map[string]jet.SerializeOverride{}
+	functionSerializeOverrides[jet.GroupByRollup] = mysqlWITHROLLUP
+	functionSerializeOverrides[jet.GroupByCube] = mysqlUnsupportedGroupingElement(jet.GroupByCube)
+	functionSerializeOverrides[jet.GroupByGroupingSets] = mysqlUnsupportedGroupingElement(jet.GroupByGroupingSets)
+
 	mySQLDialectParams := jet.DialectParams{
 		Name:                       "MySQL",
 		PackageName:                "mysql",
 		OperatorSerializeOverrides: operatorSerializeOverrides,
+		FunctionSerializeOverrides: functionSerializeOverrides,
 		AliasQuoteChar:             '"',
 		IdentifierQuoteChar:        '`',
 		ArgumentPlaceholder: func(int) string {
@@ -162,6 +168,31 @@ func mysqlNOTREGEXPLIKEoperator(expressions ...jet.Serializer) jet.SerializerFun
 	}
 }
 
+func mysqlWITHROLLUP(expressions ...jet.Serializer) jet.SerializerFunc {
+	return func(statement jet.StatementType, out *jet.SQLBuilder, options ...jet.SerializeOption) {
+		if len(expressions) == 0 {
+			panic("jet: ROLLUP requires at least one grouping expression")
+		}
+
+		for i, expression := range expressions {
+			if i > 0 {
+				out.WriteString(", ")
+			}
+			jet.Serialize(expression, statement, out, options...)
+		}
+
+		out.WriteString("WITH ROLLUP")
+	}
+}
+
+func mysqlUnsupportedGroupingElement(name string) jet.SerializeOverride {
+	return func(expressions ...jet.Serializer) jet.SerializerFunc {
+		return func(statement jet.StatementType, out *jet.SQLBuilder, options ...jet.SerializeOption) {
+			panic("jet: " + name + " is not supported by MySQL")
+		}
+	}
+}
+
 var reservedWords = []string{
 	"ACCESSIBLE",
 	"ADD",
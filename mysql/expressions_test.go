@@ -60,3 +60,11 @@ func TestRawType(t *testing.T) {
 	assertSerialize(t, RawDate("table.colDate").EQ(DateT(time)),
 		"((table.colDate) = CAST(? AS DATE))", time)
 }
+
+func TestJsonColumn(t *testing.T) {
+	var jsonColumn ColumnJson = JsonColumn("col_json")
+
+	assertSerialize(t, jsonColumn.EQ(String(`{"a": 1}`)), "(col_json = ?)", `{"a": 1}`)
+	assertSerialize(t, JsonExp(Func("JSON_EXTRACT", jsonColumn, String("$.a"))).LIKE(String("%b%")),
+		"(JSON_EXTRACT(col_json, ?) LIKE ?)", "$.a", "%b%")
+}
@@ -73,6 +73,9 @@ var BIT_AND = jet.BIT_AND
 // BIT_OR is aggregate function used to calculates the bitwise OR of all non-null input values, or null if none.
 var BIT_OR = jet.BIT_OR
 
+// GROUPING returns a bit mask indicating which GROUP BY expressions are not included in the current grouping set.
+var GROUPING = jet.GROUPING
+
 // COUNT is aggregate function. Returns number of input rows for which the value of expression is not null.
 var COUNT = jet.COUNT
 
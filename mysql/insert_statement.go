@@ -12,6 +12,7 @@ type InsertStatement interface {
 	VALUES(value interface{}, values ...interface{}) InsertStatement
 	// Insert row of values, where value for each column is extracted from filed of structure data.
 	// If data is not struct or there is no field for every column selected, this method will panic.
+	// Data wrapped with DefaultOnZero inserts DEFAULT for zero-valued fields of the defaultable columns.
 	MODEL(data interface{}) InsertStatement
 	MODELS(data interface{}) InsertStatement
 	AS_NEW() InsertStatement
@@ -21,6 +22,12 @@ type InsertStatement interface {
 	QUERY(selectStatement SelectStatement) InsertStatement
 }
 
+// DefaultOnZero wraps MODEL or MODELS data, so that DEFAULT is inserted instead of zero values of the
+// defaultable columns fields. For instance:
+//
+//	Film.INSERT(Film.AllColumns).MODEL(DefaultOnZero(film, Film.DefaultableColumns))
+var DefaultOnZero = jet.DefaultOnZero
+
 func newInsertStatement(table Table, columns []jet.Column) InsertStatement {
 	newInsert := &insertStatementImpl{}
 	newInsert.SerializerStatement = jet.NewStatementImpl(Dialect, jet.InsertStatementType, newInsert,
@@ -91,6 +91,24 @@ VALUES (?, ?),
 	assertStatementSql(t, stmt, expectedSQL, int(1), float64(1.11), int(1), float64(1.11))
 }
 
+func TestInsertValuesFromModelDefaultOnZero(t *testing.T) {
+	type Table1Model struct {
+		Col1     *int
+		ColFloat float64
+	}
+
+	one := 1
+
+	stmt := table1.INSERT(table1Col1, table1ColFloat).
+		MODELS(DefaultOnZero([]Table1Model{{}, {Col1: &one, ColFloat: 1.11}}, table1Col1, table1ColFloat))
+
+	assertStatementSql(t, stmt, `
+INSERT INTO db.table1 (col1, col_float)
+VALUES (DEFAULT, DEFAULT),
+       (?, ?);
+`, int(1), float64(1.11))
+}
+
 func TestInsertValuesFromModelColumnMismatch(t *testing.T) {
 	defer func() {
 		r := recover()
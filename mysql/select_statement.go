@@ -34,6 +34,10 @@ func FOLLOWING(offset interface{}) jet.FrameExtent {
 // Window is used to specify window reference from WINDOW clause
 var Window = jet.WindowName
 
+// ROLLUP grouping element is serialized as GROUP BY list followed by WITH ROLLUP modifier.
+// Because MySQL applies WITH ROLLUP to the whole GROUP BY list, ROLLUP has to be the only GROUP_BY clause.
+var ROLLUP = jet.ROLLUP
+
 // SelectStatement is interface for MySQL SELECT statement
 type SelectStatement interface {
 	Statement
@@ -102,7 +106,7 @@ type selectStatementImpl struct {
 	Select    jet.ClauseSelect
 	From      jet.ClauseFrom
 	Where     jet.ClauseWhere
-	GroupBy   jet.ClauseGroupBy
+	GroupBy   clauseGroupBy
 	Having    jet.ClauseHaving
 	Window    jet.ClauseWindow
 	OrderBy   jet.ClauseOrderBy
@@ -191,6 +195,22 @@ func (w windowExpand) AS(window ...jet.Window) SelectStatement {
 	return w.selectStatement
 }
 
+// clauseGroupBy is GROUP BY clause with ROLLUP placement check, because MySQL WITH ROLLUP modifier applies to the
+// whole GROUP BY list
+type clauseGroupBy struct {
+	jet.ClauseGroupBy
+}
+
+func (c *clauseGroupBy) Serialize(statementType jet.StatementType, out *jet.SQLBuilder, options ...jet.SerializeOption) {
+	for _, clause := range c.List {
+		if len(c.List) > 1 && jet.GroupingElementName(clause) == jet.GroupByRollup {
+			panic("jet: ROLLUP has to be the only GROUP BY clause in MySQL")
+		}
+	}
+
+	c.ClauseGroupBy.Serialize(statementType, out, options...)
+}
+
 func toJetFrameOffset(offset interface{}) jet.Serializer {
 	if offset == UNBOUNDED {
 		return jet.UNBOUNDED
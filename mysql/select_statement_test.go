@@ -1,6 +1,7 @@
 package mysql
 
 import (
+	"github.com/go-jet/jet/v2/internal/jet"
 	"github.com/go-jet/jet/v2/internal/testutils"
 	"testing"
 )
@@ -77,6 +78,28 @@ GROUP BY table2.col_float;
 `)
 }
 
+func TestSelectGroupByRollup(t *testing.T) {
+	assertStatementSql(t,
+		SELECT(table2ColInt, GROUPING(table2ColInt)).
+			FROM(table2).
+			GROUP_BY(ROLLUP(table2ColInt, table2ColFloat)), `
+SELECT table2.col_int AS "table2.col_int",
+     GROUPING(table2.col_int)
+FROM db.table2
+GROUP BY table2.col_int, table2.col_float WITH ROLLUP;
+`)
+	assertStatementSqlErr(t, SELECT(table2ColInt).FROM(table2).GROUP_BY(table2ColInt, ROLLUP(table2ColFloat)),
+		"jet: ROLLUP has to be the only GROUP BY clause in MySQL")
+	assertStatementSqlErr(t, SELECT(table2ColInt).FROM(table2).GROUP_BY(ROLLUP(table2ColInt), table2ColFloat),
+		"jet: ROLLUP has to be the only GROUP BY clause in MySQL")
+	assertStatementSqlErr(t, SELECT(table2ColInt).FROM(table2).GROUP_BY(ROLLUP(table2ColInt), ROLLUP(table2ColFloat)),
+		"jet: ROLLUP has to be the only GROUP BY clause in MySQL")
+	assertStatementSqlErr(t, SELECT(table2ColInt).FROM(table2).GROUP_BY(jet.CUBE(table2ColInt)),
+		"jet: CUBE is not supported by MySQL")
+	assertStatementSqlErr(t, SELECT(table2ColInt).FROM(table2).GROUP_BY(jet.GROUPING_SETS(table2ColInt)),
+		"jet: GROUPING SETS is not supported by MySQL")
+}
+
 func TestSelectHaving(t *testing.T) {
 	assertStatementSql(t, SELECT(table3ColInt).FROM(table3).HAVING(table1ColBool.EQ(Bool(true))), `
 SELECT table3.col_int AS "table3.col_int"
@@ -0,0 +1,84 @@
+// Package null provides generic nullable type, that can be used as model field type for nullable columns instead of pointer.
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+)
+
+// Null represents value of type T that may be NULL. Zero value of Null is NULL.
+// Null does not implement sql.Scanner, QRM assigns query result values directly into V field, and marks Null as Valid.
+// Null implements driver.Valuer, so it can be used as statement parameter and as model field in INSERT and UPDATE statements.
+type Null[T any] struct {
+	V     T
+	Valid bool // Valid is true if V is not NULL
+}
+
+// From returns valid (not NULL) Null containing value
+func From[T any](value T) Null[T] {
+	return Null[T]{V: value, Valid: true}
+}
+
+// FromPtr returns Null containing value pointed by ptr, or NULL if ptr is nil
+func FromPtr[T any](ptr *T) Null[T] {
+	if ptr == nil {
+		return Null[T]{}
+	}
+
+	return From(*ptr)
+}
+
+// Ptr returns pointer to the copy of V, or nil if n is NULL
+func (n Null[T]) Ptr() *T {
+	if !n.Valid {
+		return nil
+	}
+
+	value := n.V
+
+	return &value
+}
+
+// ValueOr returns V, or defaultValue if n is NULL
+func (n Null[T]) ValueOr(defaultValue T) T {
+	if !n.Valid {
+		return defaultValue
+	}
+
+	return n.V
+}
+
+// Value implements the driver.Valuer interface.
+func (n Null[T]) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+
+	return driver.DefaultParameterConverter.ConvertValue(n.V)
+}
+
+// MarshalJSON implements the json.Marshaler interface. NULL is encoded as JSON null.
+func (n Null[T]) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(n.V)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. JSON null is decoded as NULL.
+func (n *Null[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		*n = Null[T]{}
+		return nil
+	}
+
+	if err := json.Unmarshal(data, &n.V); err != nil {
+		return err
+	}
+
+	n.Valid = true
+
+	return nil
+}
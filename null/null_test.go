@@ -0,0 +1,65 @@
+package null
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNull_Value(t *testing.T) {
+	value, err := Null[int32]{}.Value()
+	require.NoError(t, err)
+	require.Nil(t, value)
+
+	value, err = From(int32(11)).Value()
+	require.NoError(t, err)
+	require.Equal(t, int64(11), value)
+
+	type Mood string
+
+	value, err = From(Mood("happy")).Value()
+	require.NoError(t, err)
+	require.Equal(t, "happy", value)
+
+	id := uuid.MustParse("a8f3dd9e-2b0c-4c5d-9d3e-1b2b7b6a1f00")
+	value, err = From(id).Value()
+	require.NoError(t, err)
+	require.Equal(t, id.String(), value)
+
+	timestamp := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	value, err = From(timestamp).Value()
+	require.NoError(t, err)
+	require.Equal(t, timestamp, value)
+}
+
+func TestNull_Ptr(t *testing.T) {
+	require.Nil(t, Null[string]{}.Ptr())
+	require.Equal(t, "text", *From("text").Ptr())
+
+	require.Equal(t, Null[string]{}, FromPtr[string](nil))
+	text := "text"
+	require.Equal(t, From("text"), FromPtr(&text))
+
+	require.Equal(t, "default", Null[string]{}.ValueOr("default"))
+	require.Equal(t, "text", From("text").ValueOr("default"))
+}
+
+func TestNull_JSON(t *testing.T) {
+	type Model struct {
+		Name  Null[string]
+		Count Null[int64]
+	}
+
+	data, err := json.Marshal(Model{Name: From("jet")})
+	require.NoError(t, err)
+	require.Equal(t, `{"Name":"jet","Count":null}`, string(data))
+
+	var model Model
+	require.NoError(t, json.Unmarshal([]byte(`{"Name":null,"Count":5}`), &model))
+	require.Equal(t, Model{Count: From(int64(5))}, model)
+
+	require.Error(t, json.Unmarshal([]byte(`{"Count":"five"}`), &model))
+}
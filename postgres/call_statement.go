@@ -0,0 +1,25 @@
+package postgres
+
+import "github.com/go-jet/jet/v2/internal/jet"
+
+// CallStatement is interface for CALL statement, used to invoke stored procedures
+type CallStatement interface {
+	Statement
+}
+
+// CALL creates new CallStatement, invoking stored procedure with the list of arguments
+func CALL(procedure string, arguments ...Expression) CallStatement {
+	newCall := &callStatementImpl{}
+	newCall.SerializerStatement = jet.NewStatementImpl(Dialect, jet.CallStatementType, newCall, &newCall.Call)
+
+	newCall.Call.Procedure = procedure
+	newCall.Call.Arguments = arguments
+
+	return newCall
+}
+
+type callStatementImpl struct {
+	jet.SerializerStatement
+
+	Call jet.ClauseCall
+}
@@ -0,0 +1,15 @@
+package postgres
+
+import (
+	"testing"
+)
+
+func TestCall(t *testing.T) {
+	assertStatementSql(t, CALL("db.refresh_stats"), `
+CALL db.refresh_stats();
+`)
+	assertStatementSql(t, CALL("db.transfer", Int(11), table1ColInt, String("note")), `
+CALL db.transfer($1, table1.col_int, $2::text);
+`, int64(11), "note")
+	assertStatementSqlErr(t, CALL(""), "jet: procedure name is not set for CALL statement")
+}
@@ -69,3 +69,10 @@ func TestExpressionCAST_AS_INTERVAL(t *testing.T) {
 	assertSerialize(t, table2ColDate.SUB(CAST(Time(20, 11, 10)).AS_INTERVAL()),
 		"(table2.col_date - $1::time without time zone::interval)", "20:11:10")
 }
+
+func TestExpressionCAST_AS_JSON(t *testing.T) {
+	assertSerialize(t, CAST(table2ColStr).AS_JSON(), "table2.col_str::json")
+	assertSerialize(t, CAST(table2ColStr).AS_JSONB().GET_PATH("a", "b"), `(table2.col_str::jsonb #> $1::text[])`, `{"a","b"}`)
+	assertSerialize(t, CAST(table2ColStr).AS_TSVECTOR(), `table2.col_str::tsvector`)
+	assertSerialize(t, CAST(table2ColStr).AS_TSQUERY(), `table2.col_str::tsquery`)
+}
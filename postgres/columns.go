@@ -65,6 +65,66 @@ type ColumnTimestampz = jet.ColumnTimestampz
 // TimestampzColumn creates named timestamp with time zone column.
 var TimestampzColumn = jet.TimestampzColumn
 
+// ColumnJson is interface of SQL json and jsonb columns.
+type ColumnJson = jet.ColumnJson
+
+// JsonColumn creates named json column.
+var JsonColumn = jet.JsonColumn
+
+// ColumnBoolArray is interface of SQL bool array columns.
+type ColumnBoolArray = jet.ColumnBoolArray
+
+// BoolArrayColumn creates named bool array column.
+var BoolArrayColumn = jet.BoolArrayColumn
+
+// ColumnIntegerArray is interface of SQL integer array columns.
+type ColumnIntegerArray = jet.ColumnIntegerArray
+
+// IntegerArrayColumn creates named integer array column.
+var IntegerArrayColumn = jet.IntegerArrayColumn
+
+// ColumnFloatArray is interface of SQL float array columns.
+type ColumnFloatArray = jet.ColumnFloatArray
+
+// FloatArrayColumn creates named float array column.
+var FloatArrayColumn = jet.FloatArrayColumn
+
+// ColumnStringArray is interface of SQL string array columns.
+type ColumnStringArray = jet.ColumnStringArray
+
+// StringArrayColumn creates named string array column.
+var StringArrayColumn = jet.StringArrayColumn
+
+// ColumnDateArray is interface of SQL date array columns.
+type ColumnDateArray = jet.ColumnDateArray
+
+// DateArrayColumn creates named date array column.
+var DateArrayColumn = jet.DateArrayColumn
+
+// ColumnTimeArray is interface of SQL time array columns.
+type ColumnTimeArray = jet.ColumnTimeArray
+
+// TimeArrayColumn creates named time array column.
+var TimeArrayColumn = jet.TimeArrayColumn
+
+// ColumnTimezArray is interface of SQL timez array columns.
+type ColumnTimezArray = jet.ColumnTimezArray
+
+// TimezArrayColumn creates named timez array column.
+var TimezArrayColumn = jet.TimezArrayColumn
+
+// ColumnTimestampArray is interface of SQL timestamp array columns.
+type ColumnTimestampArray = jet.ColumnTimestampArray
+
+// TimestampArrayColumn creates named timestamp array column.
+var TimestampArrayColumn = jet.TimestampArrayColumn
+
+// ColumnTimestampzArray is interface of SQL timestampz array columns.
+type ColumnTimestampzArray = jet.ColumnTimestampzArray
+
+// TimestampzArrayColumn creates named timestampz array column.
+var TimestampzArrayColumn = jet.TimestampzArrayColumn
+
 //------------------------------------------------------//
 
 // ColumnInterval is interface of PostgreSQL interval columns.
@@ -95,3 +155,255 @@ func IntervalColumn(name string) ColumnInterval {
 	intervalColumn.intervalInterfaceImpl.parent = intervalColumn
 	return intervalColumn
 }
+
+//------------------------------------------------------//
+
+// ColumnTsVector is interface of PostgreSQL tsvector columns.
+type ColumnTsVector interface {
+	TsVectorExpression
+	jet.Column
+
+	From(subQuery SelectTable) ColumnTsVector
+	SET(tsVectorExp TsVectorExpression) ColumnAssigment
+}
+
+type tsVectorColumnImpl struct {
+	jet.ColumnExpressionImpl
+	tsVectorInterfaceImpl
+}
+
+func (i *tsVectorColumnImpl) From(subQuery SelectTable) ColumnTsVector {
+	newTsVectorColumn := TsVectorColumn(i.Name())
+	jet.SetTableName(newTsVectorColumn, i.TableName())
+	jet.SetSubQuery(newTsVectorColumn, subQuery)
+
+	return newTsVectorColumn
+}
+
+func (i *tsVectorColumnImpl) SET(tsVectorExp TsVectorExpression) ColumnAssigment {
+	return jet.NewColumnAssigment(i, tsVectorExp)
+}
+
+// TsVectorColumn creates named tsvector column.
+func TsVectorColumn(name string) ColumnTsVector {
+	tsVectorColumn := &tsVectorColumnImpl{}
+	tsVectorColumn.ColumnExpressionImpl = jet.NewColumnImpl(name, "", tsVectorColumn)
+	tsVectorColumn.tsVectorInterfaceImpl.parent = tsVectorColumn
+	return tsVectorColumn
+}
+
+//------------------------------------------------------//
+
+// ColumnTsQuery is interface of PostgreSQL tsquery columns.
+type ColumnTsQuery interface {
+	TsQueryExpression
+	jet.Column
+
+	From(subQuery SelectTable) ColumnTsQuery
+	SET(tsQueryExp TsQueryExpression) ColumnAssigment
+}
+
+type tsQueryColumnImpl struct {
+	jet.ColumnExpressionImpl
+	tsQueryInterfaceImpl
+}
+
+func (i *tsQueryColumnImpl) From(subQuery SelectTable) ColumnTsQuery {
+	newTsQueryColumn := TsQueryColumn(i.Name())
+	jet.SetTableName(newTsQueryColumn, i.TableName())
+	jet.SetSubQuery(newTsQueryColumn, subQuery)
+
+	return newTsQueryColumn
+}
+
+func (i *tsQueryColumnImpl) SET(tsQueryExp TsQueryExpression) ColumnAssigment {
+	return jet.NewColumnAssigment(i, tsQueryExp)
+}
+
+// TsQueryColumn creates named tsquery column.
+func TsQueryColumn(name string) ColumnTsQuery {
+	tsQueryColumn := &tsQueryColumnImpl{}
+	tsQueryColumn.ColumnExpressionImpl = jet.NewColumnImpl(name, "", tsQueryColumn)
+	tsQueryColumn.tsQueryInterfaceImpl.parent = tsQueryColumn
+	return tsQueryColumn
+}
+
+//------------------------------------------------------//
+
+// ColumnIntegerRange is interface of PostgreSQL int4range, int8range, int4multirange and int8multirange columns.
+type ColumnIntegerRange interface {
+	IntegerRangeExpression
+	jet.Column
+
+	From(subQuery SelectTable) ColumnIntegerRange
+	SET(rangeExp IntegerRangeExpression) ColumnAssigment
+}
+
+type integerRangeColumnImpl struct {
+	jet.ColumnExpressionImpl
+	integerRangeInterfaceImpl
+}
+
+func (i *integerRangeColumnImpl) From(subQuery SelectTable) ColumnIntegerRange {
+	newIntegerRangeColumn := IntegerRangeColumn(i.Name())
+	jet.SetTableName(newIntegerRangeColumn, i.TableName())
+	jet.SetSubQuery(newIntegerRangeColumn, subQuery)
+
+	return newIntegerRangeColumn
+}
+
+func (i *integerRangeColumnImpl) SET(rangeExp IntegerRangeExpression) ColumnAssigment {
+	return jet.NewColumnAssigment(i, rangeExp)
+}
+
+// IntegerRangeColumn creates named integer range column.
+func IntegerRangeColumn(name string) ColumnIntegerRange {
+	integerRangeColumn := &integerRangeColumnImpl{}
+	integerRangeColumn.ColumnExpressionImpl = jet.NewColumnImpl(name, "", integerRangeColumn)
+	integerRangeColumn.integerRangeInterfaceImpl.parent = integerRangeColumn
+	return integerRangeColumn
+}
+
+//------------------------------------------------------//
+
+// ColumnFloatRange is interface of PostgreSQL numrange and nummultirange columns.
+type ColumnFloatRange interface {
+	FloatRangeExpression
+	jet.Column
+
+	From(subQuery SelectTable) ColumnFloatRange
+	SET(rangeExp FloatRangeExpression) ColumnAssigment
+}
+
+type floatRangeColumnImpl struct {
+	jet.ColumnExpressionImpl
+	floatRangeInterfaceImpl
+}
+
+func (i *floatRangeColumnImpl) From(subQuery SelectTable) ColumnFloatRange {
+	newFloatRangeColumn := FloatRangeColumn(i.Name())
+	jet.SetTableName(newFloatRangeColumn, i.TableName())
+	jet.SetSubQuery(newFloatRangeColumn, subQuery)
+
+	return newFloatRangeColumn
+}
+
+func (i *floatRangeColumnImpl) SET(rangeExp FloatRangeExpression) ColumnAssigment {
+	return jet.NewColumnAssigment(i, rangeExp)
+}
+
+// FloatRangeColumn creates named float range column.
+func FloatRangeColumn(name string) ColumnFloatRange {
+	floatRangeColumn := &floatRangeColumnImpl{}
+	floatRangeColumn.ColumnExpressionImpl = jet.NewColumnImpl(name, "", floatRangeColumn)
+	floatRangeColumn.floatRangeInterfaceImpl.parent = floatRangeColumn
+	return floatRangeColumn
+}
+
+//------------------------------------------------------//
+
+// ColumnDateRange is interface of PostgreSQL daterange and datemultirange columns.
+type ColumnDateRange interface {
+	DateRangeExpression
+	jet.Column
+
+	From(subQuery SelectTable) ColumnDateRange
+	SET(rangeExp DateRangeExpression) ColumnAssigment
+}
+
+type dateRangeColumnImpl struct {
+	jet.ColumnExpressionImpl
+	dateRangeInterfaceImpl
+}
+
+func (i *dateRangeColumnImpl) From(subQuery SelectTable) ColumnDateRange {
+	newDateRangeColumn := DateRangeColumn(i.Name())
+	jet.SetTableName(newDateRangeColumn, i.TableName())
+	jet.SetSubQuery(newDateRangeColumn, subQuery)
+
+	return newDateRangeColumn
+}
+
+func (i *dateRangeColumnImpl) SET(rangeExp DateRangeExpression) ColumnAssigment {
+	return jet.NewColumnAssigment(i, rangeExp)
+}
+
+// DateRangeColumn creates named date range column.
+func DateRangeColumn(name string) ColumnDateRange {
+	dateRangeColumn := &dateRangeColumnImpl{}
+	dateRangeColumn.ColumnExpressionImpl = jet.NewColumnImpl(name, "", dateRangeColumn)
+	dateRangeColumn.dateRangeInterfaceImpl.parent = dateRangeColumn
+	return dateRangeColumn
+}
+
+//------------------------------------------------------//
+
+// ColumnTimestampRange is interface of PostgreSQL tsrange and tsmultirange columns.
+type ColumnTimestampRange interface {
+	TimestampRangeExpression
+	jet.Column
+
+	From(subQuery SelectTable) ColumnTimestampRange
+	SET(rangeExp TimestampRangeExpression) ColumnAssigment
+}
+
+type timestampRangeColumnImpl struct {
+	jet.ColumnExpressionImpl
+	timestampRangeInterfaceImpl
+}
+
+func (i *timestampRangeColumnImpl) From(subQuery SelectTable) ColumnTimestampRange {
+	newTimestampRangeColumn := TimestampRangeColumn(i.Name())
+	jet.SetTableName(newTimestampRangeColumn, i.TableName())
+	jet.SetSubQuery(newTimestampRangeColumn, subQuery)
+
+	return newTimestampRangeColumn
+}
+
+func (i *timestampRangeColumnImpl) SET(rangeExp TimestampRangeExpression) ColumnAssigment {
+	return jet.NewColumnAssigment(i, rangeExp)
+}
+
+// TimestampRangeColumn creates named timestamp range column.
+func TimestampRangeColumn(name string) ColumnTimestampRange {
+	timestampRangeColumn := &timestampRangeColumnImpl{}
+	timestampRangeColumn.ColumnExpressionImpl = jet.NewColumnImpl(name, "", timestampRangeColumn)
+	timestampRangeColumn.timestampRangeInterfaceImpl.parent = timestampRangeColumn
+	return timestampRangeColumn
+}
+
+//------------------------------------------------------//
+
+// ColumnTimestampzRange is interface of PostgreSQL tstzrange and tstzmultirange columns.
+type ColumnTimestampzRange interface {
+	TimestampzRangeExpression
+	jet.Column
+
+	From(subQuery SelectTable) ColumnTimestampzRange
+	SET(rangeExp TimestampzRangeExpression) ColumnAssigment
+}
+
+type timestampzRangeColumnImpl struct {
+	jet.ColumnExpressionImpl
+	timestampzRangeInterfaceImpl
+}
+
+func (i *timestampzRangeColumnImpl) From(subQuery SelectTable) ColumnTimestampzRange {
+	newTimestampzRangeColumn := TimestampzRangeColumn(i.Name())
+	jet.SetTableName(newTimestampzRangeColumn, i.TableName())
+	jet.SetSubQuery(newTimestampzRangeColumn, subQuery)
+
+	return newTimestampzRangeColumn
+}
+
+func (i *timestampzRangeColumnImpl) SET(rangeExp TimestampzRangeExpression) ColumnAssigment {
+	return jet.NewColumnAssigment(i, rangeExp)
+}
+
+// TimestampzRangeColumn creates named timestampz range column.
+func TimestampzRangeColumn(name string) ColumnTimestampzRange {
+	timestampzRangeColumn := &timestampzRangeColumnImpl{}
+	timestampzRangeColumn.ColumnExpressionImpl = jet.NewColumnImpl(name, "", timestampzRangeColumn)
+	timestampzRangeColumn.timestampzRangeInterfaceImpl.parent = timestampzRangeColumn
+	return timestampzRangeColumn
+}
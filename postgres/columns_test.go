@@ -18,3 +18,12 @@ func TestNewIntervalColumn(t *testing.T) {
 	assertSerialize(t, subQueryIntervalColumn2.EQ(INTERVAL(1, DAY)), `(sub_query."table1.col_interval" = INTERVAL '1 DAY')`)
 	assertProjectionSerialize(t, subQueryIntervalColumn2, `sub_query."table1.col_interval" AS "table1.col_interval"`)
 }
+
+func TestNewJsonColumn(t *testing.T) {
+	subQuery := SELECT(Int(1)).AsTable("sub_query")
+
+	subQueryJsonColumn := table3ColJson.From(subQuery)
+	assertSerialize(t, subQueryJsonColumn, `sub_query."table3.col_json"`)
+	assertSerialize(t, subQueryJsonColumn.GET(String("key")), `(sub_query."table3.col_json" -> $1::text)`, "key")
+	assertProjectionSerialize(t, subQueryJsonColumn, `sub_query."table3.col_json" AS "table3.col_json"`)
+}
@@ -36,6 +36,39 @@ type TimestampExpression = jet.TimestampExpression
 // TimestampzExpression interface
 type TimestampzExpression = jet.TimestampzExpression
 
+// JsonExpression interface for json and jsonb types
+type JsonExpression = jet.JsonExpression
+
+// ArrayExpression is common interface for all array expressions
+type ArrayExpression = jet.ArrayExpression
+
+// BoolArrayExpression interface for bool arrays
+type BoolArrayExpression = jet.BoolArrayExpression
+
+// IntegerArrayExpression interface for integer arrays
+type IntegerArrayExpression = jet.IntegerArrayExpression
+
+// FloatArrayExpression interface for float arrays
+type FloatArrayExpression = jet.FloatArrayExpression
+
+// StringArrayExpression interface for string arrays
+type StringArrayExpression = jet.StringArrayExpression
+
+// DateArrayExpression interface for date arrays
+type DateArrayExpression = jet.DateArrayExpression
+
+// TimeArrayExpression interface for time arrays
+type TimeArrayExpression = jet.TimeArrayExpression
+
+// TimezArrayExpression interface for timez arrays
+type TimezArrayExpression = jet.TimezArrayExpression
+
+// TimestampArrayExpression interface for timestamp arrays
+type TimestampArrayExpression = jet.TimestampArrayExpression
+
+// TimestampzArrayExpression interface for timestampz arrays
+type TimestampzArrayExpression = jet.TimestampzArrayExpression
+
 // BoolExp is bool expression wrapper around arbitrary expression.
 // Allows go compiler to see any expression as bool expression.
 // Does not add sql cast to generated sql builder output.
@@ -81,6 +114,61 @@ var TimestampExp = jet.TimestampExp
 // Does not add sql cast to generated sql builder output.
 var TimestampzExp = jet.TimestampzExp
 
+// JsonExp is json expression wrapper around arbitrary expression.
+// Allows go compiler to see any expression as json expression.
+// Does not add sql cast to generated sql builder output.
+var JsonExp = jet.JsonExp
+
+// ArrayExp is array expression wrapper around arbitrary expression.
+// Allows go compiler to see any expression as array expression.
+// Does not add sql cast to generated sql builder output.
+var ArrayExp = jet.ArrayExp
+
+// BoolArrayExp is bool array expression wrapper around arbitrary expression.
+// Allows go compiler to see any expression as bool array expression.
+// Does not add sql cast to generated sql builder output.
+var BoolArrayExp = jet.BoolArrayExp
+
+// IntegerArrayExp is integer array expression wrapper around arbitrary expression.
+// Allows go compiler to see any expression as integer array expression.
+// Does not add sql cast to generated sql builder output.
+var IntegerArrayExp = jet.IntegerArrayExp
+
+// FloatArrayExp is float array expression wrapper around arbitrary expression.
+// Allows go compiler to see any expression as float array expression.
+// Does not add sql cast to generated sql builder output.
+var FloatArrayExp = jet.FloatArrayExp
+
+// StringArrayExp is string array expression wrapper around arbitrary expression.
+// Allows go compiler to see any expression as string array expression.
+// Does not add sql cast to generated sql builder output.
+var StringArrayExp = jet.StringArrayExp
+
+// DateArrayExp is date array expression wrapper around arbitrary expression.
+// Allows go compiler to see any expression as date array expression.
+// Does not add sql cast to generated sql builder output.
+var DateArrayExp = jet.DateArrayExp
+
+// TimeArrayExp is time array expression wrapper around arbitrary expression.
+// Allows go compiler to see any expression as time array expression.
+// Does not add sql cast to generated sql builder output.
+var TimeArrayExp = jet.TimeArrayExp
+
+// TimezArrayExp is timez array expression wrapper around arbitrary expression.
+// Allows go compiler to see any expression as timez array expression.
+// Does not add sql cast to generated sql builder output.
+var TimezArrayExp = jet.TimezArrayExp
+
+// TimestampArrayExp is timestamp array expression wrapper around arbitrary expression.
+// Allows go compiler to see any expression as timestamp array expression.
+// Does not add sql cast to generated sql builder output.
+var TimestampArrayExp = jet.TimestampArrayExp
+
+// TimestampzArrayExp is timestampz array expression wrapper around arbitrary expression.
+// Allows go compiler to see any expression as timestampz array expression.
+// Does not add sql cast to generated sql builder output.
+var TimestampzArrayExp = jet.TimestampzArrayExp
+
 // RawArgs is type used to pass optional arguments to Raw method
 type RawArgs = map[string]interface{}
 
@@ -98,6 +186,7 @@ var (
 	RawTimestamp  = jet.RawTimestamp
 	RawTimestampz = jet.RawTimestampz
 	RawDate       = jet.RawDate
+	RawJson       = jet.RawJson
 )
 
 // Func can be used to call custom or unsupported database functions.
@@ -74,6 +74,9 @@ var BOOL_AND = jet.BOOL_AND
 // BOOL_OR is aggregate function. Returns true if at least one input value is true, otherwise false
 var BOOL_OR = jet.BOOL_OR
 
+// GROUPING returns a bit mask indicating which GROUP BY expressions are not included in the current grouping set.
+var GROUPING = jet.GROUPING
+
 // COUNT is aggregate function. Returns number of input rows for which the value of expression is not null.
 var COUNT = jet.COUNT
 
@@ -320,6 +323,327 @@ var LOCALTIMESTAMP = jet.LOCALTIMESTAMP
 // NOW returns current date and time
 var NOW = jet.NOW
 
+// ----------------------- JSON Functions ----------------------//
+
+// TO_JSON returns the value as json
+func TO_JSON(expression Expression) JsonExpression {
+	return jet.TO_JSON(explicitLiteralCast(expression))
+}
+
+// TO_JSONB returns the value as jsonb
+func TO_JSONB(expression Expression) JsonExpression {
+	return jet.TO_JSONB(explicitLiteralCast(expression))
+}
+
+// JSON_BUILD_OBJECT builds a json object out of a variadic argument list of alternating keys and values
+//
+//	JSON_BUILD_OBJECT(String("id"), Film.FilmID, String("title"), Film.Title)
+func JSON_BUILD_OBJECT(keyValues ...Expression) JsonExpression {
+	return jet.JSON_BUILD_OBJECT(explicitLiteralCasts(keyValues...)...)
+}
+
+// JSONB_BUILD_OBJECT builds a jsonb object out of a variadic argument list of alternating keys and values
+//
+//	JSONB_BUILD_OBJECT(String("id"), Film.FilmID, String("title"), Film.Title)
+func JSONB_BUILD_OBJECT(keyValues ...Expression) JsonExpression {
+	return jet.JSONB_BUILD_OBJECT(explicitLiteralCasts(keyValues...)...)
+}
+
+// JSON_AGG is aggregate function. Collects all the input values, including nulls, into a json array.
+func JSON_AGG(expression Expression) JsonExpression {
+	return jet.JSON_AGG(explicitLiteralCast(expression))
+}
+
+// JSONB_AGG is aggregate function. Collects all the input values, including nulls, into a jsonb array.
+func JSONB_AGG(expression Expression) JsonExpression {
+	return jet.JSONB_AGG(explicitLiteralCast(expression))
+}
+
+// JSONB_SET returns target with the item designated by path replaced by newValue, or with newValue added
+// if createMissing is true (default is true) and the item designated by path does not exist.
+//
+//	JSONB_SET(Customer.Info, []string{"address", "city"}, Jsonb(`"Berlin"`))
+var JSONB_SET = jet.JSONB_SET
+
+// JSONB_PATH_QUERY returns all json items returned by the json path for the specified json value.
+// Optional vars argument is json object used to substitute named variables in the path expression.
+//
+//	JSONB_PATH_QUERY(Customer.Info, "$.phones[*] ? (@.type == $type)", Jsonb(`{"type": "mobile"}`))
+var JSONB_PATH_QUERY = jet.JSONB_PATH_QUERY
+
+// ----------------------- Array Functions ----------------------//
+
+// BoolArray creates ARRAY[...] constructor from the list of bool expressions
+var BoolArray = jet.BoolArray
+
+// IntegerArray creates ARRAY[...] constructor from the list of integer expressions
+//
+//	IntegerArray(Int(1), Int(2), Film.Length)
+func IntegerArray(elems ...IntegerExpression) IntegerArrayExpression {
+	var castedElems []IntegerExpression
+
+	for _, elem := range elems {
+		castedElems = append(castedElems, castIntegerLiteral(elem))
+	}
+
+	return jet.IntegerArray(castedElems...)
+}
+
+// FloatArray creates ARRAY[...] constructor from the list of float expressions
+func FloatArray(elems ...FloatExpression) FloatArrayExpression {
+	var castedElems []FloatExpression
+
+	for _, elem := range elems {
+		castedElems = append(castedElems, castFloatLiteral(elem))
+	}
+
+	return jet.FloatArray(castedElems...)
+}
+
+// StringArray creates ARRAY[...] constructor from the list of string expressions
+//
+//	StringArray(String("drama"), String("comedy"))
+var StringArray = jet.StringArray
+
+// DateArray creates ARRAY[...] constructor from the list of date expressions
+var DateArray = jet.DateArray
+
+// TimeArray creates ARRAY[...] constructor from the list of time expressions
+var TimeArray = jet.TimeArray
+
+// TimezArray creates ARRAY[...] constructor from the list of time with time zone expressions
+var TimezArray = jet.TimezArray
+
+// TimestampArray creates ARRAY[...] constructor from the list of timestamp expressions
+var TimestampArray = jet.TimestampArray
+
+// TimestampzArray creates ARRAY[...] constructor from the list of timestamp with time zone expressions
+var TimestampzArray = jet.TimestampzArray
+
+// ARRAY_AGG is aggregate function. Collects all the input values, including nulls, into an array.
+func ARRAY_AGG(expression Expression) ArrayExpression {
+	return jet.ARRAY_AGG(explicitLiteralCast(expression))
+}
+
+// UNNEST expands an array into a set of rows
+var UNNEST = jet.UNNEST
+
+// CARDINALITY returns the total number of elements in the array, or 0 if the array is empty
+var CARDINALITY = jet.CARDINALITY
+
+// ARRAY_LENGTH returns the length of the requested array dimension
+var ARRAY_LENGTH = jet.ARRAY_LENGTH
+
+// -------------------- Text Search Functions --------------------//
+
+// TO_TSVECTOR converts document text to tsvector. Optional config sets text search configuration used
+// for normalization (for instance 'english'), otherwise default_text_search_config is used.
+func TO_TSVECTOR(document StringExpression, config ...string) TsVectorExpression {
+	return TsVectorExp(jet.NewFunc("TO_TSVECTOR", textSearchArgs(config, document), nil))
+}
+
+// TO_TSQUERY converts query text, consisting of tokens separated by tsquery operators, to tsquery.
+// Optional config sets text search configuration.
+func TO_TSQUERY(query StringExpression, config ...string) TsQueryExpression {
+	return TsQueryExp(jet.NewFunc("TO_TSQUERY", textSearchArgs(config, query), nil))
+}
+
+// PLAINTO_TSQUERY converts unformatted query text to tsquery, by inserting & (AND) operator between
+// surviving words. Optional config sets text search configuration.
+func PLAINTO_TSQUERY(query StringExpression, config ...string) TsQueryExpression {
+	return TsQueryExp(jet.NewFunc("PLAINTO_TSQUERY", textSearchArgs(config, query), nil))
+}
+
+// PHRASETO_TSQUERY converts unformatted query text to tsquery, by inserting <-> (FOLLOWED BY) operator between
+// surviving words. Optional config sets text search configuration.
+func PHRASETO_TSQUERY(query StringExpression, config ...string) TsQueryExpression {
+	return TsQueryExp(jet.NewFunc("PHRASETO_TSQUERY", textSearchArgs(config, query), nil))
+}
+
+// WEBSEARCH_TO_TSQUERY converts query text written in web search engine syntax to tsquery.
+// Optional config sets text search configuration.
+func WEBSEARCH_TO_TSQUERY(query StringExpression, config ...string) TsQueryExpression {
+	return TsQueryExp(jet.NewFunc("WEBSEARCH_TO_TSQUERY", textSearchArgs(config, query), nil))
+}
+
+// TS_RANK ranks document vector for query, based on the frequency of matching lexemes.
+// Optional normalization specifies how document length should impact the rank.
+func TS_RANK(vector TsVectorExpression, query TsQueryExpression, normalization ...IntegerExpression) FloatExpression {
+	return jet.NewFloatFunc("TS_RANK", optionalNormalization(normalization, vector, query)...)
+}
+
+// TS_RANK_CD ranks document vector for query, using cover density ranking.
+// Optional normalization specifies how document length should impact the rank.
+func TS_RANK_CD(vector TsVectorExpression, query TsQueryExpression, normalization ...IntegerExpression) FloatExpression {
+	return jet.NewFloatFunc("TS_RANK_CD", optionalNormalization(normalization, vector, query)...)
+}
+
+// TS_HEADLINE displays document excerpt with query matches highlighted. Optional options string
+// configures the output, for instance 'MaxWords=10, MinWords=5'.
+func TS_HEADLINE(document StringExpression, query TsQueryExpression, options ...string) StringExpression {
+	args := []Expression{document, query}
+
+	if len(options) > 0 {
+		args = append(args, String(options[0]))
+	}
+
+	return jet.NewStringFunc("TS_HEADLINE", args...)
+}
+
+func textSearchArgs(config []string, text StringExpression) []Expression {
+	if len(config) > 0 {
+		return []Expression{CAST(jet.String(config[0])).AS("regconfig"), text}
+	}
+
+	return []Expression{text}
+}
+
+func optionalNormalization(normalization []IntegerExpression, vector TsVectorExpression, query TsQueryExpression) []Expression {
+	args := []Expression{vector, query}
+
+	if len(normalization) > 0 {
+		args = append(args, castIntegerLiteral(normalization[0]))
+	}
+
+	return args
+}
+
+// ----------------------- Range Functions ----------------------//
+
+// INT4RANGE constructs int4range from lower and upper bound. Optional bounds argument specifies bounds inclusivity
+// ('[)' by default, '()', '(]' or '[]'). NULL bound means the range is unbounded.
+func INT4RANGE(lower, upper IntegerExpression, bounds ...string) IntegerRangeExpression {
+	return IntegerRangeExp(jet.NewFunc("INT4RANGE", rangeArgs(bounds, castIntegerLiteral(lower), castIntegerLiteral(upper)), nil))
+}
+
+// INT8RANGE constructs int8range from lower and upper bound. Optional bounds argument specifies bounds inclusivity
+// ('[)' by default, '()', '(]' or '[]'). NULL bound means the range is unbounded.
+func INT8RANGE(lower, upper IntegerExpression, bounds ...string) IntegerRangeExpression {
+	return IntegerRangeExp(jet.NewFunc("INT8RANGE", rangeArgs(bounds, castBigintLiteral(lower), castBigintLiteral(upper)), nil))
+}
+
+// NUMRANGE constructs numrange from lower and upper bound. Optional bounds argument specifies bounds inclusivity
+// ('[)' by default, '()', '(]' or '[]'). NULL bound means the range is unbounded.
+func NUMRANGE(lower, upper FloatExpression, bounds ...string) FloatRangeExpression {
+	return FloatRangeExp(jet.NewFunc("NUMRANGE", rangeArgs(bounds, castNumericLiteral(lower), castNumericLiteral(upper)), nil))
+}
+
+// DATERANGE constructs daterange from lower and upper bound. Optional bounds argument specifies bounds inclusivity
+// ('[)' by default, '()', '(]' or '[]'). NULL bound means the range is unbounded.
+func DATERANGE(lower, upper DateExpression, bounds ...string) DateRangeExpression {
+	return DateRangeExp(jet.NewFunc("DATERANGE", rangeArgs(bounds, lower, upper), nil))
+}
+
+// TSRANGE constructs tsrange from lower and upper bound. Optional bounds argument specifies bounds inclusivity
+// ('[)' by default, '()', '(]' or '[]'). NULL bound means the range is unbounded.
+func TSRANGE(lower, upper TimestampExpression, bounds ...string) TimestampRangeExpression {
+	return TimestampRangeExp(jet.NewFunc("TSRANGE", rangeArgs(bounds, lower, upper), nil))
+}
+
+// TSTZRANGE constructs tstzrange from lower and upper bound. Optional bounds argument specifies bounds inclusivity
+// ('[)' by default, '()', '(]' or '[]'). NULL bound means the range is unbounded.
+func TSTZRANGE(lower, upper TimestampzExpression, bounds ...string) TimestampzRangeExpression {
+	return TimestampzRangeExp(jet.NewFunc("TSTZRANGE", rangeArgs(bounds, lower, upper), nil))
+}
+
+// INT4MULTIRANGE constructs int4multirange from the list of ranges (PostgreSQL 14+)
+func INT4MULTIRANGE(ranges ...IntegerRangeExpression) IntegerRangeExpression {
+	var args []Expression
+
+	for _, rangeExp := range ranges {
+		args = append(args, rangeExp)
+	}
+
+	return IntegerRangeExp(jet.NewFunc("INT4MULTIRANGE", args, nil))
+}
+
+// INT8MULTIRANGE constructs int8multirange from the list of ranges (PostgreSQL 14+)
+func INT8MULTIRANGE(ranges ...IntegerRangeExpression) IntegerRangeExpression {
+	var args []Expression
+
+	for _, rangeExp := range ranges {
+		args = append(args, rangeExp)
+	}
+
+	return IntegerRangeExp(jet.NewFunc("INT8MULTIRANGE", args, nil))
+}
+
+// NUMMULTIRANGE constructs nummultirange from the list of ranges (PostgreSQL 14+)
+func NUMMULTIRANGE(ranges ...FloatRangeExpression) FloatRangeExpression {
+	var args []Expression
+
+	for _, rangeExp := range ranges {
+		args = append(args, rangeExp)
+	}
+
+	return FloatRangeExp(jet.NewFunc("NUMMULTIRANGE", args, nil))
+}
+
+// DATEMULTIRANGE constructs datemultirange from the list of ranges (PostgreSQL 14+)
+func DATEMULTIRANGE(ranges ...DateRangeExpression) DateRangeExpression {
+	var args []Expression
+
+	for _, rangeExp := range ranges {
+		args = append(args, rangeExp)
+	}
+
+	return DateRangeExp(jet.NewFunc("DATEMULTIRANGE", args, nil))
+}
+
+// TSMULTIRANGE constructs tsmultirange from the list of ranges (PostgreSQL 14+)
+func TSMULTIRANGE(ranges ...TimestampRangeExpression) TimestampRangeExpression {
+	var args []Expression
+
+	for _, rangeExp := range ranges {
+		args = append(args, rangeExp)
+	}
+
+	return TimestampRangeExp(jet.NewFunc("TSMULTIRANGE", args, nil))
+}
+
+// TSTZMULTIRANGE constructs tstzmultirange from the list of ranges (PostgreSQL 14+)
+func TSTZMULTIRANGE(ranges ...TimestampzRangeExpression) TimestampzRangeExpression {
+	var args []Expression
+
+	for _, rangeExp := range ranges {
+		args = append(args, rangeExp)
+	}
+
+	return TimestampzRangeExp(jet.NewFunc("TSTZMULTIRANGE", args, nil))
+}
+
+func rangeArgs(bounds []string, lower, upper Expression) []Expression {
+	args := []Expression{lower, upper}
+
+	if len(bounds) > 0 {
+		args = append(args, String(bounds[0]))
+	}
+
+	return args
+}
+
+func castBigintLiteral(integer IntegerExpression) IntegerExpression {
+	if _, ok := integer.(jet.LiteralExpression); ok {
+		return CAST(integer).AS_BIGINT() // to make postgres aware of the type
+	}
+	return integer
+}
+
+func castNumericLiteral(float FloatExpression) FloatExpression {
+	if _, ok := float.(jet.LiteralExpression); ok {
+		return CAST(float).AS_NUMERIC() // to make postgres aware of the type
+	}
+	return float
+}
+
+// ----------------------- MERGE Functions ----------------------//
+
+// MERGE_ACTION returns the merge action command executed for the current row ('INSERT', 'UPDATE' or 'DELETE').
+// Can be used only in the RETURNING list of the MERGE statement (PostgreSQL 17+).
+func MERGE_ACTION() StringExpression {
+	return jet.NewStringFunc("MERGE_ACTION")
+}
+
 // --------------- Conditional Expressions Functions -------------//
 
 // COALESCE function returns the first of its arguments that is not null.
@@ -384,6 +708,13 @@ func PERCENTILE_DISC(fraction FloatExpression) *jet.OrderSetAggregateFunc {
 	return jet.PERCENTILE_DISC(castFloatLiteral(fraction))
 }
 
+func castIntegerLiteral(integer IntegerExpression) IntegerExpression {
+	if _, ok := integer.(jet.LiteralExpression); ok {
+		return CAST(integer).AS_INTEGER() // to make postgres aware of the type
+	}
+	return integer
+}
+
 func castFloatLiteral(fraction FloatExpression) FloatExpression {
 	if _, ok := fraction.(jet.LiteralExpression); ok {
 		return CAST(fraction).AS_DOUBLE() // to make postgres aware of the type
@@ -10,3 +10,30 @@ func TestROW(t *testing.T) {
      SELECT $2
 ), $3)`)
 }
+
+func TestJSONFunctions(t *testing.T) {
+	assertSerialize(t, TO_JSONB(String("str")), `TO_JSONB($1::text)`, "str")
+	assertSerialize(t, JSONB_BUILD_OBJECT(String("id"), table3Col1, String("name"), table3StrCol),
+		`JSONB_BUILD_OBJECT($1::text, table3.col1, $2::text, table3.col2)`, "id", "name")
+	assertSerialize(t, JSON_BUILD_OBJECT(String("id"), Int(1)), `JSON_BUILD_OBJECT($1::text, $2::integer)`, "id", int64(1))
+	assertSerialize(t, JSONB_AGG(table3ColJson), `JSONB_AGG(table3.col_json)`)
+	assertSerialize(t, JSONB_SET(table3ColJson, []string{"address", "city"}, Jsonb(`"Berlin"`)),
+		`JSONB_SET(table3.col_json, $1::text[], $2::jsonb)`, `{"address","city"}`, `"Berlin"`)
+	assertSerialize(t, JSONB_PATH_QUERY(table3ColJson, "$.phones[*]"),
+		`JSONB_PATH_QUERY(table3.col_json, $1::jsonpath)`, "$.phones[*]")
+}
+
+func TestTextSearchFunctions(t *testing.T) {
+	assertSerialize(t, TO_TSVECTOR(table3StrCol), `TO_TSVECTOR(table3.col2)`)
+	assertSerialize(t, TO_TSVECTOR(String("fat cats"), "english"), `TO_TSVECTOR($1::regconfig, $2::text)`, "english", "fat cats")
+	assertSerialize(t, TO_TSQUERY(String("fat & rat")), `TO_TSQUERY($1::text)`, "fat & rat")
+	assertSerialize(t, PLAINTO_TSQUERY(String("fat rat"), "english"), `PLAINTO_TSQUERY($1::regconfig, $2::text)`, "english", "fat rat")
+	assertSerialize(t, PHRASETO_TSQUERY(String("fat rat")), `PHRASETO_TSQUERY($1::text)`, "fat rat")
+	assertSerialize(t, WEBSEARCH_TO_TSQUERY(String(`"sad cat" or "fat rat"`)), `WEBSEARCH_TO_TSQUERY($1::text)`, `"sad cat" or "fat rat"`)
+	assertSerialize(t, TS_RANK(table3ColTsVector, table3ColTsQuery), `TS_RANK(table3.col_tsvector, table3.col_tsquery)`)
+	assertSerialize(t, TS_RANK(table3ColTsVector, table3ColTsQuery, Int(32)), `TS_RANK(table3.col_tsvector, table3.col_tsquery, $1::integer)`, int64(32))
+	assertSerialize(t, TS_RANK_CD(table3ColTsVector, table3ColTsQuery), `TS_RANK_CD(table3.col_tsvector, table3.col_tsquery)`)
+	assertSerialize(t, TS_HEADLINE(table3StrCol, table3ColTsQuery), `TS_HEADLINE(table3.col2, table3.col_tsquery)`)
+	assertSerialize(t, TS_HEADLINE(table3StrCol, WEBSEARCH_TO_TSQUERY(String("cat")), "MaxWords=10"),
+		`TS_HEADLINE(table3.col2, WEBSEARCH_TO_TSQUERY($1::text), $2::text)`, "cat", "MaxWords=10")
+}
@@ -10,6 +10,7 @@ type InsertStatement interface {
 	VALUES(value interface{}, values ...interface{}) InsertStatement
 	// Insert row of values, where value for each column is extracted from filed of structure data.
 	// If data is not struct or there is no field for every column selected, this method will panic.
+	// Data wrapped with DefaultOnZero inserts DEFAULT for zero-valued fields of the defaultable columns.
 	MODEL(data interface{}) InsertStatement
 	MODELS(data interface{}) InsertStatement
 	QUERY(selectStatement SelectStatement) InsertStatement
@@ -19,6 +20,12 @@ type InsertStatement interface {
 	RETURNING(projections ...Projection) InsertStatement
 }
 
+// DefaultOnZero wraps MODEL or MODELS data, so that DEFAULT is inserted instead of zero values of the
+// defaultable columns fields. For instance:
+//
+//	Film.INSERT(Film.AllColumns).MODEL(DefaultOnZero(film, Film.DefaultableColumns))
+var DefaultOnZero = jet.DefaultOnZero
+
 func newInsertStatement(table WritableTable, columns []jet.Column) InsertStatement {
 	newInsert := &insertStatementImpl{}
 	newInsert.SerializerStatement = jet.NewStatementImpl(Dialect, jet.InsertStatementType, newInsert,
@@ -49,12 +56,12 @@ func (i *insertStatementImpl) VALUES(value interface{}, values ...interface{}) I
 }
 
 func (i *insertStatementImpl) MODEL(data interface{}) InsertStatement {
-	i.ValuesQuery.Rows = append(i.ValuesQuery.Rows, jet.UnwindRowFromModel(i.Insert.GetColumns(), data))
+	i.ValuesQuery.Rows = append(i.ValuesQuery.Rows, jet.UnwindRowFromModelWithArrays(i.Insert.GetColumns(), data))
 	return i
 }
 
 func (i *insertStatementImpl) MODELS(data interface{}) InsertStatement {
-	i.ValuesQuery.Rows = append(i.ValuesQuery.Rows, jet.UnwindRowsFromModels(i.Insert.GetColumns(), data)...)
+	i.ValuesQuery.Rows = append(i.ValuesQuery.Rows, jet.UnwindRowsFromModelsWithArrays(i.Insert.GetColumns(), data)...)
 	return i
 }
 
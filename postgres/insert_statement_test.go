@@ -90,6 +90,49 @@ VALUES ($1, $2),
 	assertStatementSql(t, stmt, expectedSQL, 1, float64(1.11), 1, float64(1.11))
 }
 
+func TestInsertValuesFromModelDefaultOnZero(t *testing.T) {
+	type Table1Model struct {
+		Col1          *int
+		ColFloat      float64
+		ColTimestamp  time.Time
+		ColTimestampz *time.Time
+	}
+
+	one := 1
+	timestamp := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	defaultableColumns := ColumnList{table1Col1, table1ColTimestamp, table1ColTimestampz}
+
+	stmt := table1.INSERT(table1Col1, table1ColFloat, table1ColTimestamp, table1ColTimestampz).
+		MODEL(DefaultOnZero(Table1Model{}, defaultableColumns)).
+		MODEL(DefaultOnZero(&Table1Model{Col1: &one, ColTimestamp: timestamp}, defaultableColumns)).
+		MODELS(DefaultOnZero([]Table1Model{{ColFloat: 2.2}}, table1ColFloat))
+
+	assertStatementSql(t, stmt, `
+INSERT INTO db.table1 (col1, col_float, col_timestamp, col_timestampz)
+VALUES (DEFAULT, $1, DEFAULT, DEFAULT),
+       ($2, $3, $4, DEFAULT),
+       ($5, $6, $7, $8);
+`, 0.0, 1, 0.0, timestamp, nil, 2.2, time.Time{}, nil)
+}
+
+func TestUpdateValuesFromModelDefaultOnZero(t *testing.T) {
+	type Table1Model struct {
+		Col1     int
+		ColFloat float64
+	}
+
+	stmt := table1.UPDATE(table1Col1, table1ColFloat).
+		MODEL(DefaultOnZero(Table1Model{Col1: 1}, table1Col1, table1ColFloat)).
+		WHERE(table1Col1.EQ(Int(2)))
+
+	assertStatementSql(t, stmt, `
+UPDATE db.table1
+SET (col1, col_float) = ($1, DEFAULT)
+WHERE table1.col1 = $2;
+`, 1, int64(2))
+}
+
 func TestInsertValuesFromModelColumnMismatch(t *testing.T) {
 	defer func() {
 		r := recover()
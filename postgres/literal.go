@@ -66,13 +66,23 @@ func String(value string) StringExpression {
 }
 
 // Json creates new json literal expression
-func Json(value interface{}) StringExpression {
+func Json(value interface{}) JsonExpression {
 	switch value.(type) {
 	case string, []byte:
 	default:
-		panic("Bytea parameter value has to be of the type string or []byte")
+		panic("Json parameter value has to be of the type string or []byte")
+	}
+	return CAST(jet.Literal(value)).AS_JSON()
+}
+
+// Jsonb creates new jsonb literal expression
+func Jsonb(value interface{}) JsonExpression {
+	switch value.(type) {
+	case string, []byte:
+	default:
+		panic("Jsonb parameter value has to be of the type string or []byte")
 	}
-	return StringExp(CAST(jet.Literal(value)).AS("json"))
+	return CAST(jet.Literal(value)).AS_JSONB()
 }
 
 // UUID is a helper function to create string literal expression from uuid object
@@ -70,6 +70,12 @@ func TestBytea(t *testing.T) {
 func TestJson(t *testing.T) {
 	assertSerialize(t, Json("{\"key\": \"value\"}"), `$1::json`, "{\"key\": \"value\"}")
 	assertSerialize(t, Json([]byte("{\"key\": \"value\"}")), `$1::json`, []byte("{\"key\": \"value\"}"))
+	assertSerialize(t, Json(`{"key": "value"}`).GET_TEXT(String("key")), `($1::json ->> $2::text)`, `{"key": "value"}`, "key")
+}
+
+func TestJsonb(t *testing.T) {
+	assertSerialize(t, Jsonb(`{"key": "value"}`), `$1::jsonb`, `{"key": "value"}`)
+	assertSerialize(t, Jsonb(`{"key": "value"}`).HAS_KEY(String("key")), `($1::jsonb ? $2::text)`, `{"key": "value"}`, "key")
 }
 
 func TestDate(t *testing.T) {
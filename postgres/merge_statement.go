@@ -0,0 +1,282 @@
+package postgres
+
+import (
+	"github.com/go-jet/jet/v2/internal/jet"
+	"github.com/go-jet/jet/v2/internal/utils"
+)
+
+// MergeStatement is interface of PostgreSQL MERGE statement (PostgreSQL 15+)
+type MergeStatement interface {
+	jet.SerializerStatement
+
+	USING(source ReadableTable) MergeStatement
+	ON(condition BoolExpression) MergeStatement
+
+	// WHEN_MATCHED starts WHEN MATCHED branch, applied to target rows matched by the source row
+	WHEN_MATCHED() MergeMatchedAction
+	// WHEN_MATCHED_AND starts WHEN MATCHED AND condition branch
+	WHEN_MATCHED_AND(condition BoolExpression) MergeMatchedAction
+	// WHEN_NOT_MATCHED starts WHEN NOT MATCHED branch, applied to source rows without matching target row
+	WHEN_NOT_MATCHED() MergeNotMatchedAction
+	// WHEN_NOT_MATCHED_AND starts WHEN NOT MATCHED AND condition branch
+	WHEN_NOT_MATCHED_AND(condition BoolExpression) MergeNotMatchedAction
+
+	// RETURNING clause is supported by PostgreSQL 17+
+	RETURNING(projections ...Projection) MergeStatement
+}
+
+// MergeMatchedAction is interface of MERGE statement WHEN MATCHED branch action
+type MergeMatchedAction interface {
+	// THEN_UPDATE updates matched target row with column assigments
+	THEN_UPDATE(assigments ...ColumnAssigment) MergeStatement
+	// THEN_DELETE deletes matched target row
+	THEN_DELETE() MergeStatement
+	// THEN_DO_NOTHING skips matched target row
+	THEN_DO_NOTHING() MergeStatement
+}
+
+// MergeNotMatchedAction is interface of MERGE statement WHEN NOT MATCHED branch action
+type MergeNotMatchedAction interface {
+	// THEN_INSERT inserts new target row, with values set for the columns list
+	THEN_INSERT(columns ...jet.Column) MergeInsertAction
+	// THEN_DO_NOTHING skips not matched source row
+	THEN_DO_NOTHING() MergeStatement
+}
+
+// MergeInsertAction is interface of MERGE statement THEN INSERT action values
+type MergeInsertAction interface {
+	// VALUES inserts row of values
+	VALUES(value interface{}, values ...interface{}) MergeStatement
+	// MODEL inserts row of values, where value for each column is extracted from filed of structure data.
+	MODEL(data interface{}) MergeStatement
+	// DEFAULT_VALUES inserts row filled with column default values
+	DEFAULT_VALUES() MergeStatement
+}
+
+type mergeStatementImpl struct {
+	jet.SerializerStatement
+
+	Merge     clauseMerge
+	Using     clauseMergeUsing
+	On        clauseMergeOn
+	When      []*clauseMergeWhen
+	Returning jet.ClauseReturning
+}
+
+func newMergeStatement(table WritableTable) MergeStatement {
+	newMerge := &mergeStatementImpl{}
+	newMerge.SerializerStatement = jet.NewStatementImpl(Dialect, jet.MergeStatementType, newMerge,
+		&newMerge.Merge,
+		&newMerge.Using,
+		&newMerge.On,
+		clauseMergeWhenList{&newMerge.When},
+		&newMerge.Returning,
+	)
+
+	newMerge.Merge.Table = table
+	newMerge.Using.Name = "USING"
+
+	return newMerge
+}
+
+func (m *mergeStatementImpl) USING(source ReadableTable) MergeStatement {
+	m.Using.Tables = []jet.Serializer{source}
+	return m
+}
+
+func (m *mergeStatementImpl) ON(condition BoolExpression) MergeStatement {
+	m.On.Condition = condition
+	return m
+}
+
+func (m *mergeStatementImpl) WHEN_MATCHED() MergeMatchedAction {
+	return m.newWhen(true, nil)
+}
+
+func (m *mergeStatementImpl) WHEN_MATCHED_AND(condition BoolExpression) MergeMatchedAction {
+	return m.newWhen(true, condition)
+}
+
+func (m *mergeStatementImpl) WHEN_NOT_MATCHED() MergeNotMatchedAction {
+	return m.newWhen(false, nil)
+}
+
+func (m *mergeStatementImpl) WHEN_NOT_MATCHED_AND(condition BoolExpression) MergeNotMatchedAction {
+	return m.newWhen(false, condition)
+}
+
+func (m *mergeStatementImpl) RETURNING(projections ...jet.Projection) MergeStatement {
+	m.Returning.ProjectionList = projections
+	return m
+}
+
+func (m *mergeStatementImpl) newWhen(matched bool, condition BoolExpression) *clauseMergeWhen {
+	when := &clauseMergeWhen{
+		statement: m,
+		matched:   matched,
+		condition: condition,
+	}
+
+	m.When = append(m.When, when)
+
+	return when
+}
+
+type clauseMerge struct {
+	Table WritableTable
+}
+
+func (m *clauseMerge) Serialize(statementType jet.StatementType, out *jet.SQLBuilder, options ...jet.SerializeOption) {
+	if utils.IsNil(m.Table) {
+		panic("jet: table is nil for MERGE clause")
+	}
+
+	out.NewLine()
+	out.WriteString("MERGE INTO")
+	jet.Serialize(m.Table, statementType, out, jet.FallTrough(options)...)
+}
+
+type clauseMergeUsing struct {
+	jet.ClauseFrom
+}
+
+func (u *clauseMergeUsing) Serialize(statementType jet.StatementType, out *jet.SQLBuilder, options ...jet.SerializeOption) {
+	if len(u.Tables) == 0 || utils.IsNil(u.Tables[0]) {
+		panic("jet: USING source not set for MERGE statement")
+	}
+
+	u.ClauseFrom.Serialize(statementType, out, options...)
+}
+
+type clauseMergeOn struct {
+	Condition BoolExpression
+}
+
+func (o *clauseMergeOn) Serialize(statementType jet.StatementType, out *jet.SQLBuilder, options ...jet.SerializeOption) {
+	if o.Condition == nil {
+		panic("jet: ON condition not set for MERGE statement")
+	}
+
+	out.NewLine()
+	out.WriteString("ON")
+	out.IncreaseIdent(3)
+	jet.Serialize(o.Condition, statementType, out, jet.NoWrap.WithFallTrough(options)...)
+	out.DecreaseIdent(3)
+}
+
+type clauseMergeWhenList struct {
+	list *[]*clauseMergeWhen
+}
+
+func (w clauseMergeWhenList) Serialize(statementType jet.StatementType, out *jet.SQLBuilder, options ...jet.SerializeOption) {
+	if len(*w.list) == 0 {
+		panic("jet: MERGE statement requires at least one WHEN clause")
+	}
+
+	for _, when := range *w.list {
+		when.Serialize(statementType, out, options...)
+	}
+}
+
+type clauseMergeWhen struct {
+	statement *mergeStatementImpl
+	matched   bool
+	condition BoolExpression
+	action    jet.Serializer
+
+	insertColumns []jet.Column
+	values        jet.ClauseValuesQuery
+}
+
+func (w *clauseMergeWhen) THEN_UPDATE(assigments ...ColumnAssigment) MergeStatement {
+	set := jet.SetClauseNew(assigments)
+	w.action = jet.NewSerializerClauseImpl(jet.KeywordClause{Keyword: "UPDATE"}, set)
+	return w.statement
+}
+
+func (w *clauseMergeWhen) THEN_DELETE() MergeStatement {
+	w.action = jet.Keyword("DELETE")
+	return w.statement
+}
+
+func (w *clauseMergeWhen) THEN_DO_NOTHING() MergeStatement {
+	w.action = jet.Keyword("DO NOTHING")
+	return w.statement
+}
+
+func (w *clauseMergeWhen) THEN_INSERT(columns ...jet.Column) MergeInsertAction {
+	w.insertColumns = jet.UnwidColumnList(columns)
+	w.action = jet.NewSerializerClauseImpl(&clauseMergeInsert{Columns: w.insertColumns, Values: &w.values}, &w.values)
+	return w
+}
+
+func (w *clauseMergeWhen) VALUES(value interface{}, values ...interface{}) MergeStatement {
+	w.values.Rows = [][]jet.Serializer{jet.UnwindRowFromValues(value, values)}
+	return w.statement
+}
+
+func (w *clauseMergeWhen) MODEL(data interface{}) MergeStatement {
+	columns := w.insertColumns
+
+	if len(columns) == 0 {
+		columns = (&jet.ClauseInsert{Table: w.statement.Merge.Table}).GetColumns()
+	}
+
+	w.values.Rows = [][]jet.Serializer{jet.UnwindRowFromModelWithArrays(columns, data)}
+	return w.statement
+}
+
+func (w *clauseMergeWhen) DEFAULT_VALUES() MergeStatement {
+	w.action = jet.NewSerializerClauseImpl(&clauseMergeInsert{DefaultValues: true})
+	return w.statement
+}
+
+func (w *clauseMergeWhen) Serialize(statementType jet.StatementType, out *jet.SQLBuilder, options ...jet.SerializeOption) {
+	if w.action == nil {
+		panic("jet: WHEN clause action not set for MERGE statement")
+	}
+
+	out.NewLine()
+	if w.matched {
+		out.WriteString("WHEN MATCHED")
+	} else {
+		out.WriteString("WHEN NOT MATCHED")
+	}
+
+	if w.condition != nil {
+		out.WriteString("AND")
+		jet.Serialize(w.condition, statementType, out, jet.NoWrap.WithFallTrough(options)...)
+	}
+
+	out.WriteString("THEN")
+
+	out.IncreaseIdent(5)
+	out.NewLine()
+	jet.Serialize(w.action, statementType, out, jet.FallTrough(options)...)
+	out.DecreaseIdent(5)
+}
+
+type clauseMergeInsert struct {
+	Columns       []jet.Column
+	Values        *jet.ClauseValuesQuery
+	DefaultValues bool
+}
+
+func (i *clauseMergeInsert) Serialize(statementType jet.StatementType, out *jet.SQLBuilder, options ...jet.SerializeOption) {
+	if !i.DefaultValues && (i.Values == nil || len(i.Values.Rows) == 0) {
+		panic("jet: VALUES, MODEL or DEFAULT_VALUES not set for MERGE WHEN NOT MATCHED THEN INSERT")
+	}
+
+	out.WriteString("INSERT")
+
+	if i.DefaultValues {
+		out.WriteString("DEFAULT VALUES")
+		return
+	}
+
+	if len(i.Columns) > 0 {
+		out.WriteString("(")
+		jet.SerializeColumnNames(i.Columns, out)
+		out.WriteString(")")
+	}
+}
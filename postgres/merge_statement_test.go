@@ -0,0 +1,97 @@
+package postgres
+
+import (
+	"testing"
+)
+
+func TestMergeInvalid(t *testing.T) {
+	assertStatementSqlErr(t, table1.MERGE().ON(table1ColInt.EQ(table2ColInt)).WHEN_MATCHED().THEN_DELETE(),
+		"jet: USING source not set for MERGE statement")
+	assertStatementSqlErr(t, table1.MERGE().USING(table2), "jet: ON condition not set for MERGE statement")
+	assertStatementSqlErr(t, table1.MERGE().USING(table2).ON(table1ColInt.EQ(table2ColInt)),
+		"jet: MERGE statement requires at least one WHEN clause")
+
+	stmt := table1.MERGE().USING(table2).ON(table1ColInt.EQ(table2ColInt))
+	stmt.WHEN_MATCHED()
+	assertStatementSqlErr(t, stmt, "jet: WHEN clause action not set for MERGE statement")
+
+	stmt = table1.MERGE().USING(table2).ON(table1ColInt.EQ(table2ColInt))
+	stmt.WHEN_NOT_MATCHED().THEN_INSERT(table1ColInt)
+	assertStatementSqlErr(t, stmt, "jet: VALUES, MODEL or DEFAULT_VALUES not set for MERGE WHEN NOT MATCHED THEN INSERT")
+}
+
+func TestMergeMatched(t *testing.T) {
+	assertStatementSql(t,
+		table1.MERGE().
+			USING(table2).
+			ON(table1ColInt.EQ(table2ColInt)).
+			WHEN_MATCHED_AND(table2ColBool.IS_FALSE()).THEN_DELETE().
+			WHEN_MATCHED_AND(table2ColFloat.GT(table1ColFloat)).
+			THEN_UPDATE(table1ColFloat.SET(table2ColFloat), table1ColBool.SET(Bool(true))).
+			WHEN_MATCHED().THEN_DO_NOTHING(), `
+MERGE INTO db.table1
+USING db.table2
+ON table1.col_int = table2.col_int
+WHEN MATCHED AND table2.col_bool IS FALSE THEN
+     DELETE
+WHEN MATCHED AND table2.col_float > table1.col_float THEN
+     UPDATE
+     SET col_float = table2.col_float,
+         col_bool = $1::boolean
+WHEN MATCHED THEN
+     DO NOTHING;
+`, true)
+}
+
+func TestMergeNotMatched(t *testing.T) {
+	assertStatementSql(t,
+		table1.MERGE().
+			USING(table2).
+			ON(table1ColInt.EQ(table2ColInt)).
+			WHEN_NOT_MATCHED_AND(table2ColBool).THEN_INSERT(table1ColInt, table1ColFloat).
+			VALUES(table2ColInt, table2ColFloat).
+			WHEN_NOT_MATCHED().THEN_INSERT().DEFAULT_VALUES(), `
+MERGE INTO db.table1
+USING db.table2
+ON table1.col_int = table2.col_int
+WHEN NOT MATCHED AND table2.col_bool THEN
+     INSERT (col_int, col_float)
+     VALUES (table2.col_int, table2.col_float)
+WHEN NOT MATCHED THEN
+     INSERT DEFAULT VALUES;
+`)
+}
+
+func TestMergeInsertModel(t *testing.T) {
+	type Table3 struct {
+		Col1   int
+		ColInt int
+	}
+
+	source := table2.SELECT(table2ColInt, table2ColFloat).AsTable("source")
+	sourceColInt := table2ColInt.From(source)
+
+	assertStatementSql(t,
+		table3.MERGE().
+			USING(source).
+			ON(table3ColInt.EQ(sourceColInt)).
+			WHEN_NOT_MATCHED().THEN_INSERT(table3Col1, table3ColInt).MODEL(Table3{Col1: 1, ColInt: 2}).
+			WHEN_MATCHED().THEN_UPDATE(table3ColInt.SET(sourceColInt)).
+			RETURNING(table3Col1, MERGE_ACTION()), `
+MERGE INTO db.table3
+USING (
+          SELECT table2.col_int AS "table2.col_int",
+               table2.col_float AS "table2.col_float"
+          FROM db.table2
+     ) AS source
+ON table3.col_int = source."table2.col_int"
+WHEN NOT MATCHED THEN
+     INSERT (col1, col_int)
+     VALUES ($1, $2)
+WHEN MATCHED THEN
+     UPDATE
+     SET col_int = source."table2.col_int"
+RETURNING table3.col1 AS "table3.col1",
+          MERGE_ACTION();
+`, 1, 2)
+}
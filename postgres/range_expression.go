@@ -0,0 +1,988 @@
+package postgres
+
+import "github.com/go-jet/jet/v2/internal/jet"
+
+// Range operators
+const (
+	RangeContainsOperator       = "@>"
+	RangeContainedByOperator    = "<@"
+	RangeOverlapOperator        = "&&"
+	RangeStrictlyLeftOperator   = "<<"
+	RangeStrictlyRightOperator  = ">>"
+	RangeNotExtendRightOperator = "&<"
+	RangeNotExtendLeftOperator  = "&>"
+	RangeAdjacentOperator       = "-|-"
+	RangeUnionOperator          = "+"
+	RangeIntersectionOperator   = "*"
+	RangeDifferenceOperator     = "-"
+)
+
+// RangeExpression is common interface for all the range and multirange expressions
+type RangeExpression interface {
+	Expression
+
+	isRange()
+}
+
+type rangeInterfaceImpl struct{}
+
+func (r *rangeInterfaceImpl) isRange() {}
+
+func newRangeBoolOperatorExpression(lhs, rhs Expression, operator string) BoolExpression {
+	return BoolExp(jet.NewBinaryOperatorExpression(lhs, rhs, operator))
+}
+
+//---------------------------------------------------//
+
+// IntegerRangeExpression is interface for PostgreSQL int4range, int8range, int4multirange and int8multirange types.
+// Range and multirange of the same element type share the same expression interface,
+// because all the range operators accept both.
+type IntegerRangeExpression interface {
+	RangeExpression
+
+	EQ(rhs IntegerRangeExpression) BoolExpression
+	NOT_EQ(rhs IntegerRangeExpression) BoolExpression
+	IS_DISTINCT_FROM(rhs IntegerRangeExpression) BoolExpression
+	IS_NOT_DISTINCT_FROM(rhs IntegerRangeExpression) BoolExpression
+
+	LT(rhs IntegerRangeExpression) BoolExpression
+	LT_EQ(rhs IntegerRangeExpression) BoolExpression
+	GT(rhs IntegerRangeExpression) BoolExpression
+	GT_EQ(rhs IntegerRangeExpression) BoolExpression
+
+	// CONTAINS checks if this range contains rhs range (@> operator)
+	CONTAINS(rhs IntegerRangeExpression) BoolExpression
+	// CONTAINS_ELEMENT checks if this range contains element (@> operator)
+	CONTAINS_ELEMENT(elem IntegerExpression) BoolExpression
+	// IS_CONTAINED_BY checks if this range is contained by rhs range (<@ operator)
+	IS_CONTAINED_BY(rhs IntegerRangeExpression) BoolExpression
+	// OVERLAP checks if this range and rhs range have points in common (&& operator)
+	OVERLAP(rhs IntegerRangeExpression) BoolExpression
+	// STRICTLY_LEFT_OF checks if this range is strictly left of rhs range (<< operator)
+	STRICTLY_LEFT_OF(rhs IntegerRangeExpression) BoolExpression
+	// STRICTLY_RIGHT_OF checks if this range is strictly right of rhs range (>> operator)
+	STRICTLY_RIGHT_OF(rhs IntegerRangeExpression) BoolExpression
+	// NOT_EXTEND_RIGHT_OF checks if this range does not extend to the right of rhs range (&< operator)
+	NOT_EXTEND_RIGHT_OF(rhs IntegerRangeExpression) BoolExpression
+	// NOT_EXTEND_LEFT_OF checks if this range does not extend to the left of rhs range (&> operator)
+	NOT_EXTEND_LEFT_OF(rhs IntegerRangeExpression) BoolExpression
+	// ADJACENT_TO checks if this range is adjacent to rhs range (-|- operator)
+	ADJACENT_TO(rhs IntegerRangeExpression) BoolExpression
+
+	// UNION computes union of two ranges (+ operator)
+	UNION(rhs IntegerRangeExpression) IntegerRangeExpression
+	// INTERSECTION computes intersection of two ranges (* operator)
+	INTERSECTION(rhs IntegerRangeExpression) IntegerRangeExpression
+	// DIFFERENCE computes difference of two ranges (- operator)
+	DIFFERENCE(rhs IntegerRangeExpression) IntegerRangeExpression
+
+	// LOWER_BOUND returns lower bound of the range, or NULL if range is empty or lower bound is infinite
+	LOWER_BOUND() IntegerExpression
+	// UPPER_BOUND returns upper bound of the range, or NULL if range is empty or upper bound is infinite
+	UPPER_BOUND() IntegerExpression
+	// IS_EMPTY checks if the range is empty
+	IS_EMPTY() BoolExpression
+	// LOWER_INC checks if lower bound of the range is inclusive
+	LOWER_INC() BoolExpression
+	// UPPER_INC checks if upper bound of the range is inclusive
+	UPPER_INC() BoolExpression
+	// LOWER_INF checks if lower bound of the range is infinite
+	LOWER_INF() BoolExpression
+	// UPPER_INF checks if upper bound of the range is infinite
+	UPPER_INF() BoolExpression
+}
+
+type integerRangeInterfaceImpl struct {
+	rangeInterfaceImpl
+	parent IntegerRangeExpression
+}
+
+func (r *integerRangeInterfaceImpl) EQ(rhs IntegerRangeExpression) BoolExpression {
+	return jet.Eq(r.parent, rhs)
+}
+
+func (r *integerRangeInterfaceImpl) NOT_EQ(rhs IntegerRangeExpression) BoolExpression {
+	return jet.NotEq(r.parent, rhs)
+}
+
+func (r *integerRangeInterfaceImpl) IS_DISTINCT_FROM(rhs IntegerRangeExpression) BoolExpression {
+	return jet.IsDistinctFrom(r.parent, rhs)
+}
+
+func (r *integerRangeInterfaceImpl) IS_NOT_DISTINCT_FROM(rhs IntegerRangeExpression) BoolExpression {
+	return jet.IsNotDistinctFrom(r.parent, rhs)
+}
+
+func (r *integerRangeInterfaceImpl) LT(rhs IntegerRangeExpression) BoolExpression {
+	return jet.Lt(r.parent, rhs)
+}
+
+func (r *integerRangeInterfaceImpl) LT_EQ(rhs IntegerRangeExpression) BoolExpression {
+	return jet.LtEq(r.parent, rhs)
+}
+
+func (r *integerRangeInterfaceImpl) GT(rhs IntegerRangeExpression) BoolExpression {
+	return jet.Gt(r.parent, rhs)
+}
+
+func (r *integerRangeInterfaceImpl) GT_EQ(rhs IntegerRangeExpression) BoolExpression {
+	return jet.GtEq(r.parent, rhs)
+}
+
+func (r *integerRangeInterfaceImpl) CONTAINS(rhs IntegerRangeExpression) BoolExpression {
+	return newRangeBoolOperatorExpression(r.parent, rhs, RangeContainsOperator)
+}
+
+func (r *integerRangeInterfaceImpl) CONTAINS_ELEMENT(elem IntegerExpression) BoolExpression {
+	return newRangeBoolOperatorExpression(r.parent, elem, RangeContainsOperator)
+}
+
+func (r *integerRangeInterfaceImpl) IS_CONTAINED_BY(rhs IntegerRangeExpression) BoolExpression {
+	return newRangeBoolOperatorExpression(r.parent, rhs, RangeContainedByOperator)
+}
+
+func (r *integerRangeInterfaceImpl) OVERLAP(rhs IntegerRangeExpression) BoolExpression {
+	return newRangeBoolOperatorExpression(r.parent, rhs, RangeOverlapOperator)
+}
+
+func (r *integerRangeInterfaceImpl) STRICTLY_LEFT_OF(rhs IntegerRangeExpression) BoolExpression {
+	return newRangeBoolOperatorExpression(r.parent, rhs, RangeStrictlyLeftOperator)
+}
+
+func (r *integerRangeInterfaceImpl) STRICTLY_RIGHT_OF(rhs IntegerRangeExpression) BoolExpression {
+	return newRangeBoolOperatorExpression(r.parent, rhs, RangeStrictlyRightOperator)
+}
+
+func (r *integerRangeInterfaceImpl) NOT_EXTEND_RIGHT_OF(rhs IntegerRangeExpression) BoolExpression {
+	return newRangeBoolOperatorExpression(r.parent, rhs, RangeNotExtendRightOperator)
+}
+
+func (r *integerRangeInterfaceImpl) NOT_EXTEND_LEFT_OF(rhs IntegerRangeExpression) BoolExpression {
+	return newRangeBoolOperatorExpression(r.parent, rhs, RangeNotExtendLeftOperator)
+}
+
+func (r *integerRangeInterfaceImpl) ADJACENT_TO(rhs IntegerRangeExpression) BoolExpression {
+	return newRangeBoolOperatorExpression(r.parent, rhs, RangeAdjacentOperator)
+}
+
+func (r *integerRangeInterfaceImpl) UNION(rhs IntegerRangeExpression) IntegerRangeExpression {
+	return IntegerRangeExp(jet.NewBinaryOperatorExpression(r.parent, rhs, RangeUnionOperator))
+}
+
+func (r *integerRangeInterfaceImpl) INTERSECTION(rhs IntegerRangeExpression) IntegerRangeExpression {
+	return IntegerRangeExp(jet.NewBinaryOperatorExpression(r.parent, rhs, RangeIntersectionOperator))
+}
+
+func (r *integerRangeInterfaceImpl) DIFFERENCE(rhs IntegerRangeExpression) IntegerRangeExpression {
+	return IntegerRangeExp(jet.NewBinaryOperatorExpression(r.parent, rhs, RangeDifferenceOperator))
+}
+
+func (r *integerRangeInterfaceImpl) LOWER_BOUND() IntegerExpression {
+	return IntExp(jet.NewFunc("LOWER", []jet.Expression{r.parent}, nil))
+}
+
+func (r *integerRangeInterfaceImpl) UPPER_BOUND() IntegerExpression {
+	return IntExp(jet.NewFunc("UPPER", []jet.Expression{r.parent}, nil))
+}
+
+func (r *integerRangeInterfaceImpl) IS_EMPTY() BoolExpression {
+	return BoolExp(jet.NewFunc("ISEMPTY", []jet.Expression{r.parent}, nil))
+}
+
+func (r *integerRangeInterfaceImpl) LOWER_INC() BoolExpression {
+	return BoolExp(jet.NewFunc("LOWER_INC", []jet.Expression{r.parent}, nil))
+}
+
+func (r *integerRangeInterfaceImpl) UPPER_INC() BoolExpression {
+	return BoolExp(jet.NewFunc("UPPER_INC", []jet.Expression{r.parent}, nil))
+}
+
+func (r *integerRangeInterfaceImpl) LOWER_INF() BoolExpression {
+	return BoolExp(jet.NewFunc("LOWER_INF", []jet.Expression{r.parent}, nil))
+}
+
+func (r *integerRangeInterfaceImpl) UPPER_INF() BoolExpression {
+	return BoolExp(jet.NewFunc("UPPER_INF", []jet.Expression{r.parent}, nil))
+}
+
+type integerRangeExpressionWrapper struct {
+	integerRangeInterfaceImpl
+	jet.Expression
+}
+
+func newIntegerRangeExpressionWrap(expression Expression) IntegerRangeExpression {
+	rangeExpressionWrap := integerRangeExpressionWrapper{Expression: expression}
+	rangeExpressionWrap.integerRangeInterfaceImpl.parent = &rangeExpressionWrap
+	return &rangeExpressionWrap
+}
+
+// IntegerRangeExp is integer range expression wrapper around arbitrary expression.
+// Allows go compiler to see any expression as integer range expression.
+// Does not add sql cast to generated sql builder output.
+func IntegerRangeExp(expression Expression) IntegerRangeExpression {
+	return newIntegerRangeExpressionWrap(expression)
+}
+
+//---------------------------------------------------//
+
+// FloatRangeExpression is interface for PostgreSQL numrange and nummultirange types.
+// Range and multirange of the same element type share the same expression interface,
+// because all the range operators accept both.
+type FloatRangeExpression interface {
+	RangeExpression
+
+	EQ(rhs FloatRangeExpression) BoolExpression
+	NOT_EQ(rhs FloatRangeExpression) BoolExpression
+	IS_DISTINCT_FROM(rhs FloatRangeExpression) BoolExpression
+	IS_NOT_DISTINCT_FROM(rhs FloatRangeExpression) BoolExpression
+
+	LT(rhs FloatRangeExpression) BoolExpression
+	LT_EQ(rhs FloatRangeExpression) BoolExpression
+	GT(rhs FloatRangeExpression) BoolExpression
+	GT_EQ(rhs FloatRangeExpression) BoolExpression
+
+	// CONTAINS checks if this range contains rhs range (@> operator)
+	CONTAINS(rhs FloatRangeExpression) BoolExpression
+	// CONTAINS_ELEMENT checks if this range contains element (@> operator)
+	CONTAINS_ELEMENT(elem FloatExpression) BoolExpression
+	// IS_CONTAINED_BY checks if this range is contained by rhs range (<@ operator)
+	IS_CONTAINED_BY(rhs FloatRangeExpression) BoolExpression
+	// OVERLAP checks if this range and rhs range have points in common (&& operator)
+	OVERLAP(rhs FloatRangeExpression) BoolExpression
+	// STRICTLY_LEFT_OF checks if this range is strictly left of rhs range (<< operator)
+	STRICTLY_LEFT_OF(rhs FloatRangeExpression) BoolExpression
+	// STRICTLY_RIGHT_OF checks if this range is strictly right of rhs range (>> operator)
+	STRICTLY_RIGHT_OF(rhs FloatRangeExpression) BoolExpression
+	// NOT_EXTEND_RIGHT_OF checks if this range does not extend to the right of rhs range (&< operator)
+	NOT_EXTEND_RIGHT_OF(rhs FloatRangeExpression) BoolExpression
+	// NOT_EXTEND_LEFT_OF checks if this range does not extend to the left of rhs range (&> operator)
+	NOT_EXTEND_LEFT_OF(rhs FloatRangeExpression) BoolExpression
+	// ADJACENT_TO checks if this range is adjacent to rhs range (-|- operator)
+	ADJACENT_TO(rhs FloatRangeExpression) BoolExpression
+
+	// UNION computes union of two ranges (+ operator)
+	UNION(rhs FloatRangeExpression) FloatRangeExpression
+	// INTERSECTION computes intersection of two ranges (* operator)
+	INTERSECTION(rhs FloatRangeExpression) FloatRangeExpression
+	// DIFFERENCE computes difference of two ranges (- operator)
+	DIFFERENCE(rhs FloatRangeExpression) FloatRangeExpression
+
+	// LOWER_BOUND returns lower bound of the range, or NULL if range is empty or lower bound is infinite
+	LOWER_BOUND() FloatExpression
+	// UPPER_BOUND returns upper bound of the range, or NULL if range is empty or upper bound is infinite
+	UPPER_BOUND() FloatExpression
+	// IS_EMPTY checks if the range is empty
+	IS_EMPTY() BoolExpression
+	// LOWER_INC checks if lower bound of the range is inclusive
+	LOWER_INC() BoolExpression
+	// UPPER_INC checks if upper bound of the range is inclusive
+	UPPER_INC() BoolExpression
+	// LOWER_INF checks if lower bound of the range is infinite
+	LOWER_INF() BoolExpression
+	// UPPER_INF checks if upper bound of the range is infinite
+	UPPER_INF() BoolExpression
+}
+
+type floatRangeInterfaceImpl struct {
+	rangeInterfaceImpl
+	parent FloatRangeExpression
+}
+
+func (r *floatRangeInterfaceImpl) EQ(rhs FloatRangeExpression) BoolExpression {
+	return jet.Eq(r.parent, rhs)
+}
+
+func (r *floatRangeInterfaceImpl) NOT_EQ(rhs FloatRangeExpression) BoolExpression {
+	return jet.NotEq(r.parent, rhs)
+}
+
+func (r *floatRangeInterfaceImpl) IS_DISTINCT_FROM(rhs FloatRangeExpression) BoolExpression {
+	return jet.IsDistinctFrom(r.parent, rhs)
+}
+
+func (r *floatRangeInterfaceImpl) IS_NOT_DISTINCT_FROM(rhs FloatRangeExpression) BoolExpression {
+	return jet.IsNotDistinctFrom(r.parent, rhs)
+}
+
+func (r *floatRangeInterfaceImpl) LT(rhs FloatRangeExpression) BoolExpression {
+	return jet.Lt(r.parent, rhs)
+}
+
+func (r *floatRangeInterfaceImpl) LT_EQ(rhs FloatRangeExpression) BoolExpression {
+	return jet.LtEq(r.parent, rhs)
+}
+
+func (r *floatRangeInterfaceImpl) GT(rhs FloatRangeExpression) BoolExpression {
+	return jet.Gt(r.parent, rhs)
+}
+
+func (r *floatRangeInterfaceImpl) GT_EQ(rhs FloatRangeExpression) BoolExpression {
+	return jet.GtEq(r.parent, rhs)
+}
+
+func (r *floatRangeInterfaceImpl) CONTAINS(rhs FloatRangeExpression) BoolExpression {
+	return newRangeBoolOperatorExpression(r.parent, rhs, RangeContainsOperator)
+}
+
+func (r *floatRangeInterfaceImpl) CONTAINS_ELEMENT(elem FloatExpression) BoolExpression {
+	return newRangeBoolOperatorExpression(r.parent, elem, RangeContainsOperator)
+}
+
+func (r *floatRangeInterfaceImpl) IS_CONTAINED_BY(rhs FloatRangeExpression) BoolExpression {
+	return newRangeBoolOperatorExpression(r.parent, rhs, RangeContainedByOperator)
+}
+
+func (r *floatRangeInterfaceImpl) OVERLAP(rhs FloatRangeExpression) BoolExpression {
+	return newRangeBoolOperatorExpression(r.parent, rhs, RangeOverlapOperator)
+}
+
+func (r *floatRangeInterfaceImpl) STRICTLY_LEFT_OF(rhs FloatRangeExpression) BoolExpression {
+	return newRangeBoolOperatorExpression(r.parent, rhs, RangeStrictlyLeftOperator)
+}
+
+func (r *floatRangeInterfaceImpl) STRICTLY_RIGHT_OF(rhs FloatRangeExpression) BoolExpression {
+	return newRangeBoolOperatorExpression(r.parent, rhs, RangeStrictlyRightOperator)
+}
+
+func (r *floatRangeInterfaceImpl) NOT_EXTEND_RIGHT_OF(rhs FloatRangeExpression) BoolExpression {
+	return newRangeBoolOperatorExpression(r.parent, rhs, RangeNotExtendRightOperator)
+}
+
+func (r *floatRangeInterfaceImpl) NOT_EXTEND_LEFT_OF(rhs FloatRangeExpression) BoolExpression {
+	return newRangeBoolOperatorExpression(r.parent, rhs, RangeNotExtendLeftOperator)
+}
+
+func (r *floatRangeInterfaceImpl) ADJACENT_TO(rhs FloatRangeExpression) BoolExpression {
+	return newRangeBoolOperatorExpression(r.parent, rhs, RangeAdjacentOperator)
+}
+
+func (r *floatRangeInterfaceImpl) UNION(rhs FloatRangeExpression) FloatRangeExpression {
+	return FloatRangeExp(jet.NewBinaryOperatorExpression(r.parent, rhs, RangeUnionOperator))
+}
+
+func (r *floatRangeInterfaceImpl) INTERSECTION(rhs FloatRangeExpression) FloatRangeExpression {
+	return FloatRangeExp(jet.NewBinaryOperatorExpression(r.parent, rhs, RangeIntersectionOperator))
+}
+
+func (r *floatRangeInterfaceImpl) DIFFERENCE(rhs FloatRangeExpression) FloatRangeExpression {
+	return FloatRangeExp(jet.NewBinaryOperatorExpression(r.parent, rhs, RangeDifferenceOperator))
+}
+
+func (r *floatRangeInterfaceImpl) LOWER_BOUND() FloatExpression {
+	return FloatExp(jet.NewFunc("LOWER", []jet.Expression{r.parent}, nil))
+}
+
+func (r *floatRangeInterfaceImpl) UPPER_BOUND() FloatExpression {
+	return FloatExp(jet.NewFunc("UPPER", []jet.Expression{r.parent}, nil))
+}
+
+func (r *floatRangeInterfaceImpl) IS_EMPTY() BoolExpression {
+	return BoolExp(jet.NewFunc("ISEMPTY", []jet.Expression{r.parent}, nil))
+}
+
+func (r *floatRangeInterfaceImpl) LOWER_INC() BoolExpression {
+	return BoolExp(jet.NewFunc("LOWER_INC", []jet.Expression{r.parent}, nil))
+}
+
+func (r *floatRangeInterfaceImpl) UPPER_INC() BoolExpression {
+	return BoolExp(jet.NewFunc("UPPER_INC", []jet.Expression{r.parent}, nil))
+}
+
+func (r *floatRangeInterfaceImpl) LOWER_INF() BoolExpression {
+	return BoolExp(jet.NewFunc("LOWER_INF", []jet.Expression{r.parent}, nil))
+}
+
+func (r *floatRangeInterfaceImpl) UPPER_INF() BoolExpression {
+	return BoolExp(jet.NewFunc("UPPER_INF", []jet.Expression{r.parent}, nil))
+}
+
+type floatRangeExpressionWrapper struct {
+	floatRangeInterfaceImpl
+	jet.Expression
+}
+
+func newFloatRangeExpressionWrap(expression Expression) FloatRangeExpression {
+	rangeExpressionWrap := floatRangeExpressionWrapper{Expression: expression}
+	rangeExpressionWrap.floatRangeInterfaceImpl.parent = &rangeExpressionWrap
+	return &rangeExpressionWrap
+}
+
+// FloatRangeExp is float range expression wrapper around arbitrary expression.
+// Allows go compiler to see any expression as float range expression.
+// Does not add sql cast to generated sql builder output.
+func FloatRangeExp(expression Expression) FloatRangeExpression {
+	return newFloatRangeExpressionWrap(expression)
+}
+
+//---------------------------------------------------//
+
+// DateRangeExpression is interface for PostgreSQL daterange and datemultirange types.
+// Range and multirange of the same element type share the same expression interface,
+// because all the range operators accept both.
+type DateRangeExpression interface {
+	RangeExpression
+
+	EQ(rhs DateRangeExpression) BoolExpression
+	NOT_EQ(rhs DateRangeExpression) BoolExpression
+	IS_DISTINCT_FROM(rhs DateRangeExpression) BoolExpression
+	IS_NOT_DISTINCT_FROM(rhs DateRangeExpression) BoolExpression
+
+	LT(rhs DateRangeExpression) BoolExpression
+	LT_EQ(rhs DateRangeExpression) BoolExpression
+	GT(rhs DateRangeExpression) BoolExpression
+	GT_EQ(rhs DateRangeExpression) BoolExpression
+
+	// CONTAINS checks if this range contains rhs range (@> operator)
+	CONTAINS(rhs DateRangeExpression) BoolExpression
+	// CONTAINS_ELEMENT checks if this range contains element (@> operator)
+	CONTAINS_ELEMENT(elem DateExpression) BoolExpression
+	// IS_CONTAINED_BY checks if this range is contained by rhs range (<@ operator)
+	IS_CONTAINED_BY(rhs DateRangeExpression) BoolExpression
+	// OVERLAP checks if this range and rhs range have points in common (&& operator)
+	OVERLAP(rhs DateRangeExpression) BoolExpression
+	// STRICTLY_LEFT_OF checks if this range is strictly left of rhs range (<< operator)
+	STRICTLY_LEFT_OF(rhs DateRangeExpression) BoolExpression
+	// STRICTLY_RIGHT_OF checks if this range is strictly right of rhs range (>> operator)
+	STRICTLY_RIGHT_OF(rhs DateRangeExpression) BoolExpression
+	// NOT_EXTEND_RIGHT_OF checks if this range does not extend to the right of rhs range (&< operator)
+	NOT_EXTEND_RIGHT_OF(rhs DateRangeExpression) BoolExpression
+	// NOT_EXTEND_LEFT_OF checks if this range does not extend to the left of rhs range (&> operator)
+	NOT_EXTEND_LEFT_OF(rhs DateRangeExpression) BoolExpression
+	// ADJACENT_TO checks if this range is adjacent to rhs range (-|- operator)
+	ADJACENT_TO(rhs DateRangeExpression) BoolExpression
+
+	// UNION computes union of two ranges (+ operator)
+	UNION(rhs DateRangeExpression) DateRangeExpression
+	// INTERSECTION computes intersection of two ranges (* operator)
+	INTERSECTION(rhs DateRangeExpression) DateRangeExpression
+	// DIFFERENCE computes difference of two ranges (- operator)
+	DIFFERENCE(rhs DateRangeExpression) DateRangeExpression
+
+	// LOWER_BOUND returns lower bound of the range, or NULL if range is empty or lower bound is infinite
+	LOWER_BOUND() DateExpression
+	// UPPER_BOUND returns upper bound of the range, or NULL if range is empty or upper bound is infinite
+	UPPER_BOUND() DateExpression
+	// IS_EMPTY checks if the range is empty
+	IS_EMPTY() BoolExpression
+	// LOWER_INC checks if lower bound of the range is inclusive
+	LOWER_INC() BoolExpression
+	// UPPER_INC checks if upper bound of the range is inclusive
+	UPPER_INC() BoolExpression
+	// LOWER_INF checks if lower bound of the range is infinite
+	LOWER_INF() BoolExpression
+	// UPPER_INF checks if upper bound of the range is infinite
+	UPPER_INF() BoolExpression
+}
+
+type dateRangeInterfaceImpl struct {
+	rangeInterfaceImpl
+	parent DateRangeExpression
+}
+
+func (r *dateRangeInterfaceImpl) EQ(rhs DateRangeExpression) BoolExpression {
+	return jet.Eq(r.parent, rhs)
+}
+
+func (r *dateRangeInterfaceImpl) NOT_EQ(rhs DateRangeExpression) BoolExpression {
+	return jet.NotEq(r.parent, rhs)
+}
+
+func (r *dateRangeInterfaceImpl) IS_DISTINCT_FROM(rhs DateRangeExpression) BoolExpression {
+	return jet.IsDistinctFrom(r.parent, rhs)
+}
+
+func (r *dateRangeInterfaceImpl) IS_NOT_DISTINCT_FROM(rhs DateRangeExpression) BoolExpression {
+	return jet.IsNotDistinctFrom(r.parent, rhs)
+}
+
+func (r *dateRangeInterfaceImpl) LT(rhs DateRangeExpression) BoolExpression {
+	return jet.Lt(r.parent, rhs)
+}
+
+func (r *dateRangeInterfaceImpl) LT_EQ(rhs DateRangeExpression) BoolExpression {
+	return jet.LtEq(r.parent, rhs)
+}
+
+func (r *dateRangeInterfaceImpl) GT(rhs DateRangeExpression) BoolExpression {
+	return jet.Gt(r.parent, rhs)
+}
+
+func (r *dateRangeInterfaceImpl) GT_EQ(rhs DateRangeExpression) BoolExpression {
+	return jet.GtEq(r.parent, rhs)
+}
+
+func (r *dateRangeInterfaceImpl) CONTAINS(rhs DateRangeExpression) BoolExpression {
+	return newRangeBoolOperatorExpression(r.parent, rhs, RangeContainsOperator)
+}
+
+func (r *dateRangeInterfaceImpl) CONTAINS_ELEMENT(elem DateExpression) BoolExpression {
+	return newRangeBoolOperatorExpression(r.parent, elem, RangeContainsOperator)
+}
+
+func (r *dateRangeInterfaceImpl) IS_CONTAINED_BY(rhs DateRangeExpression) BoolExpression {
+	return newRangeBoolOperatorExpression(r.parent, rhs, RangeContainedByOperator)
+}
+
+func (r *dateRangeInterfaceImpl) OVERLAP(rhs DateRangeExpression) BoolExpression {
+	return newRangeBoolOperatorExpression(r.parent, rhs, RangeOverlapOperator)
+}
+
+func (r *dateRangeInterfaceImpl) STRICTLY_LEFT_OF(rhs DateRangeExpression) BoolExpression {
+	return newRangeBoolOperatorExpression(r.parent, rhs, RangeStrictlyLeftOperator)
+}
+
+func (r *dateRangeInterfaceImpl) STRICTLY_RIGHT_OF(rhs DateRangeExpression) BoolExpression {
+	return newRangeBoolOperatorExpression(r.parent, rhs, RangeStrictlyRightOperator)
+}
+
+func (r *dateRangeInterfaceImpl) NOT_EXTEND_RIGHT_OF(rhs DateRangeExpression) BoolExpression {
+	return newRangeBoolOperatorExpression(r.parent, rhs, RangeNotExtendRightOperator)
+}
+
+func (r *dateRangeInterfaceImpl) NOT_EXTEND_LEFT_OF(rhs DateRangeExpression) BoolExpression {
+	return newRangeBoolOperatorExpression(r.parent, rhs, RangeNotExtendLeftOperator)
+}
+
+func (r *dateRangeInterfaceImpl) ADJACENT_TO(rhs DateRangeExpression) BoolExpression {
+	return newRangeBoolOperatorExpression(r.parent, rhs, RangeAdjacentOperator)
+}
+
+func (r *dateRangeInterfaceImpl) UNION(rhs DateRangeExpression) DateRangeExpression {
+	return DateRangeExp(jet.NewBinaryOperatorExpression(r.parent, rhs, RangeUnionOperator))
+}
+
+func (r *dateRangeInterfaceImpl) INTERSECTION(rhs DateRangeExpression) DateRangeExpression {
+	return DateRangeExp(jet.NewBinaryOperatorExpression(r.parent, rhs, RangeIntersectionOperator))
+}
+
+func (r *dateRangeInterfaceImpl) DIFFERENCE(rhs DateRangeExpression) DateRangeExpression {
+	return DateRangeExp(jet.NewBinaryOperatorExpression(r.parent, rhs, RangeDifferenceOperator))
+}
+
+func (r *dateRangeInterfaceImpl) LOWER_BOUND() DateExpression {
+	return DateExp(jet.NewFunc("LOWER", []jet.Expression{r.parent}, nil))
+}
+
+func (r *dateRangeInterfaceImpl) UPPER_BOUND() DateExpression {
+	return DateExp(jet.NewFunc("UPPER", []jet.Expression{r.parent}, nil))
+}
+
+func (r *dateRangeInterfaceImpl) IS_EMPTY() BoolExpression {
+	return BoolExp(jet.NewFunc("ISEMPTY", []jet.Expression{r.parent}, nil))
+}
+
+func (r *dateRangeInterfaceImpl) LOWER_INC() BoolExpression {
+	return BoolExp(jet.NewFunc("LOWER_INC", []jet.Expression{r.parent}, nil))
+}
+
+func (r *dateRangeInterfaceImpl) UPPER_INC() BoolExpression {
+	return BoolExp(jet.NewFunc("UPPER_INC", []jet.Expression{r.parent}, nil))
+}
+
+func (r *dateRangeInterfaceImpl) LOWER_INF() BoolExpression {
+	return BoolExp(jet.NewFunc("LOWER_INF", []jet.Expression{r.parent}, nil))
+}
+
+func (r *dateRangeInterfaceImpl) UPPER_INF() BoolExpression {
+	return BoolExp(jet.NewFunc("UPPER_INF", []jet.Expression{r.parent}, nil))
+}
+
+type dateRangeExpressionWrapper struct {
+	dateRangeInterfaceImpl
+	jet.Expression
+}
+
+func newDateRangeExpressionWrap(expression Expression) DateRangeExpression {
+	rangeExpressionWrap := dateRangeExpressionWrapper{Expression: expression}
+	rangeExpressionWrap.dateRangeInterfaceImpl.parent = &rangeExpressionWrap
+	return &rangeExpressionWrap
+}
+
+// DateRangeExp is date range expression wrapper around arbitrary expression.
+// Allows go compiler to see any expression as date range expression.
+// Does not add sql cast to generated sql builder output.
+func DateRangeExp(expression Expression) DateRangeExpression {
+	return newDateRangeExpressionWrap(expression)
+}
+
+//---------------------------------------------------//
+
+// TimestampRangeExpression is interface for PostgreSQL tsrange and tsmultirange types.
+// Range and multirange of the same element type share the same expression interface,
+// because all the range operators accept both.
+type TimestampRangeExpression interface {
+	RangeExpression
+
+	EQ(rhs TimestampRangeExpression) BoolExpression
+	NOT_EQ(rhs TimestampRangeExpression) BoolExpression
+	IS_DISTINCT_FROM(rhs TimestampRangeExpression) BoolExpression
+	IS_NOT_DISTINCT_FROM(rhs TimestampRangeExpression) BoolExpression
+
+	LT(rhs TimestampRangeExpression) BoolExpression
+	LT_EQ(rhs TimestampRangeExpression) BoolExpression
+	GT(rhs TimestampRangeExpression) BoolExpression
+	GT_EQ(rhs TimestampRangeExpression) BoolExpression
+
+	// CONTAINS checks if this range contains rhs range (@> operator)
+	CONTAINS(rhs TimestampRangeExpression) BoolExpression
+	// CONTAINS_ELEMENT checks if this range contains element (@> operator)
+	CONTAINS_ELEMENT(elem TimestampExpression) BoolExpression
+	// IS_CONTAINED_BY checks if this range is contained by rhs range (<@ operator)
+	IS_CONTAINED_BY(rhs TimestampRangeExpression) BoolExpression
+	// OVERLAP checks if this range and rhs range have points in common (&& operator)
+	OVERLAP(rhs TimestampRangeExpression) BoolExpression
+	// STRICTLY_LEFT_OF checks if this range is strictly left of rhs range (<< operator)
+	STRICTLY_LEFT_OF(rhs TimestampRangeExpression) BoolExpression
+	// STRICTLY_RIGHT_OF checks if this range is strictly right of rhs range (>> operator)
+	STRICTLY_RIGHT_OF(rhs TimestampRangeExpression) BoolExpression
+	// NOT_EXTEND_RIGHT_OF checks if this range does not extend to the right of rhs range (&< operator)
+	NOT_EXTEND_RIGHT_OF(rhs TimestampRangeExpression) BoolExpression
+	// NOT_EXTEND_LEFT_OF checks if this range does not extend to the left of rhs range (&> operator)
+	NOT_EXTEND_LEFT_OF(rhs TimestampRangeExpression) BoolExpression
+	// ADJACENT_TO checks if this range is adjacent to rhs range (-|- operator)
+	ADJACENT_TO(rhs TimestampRangeExpression) BoolExpression
+
+	// UNION computes union of two ranges (+ operator)
+	UNION(rhs TimestampRangeExpression) TimestampRangeExpression
+	// INTERSECTION computes intersection of two ranges (* operator)
+	INTERSECTION(rhs TimestampRangeExpression) TimestampRangeExpression
+	// DIFFERENCE computes difference of two ranges (- operator)
+	DIFFERENCE(rhs TimestampRangeExpression) TimestampRangeExpression
+
+	// LOWER_BOUND returns lower bound of the range, or NULL if range is empty or lower bound is infinite
+	LOWER_BOUND() TimestampExpression
+	// UPPER_BOUND returns upper bound of the range, or NULL if range is empty or upper bound is infinite
+	UPPER_BOUND() TimestampExpression
+	// IS_EMPTY checks if the range is empty
+	IS_EMPTY() BoolExpression
+	// LOWER_INC checks if lower bound of the range is inclusive
+	LOWER_INC() BoolExpression
+	// UPPER_INC checks if upper bound of the range is inclusive
+	UPPER_INC() BoolExpression
+	// LOWER_INF checks if lower bound of the range is infinite
+	LOWER_INF() BoolExpression
+	// UPPER_INF checks if upper bound of the range is infinite
+	UPPER_INF() BoolExpression
+}
+
+type timestampRangeInterfaceImpl struct {
+	rangeInterfaceImpl
+	parent TimestampRangeExpression
+}
+
+func (r *timestampRangeInterfaceImpl) EQ(rhs TimestampRangeExpression) BoolExpression {
+	return jet.Eq(r.parent, rhs)
+}
+
+func (r *timestampRangeInterfaceImpl) NOT_EQ(rhs TimestampRangeExpression) BoolExpression {
+	return jet.NotEq(r.parent, rhs)
+}
+
+func (r *timestampRangeInterfaceImpl) IS_DISTINCT_FROM(rhs TimestampRangeExpression) BoolExpression {
+	return jet.IsDistinctFrom(r.parent, rhs)
+}
+
+func (r *timestampRangeInterfaceImpl) IS_NOT_DISTINCT_FROM(rhs TimestampRangeExpression) BoolExpression {
+	return jet.IsNotDistinctFrom(r.parent, rhs)
+}
+
+func (r *timestampRangeInterfaceImpl) LT(rhs TimestampRangeExpression) BoolExpression {
+	return jet.Lt(r.parent, rhs)
+}
+
+func (r *timestampRangeInterfaceImpl) LT_EQ(rhs TimestampRangeExpression) BoolExpression {
+	return jet.LtEq(r.parent, rhs)
+}
+
+func (r *timestampRangeInterfaceImpl) GT(rhs TimestampRangeExpression) BoolExpression {
+	return jet.Gt(r.parent, rhs)
+}
+
+func (r *timestampRangeInterfaceImpl) GT_EQ(rhs TimestampRangeExpression) BoolExpression {
+	return jet.GtEq(r.parent, rhs)
+}
+
+func (r *timestampRangeInterfaceImpl) CONTAINS(rhs TimestampRangeExpression) BoolExpression {
+	return newRangeBoolOperatorExpression(r.parent, rhs, RangeContainsOperator)
+}
+
+func (r *timestampRangeInterfaceImpl) CONTAINS_ELEMENT(elem TimestampExpression) BoolExpression {
+	return newRangeBoolOperatorExpression(r.parent, elem, RangeContainsOperator)
+}
+
+func (r *timestampRangeInterfaceImpl) IS_CONTAINED_BY(rhs TimestampRangeExpression) BoolExpression {
+	return newRangeBoolOperatorExpression(r.parent, rhs, RangeContainedByOperator)
+}
+
+func (r *timestampRangeInterfaceImpl) OVERLAP(rhs TimestampRangeExpression) BoolExpression {
+	return newRangeBoolOperatorExpression(r.parent, rhs, RangeOverlapOperator)
+}
+
+func (r *timestampRangeInterfaceImpl) STRICTLY_LEFT_OF(rhs TimestampRangeExpression) BoolExpression {
+	return newRangeBoolOperatorExpression(r.parent, rhs, RangeStrictlyLeftOperator)
+}
+
+func (r *timestampRangeInterfaceImpl) STRICTLY_RIGHT_OF(rhs TimestampRangeExpression) BoolExpression {
+	return newRangeBoolOperatorExpression(r.parent, rhs, RangeStrictlyRightOperator)
+}
+
+func (r *timestampRangeInterfaceImpl) NOT_EXTEND_RIGHT_OF(rhs TimestampRangeExpression) BoolExpression {
+	return newRangeBoolOperatorExpression(r.parent, rhs, RangeNotExtendRightOperator)
+}
+
+func (r *timestampRangeInterfaceImpl) NOT_EXTEND_LEFT_OF(rhs TimestampRangeExpression) BoolExpression {
+	return newRangeBoolOperatorExpression(r.parent, rhs, RangeNotExtendLeftOperator)
+}
+
+func (r *timestampRangeInterfaceImpl) ADJACENT_TO(rhs TimestampRangeExpression) BoolExpression {
+	return newRangeBoolOperatorExpression(r.parent, rhs, RangeAdjacentOperator)
+}
+
+func (r *timestampRangeInterfaceImpl) UNION(rhs TimestampRangeExpression) TimestampRangeExpression {
+	return TimestampRangeExp(jet.NewBinaryOperatorExpression(r.parent, rhs, RangeUnionOperator))
+}
+
+func (r *timestampRangeInterfaceImpl) INTERSECTION(rhs TimestampRangeExpression) TimestampRangeExpression {
+	return TimestampRangeExp(jet.NewBinaryOperatorExpression(r.parent, rhs, RangeIntersectionOperator))
+}
+
+func (r *timestampRangeInterfaceImpl) DIFFERENCE(rhs TimestampRangeExpression) TimestampRangeExpression {
+	return TimestampRangeExp(jet.NewBinaryOperatorExpression(r.parent, rhs, RangeDifferenceOperator))
+}
+
+func (r *timestampRangeInterfaceImpl) LOWER_BOUND() TimestampExpression {
+	return TimestampExp(jet.NewFunc("LOWER", []jet.Expression{r.parent}, nil))
+}
+
+func (r *timestampRangeInterfaceImpl) UPPER_BOUND() TimestampExpression {
+	return TimestampExp(jet.NewFunc("UPPER", []jet.Expression{r.parent}, nil))
+}
+
+func (r *timestampRangeInterfaceImpl) IS_EMPTY() BoolExpression {
+	return BoolExp(jet.NewFunc("ISEMPTY", []jet.Expression{r.parent}, nil))
+}
+
+func (r *timestampRangeInterfaceImpl) LOWER_INC() BoolExpression {
+	return BoolExp(jet.NewFunc("LOWER_INC", []jet.Expression{r.parent}, nil))
+}
+
+func (r *timestampRangeInterfaceImpl) UPPER_INC() BoolExpression {
+	return BoolExp(jet.NewFunc("UPPER_INC", []jet.Expression{r.parent}, nil))
+}
+
+func (r *timestampRangeInterfaceImpl) LOWER_INF() BoolExpression {
+	return BoolExp(jet.NewFunc("LOWER_INF", []jet.Expression{r.parent}, nil))
+}
+
+func (r *timestampRangeInterfaceImpl) UPPER_INF() BoolExpression {
+	return BoolExp(jet.NewFunc("UPPER_INF", []jet.Expression{r.parent}, nil))
+}
+
+type timestampRangeExpressionWrapper struct {
+	timestampRangeInterfaceImpl
+	jet.Expression
+}
+
+func newTimestampRangeExpressionWrap(expression Expression) TimestampRangeExpression {
+	rangeExpressionWrap := timestampRangeExpressionWrapper{Expression: expression}
+	rangeExpressionWrap.timestampRangeInterfaceImpl.parent = &rangeExpressionWrap
+	return &rangeExpressionWrap
+}
+
+// TimestampRangeExp is timestamp range expression wrapper around arbitrary expression.
+// Allows go compiler to see any expression as timestamp range expression.
+// Does not add sql cast to generated sql builder output.
+func TimestampRangeExp(expression Expression) TimestampRangeExpression {
+	return newTimestampRangeExpressionWrap(expression)
+}
+
+//---------------------------------------------------//
+
+// TimestampzRangeExpression is interface for PostgreSQL tstzrange and tstzmultirange types.
+// Range and multirange of the same element type share the same expression interface,
+// because all the range operators accept both.
+type TimestampzRangeExpression interface {
+	RangeExpression
+
+	EQ(rhs TimestampzRangeExpression) BoolExpression
+	NOT_EQ(rhs TimestampzRangeExpression) BoolExpression
+	IS_DISTINCT_FROM(rhs TimestampzRangeExpression) BoolExpression
+	IS_NOT_DISTINCT_FROM(rhs TimestampzRangeExpression) BoolExpression
+
+	LT(rhs TimestampzRangeExpression) BoolExpression
+	LT_EQ(rhs TimestampzRangeExpression) BoolExpression
+	GT(rhs TimestampzRangeExpression) BoolExpression
+	GT_EQ(rhs TimestampzRangeExpression) BoolExpression
+
+	// CONTAINS checks if this range contains rhs range (@> operator)
+	CONTAINS(rhs TimestampzRangeExpression) BoolExpression
+	// CONTAINS_ELEMENT checks if this range contains element (@> operator)
+	CONTAINS_ELEMENT(elem TimestampzExpression) BoolExpression
+	// IS_CONTAINED_BY checks if this range is contained by rhs range (<@ operator)
+	IS_CONTAINED_BY(rhs TimestampzRangeExpression) BoolExpression
+	// OVERLAP checks if this range and rhs range have points in common (&& operator)
+	OVERLAP(rhs TimestampzRangeExpression) BoolExpression
+	// STRICTLY_LEFT_OF checks if this range is strictly left of rhs range (<< operator)
+	STRICTLY_LEFT_OF(rhs TimestampzRangeExpression) BoolExpression
+	// STRICTLY_RIGHT_OF checks if this range is strictly right of rhs range (>> operator)
+	STRICTLY_RIGHT_OF(rhs TimestampzRangeExpression) BoolExpression
+	// NOT_EXTEND_RIGHT_OF checks if this range does not extend to the right of rhs range (&< operator)
+	NOT_EXTEND_RIGHT_OF(rhs TimestampzRangeExpression) BoolExpression
+	// NOT_EXTEND_LEFT_OF checks if this range does not extend to the left of rhs range (&> operator)
+	NOT_EXTEND_LEFT_OF(rhs TimestampzRangeExpression) BoolExpression
+	// ADJACENT_TO checks if this range is adjacent to rhs range (-|- operator)
+	ADJACENT_TO(rhs TimestampzRangeExpression) BoolExpression
+
+	// UNION computes union of two ranges (+ operator)
+	UNION(rhs TimestampzRangeExpression) TimestampzRangeExpression
+	// INTERSECTION computes intersection of two ranges (* operator)
+	INTERSECTION(rhs TimestampzRangeExpression) TimestampzRangeExpression
+	// DIFFERENCE computes difference of two ranges (- operator)
+	DIFFERENCE(rhs TimestampzRangeExpression) TimestampzRangeExpression
+
+	// LOWER_BOUND returns lower bound of the range, or NULL if range is empty or lower bound is infinite
+	LOWER_BOUND() TimestampzExpression
+	// UPPER_BOUND returns upper bound of the range, or NULL if range is empty or upper bound is infinite
+	UPPER_BOUND() TimestampzExpression
+	// IS_EMPTY checks if the range is empty
+	IS_EMPTY() BoolExpression
+	// LOWER_INC checks if lower bound of the range is inclusive
+	LOWER_INC() BoolExpression
+	// UPPER_INC checks if upper bound of the range is inclusive
+	UPPER_INC() BoolExpression
+	// LOWER_INF checks if lower bound of the range is infinite
+	LOWER_INF() BoolExpression
+	// UPPER_INF checks if upper bound of the range is infinite
+	UPPER_INF() BoolExpression
+}
+
+type timestampzRangeInterfaceImpl struct {
+	rangeInterfaceImpl
+	parent TimestampzRangeExpression
+}
+
+func (r *timestampzRangeInterfaceImpl) EQ(rhs TimestampzRangeExpression) BoolExpression {
+	return jet.Eq(r.parent, rhs)
+}
+
+func (r *timestampzRangeInterfaceImpl) NOT_EQ(rhs TimestampzRangeExpression) BoolExpression {
+	return jet.NotEq(r.parent, rhs)
+}
+
+func (r *timestampzRangeInterfaceImpl) IS_DISTINCT_FROM(rhs TimestampzRangeExpression) BoolExpression {
+	return jet.IsDistinctFrom(r.parent, rhs)
+}
+
+func (r *timestampzRangeInterfaceImpl) IS_NOT_DISTINCT_FROM(rhs TimestampzRangeExpression) BoolExpression {
+	return jet.IsNotDistinctFrom(r.parent, rhs)
+}
+
+func (r *timestampzRangeInterfaceImpl) LT(rhs TimestampzRangeExpression) BoolExpression {
+	return jet.Lt(r.parent, rhs)
+}
+
+func (r *timestampzRangeInterfaceImpl) LT_EQ(rhs TimestampzRangeExpression) BoolExpression {
+	return jet.LtEq(r.parent, rhs)
+}
+
+func (r *timestampzRangeInterfaceImpl) GT(rhs TimestampzRangeExpression) BoolExpression {
+	return jet.Gt(r.parent, rhs)
+}
+
+func (r *timestampzRangeInterfaceImpl) GT_EQ(rhs TimestampzRangeExpression) BoolExpression {
+	return jet.GtEq(r.parent, rhs)
+}
+
+func (r *timestampzRangeInterfaceImpl) CONTAINS(rhs TimestampzRangeExpression) BoolExpression {
+	return newRangeBoolOperatorExpression(r.parent, rhs, RangeContainsOperator)
+}
+
+func (r *timestampzRangeInterfaceImpl) CONTAINS_ELEMENT(elem TimestampzExpression) BoolExpression {
+	return newRangeBoolOperatorExpression(r.parent, elem, RangeContainsOperator)
+}
+
+func (r *timestampzRangeInterfaceImpl) IS_CONTAINED_BY(rhs TimestampzRangeExpression) BoolExpression {
+	return newRangeBoolOperatorExpression(r.parent, rhs, RangeContainedByOperator)
+}
+
+func (r *timestampzRangeInterfaceImpl) OVERLAP(rhs TimestampzRangeExpression) BoolExpression {
+	return newRangeBoolOperatorExpression(r.parent, rhs, RangeOverlapOperator)
+}
+
+func (r *timestampzRangeInterfaceImpl) STRICTLY_LEFT_OF(rhs TimestampzRangeExpression) BoolExpression {
+	return newRangeBoolOperatorExpression(r.parent, rhs, RangeStrictlyLeftOperator)
+}
+
+func (r *timestampzRangeInterfaceImpl) STRICTLY_RIGHT_OF(rhs TimestampzRangeExpression) BoolExpression {
+	return newRangeBoolOperatorExpression(r.parent, rhs, RangeStrictlyRightOperator)
+}
+
+func (r *timestampzRangeInterfaceImpl) NOT_EXTEND_RIGHT_OF(rhs TimestampzRangeExpression) BoolExpression {
+	return newRangeBoolOperatorExpression(r.parent, rhs, RangeNotExtendRightOperator)
+}
+
+func (r *timestampzRangeInterfaceImpl) NOT_EXTEND_LEFT_OF(rhs TimestampzRangeExpression) BoolExpression {
+	return newRangeBoolOperatorExpression(r.parent, rhs, RangeNotExtendLeftOperator)
+}
+
+func (r *timestampzRangeInterfaceImpl) ADJACENT_TO(rhs TimestampzRangeExpression) BoolExpression {
+	return newRangeBoolOperatorExpression(r.parent, rhs, RangeAdjacentOperator)
+}
+
+func (r *timestampzRangeInterfaceImpl) UNION(rhs TimestampzRangeExpression) TimestampzRangeExpression {
+	return TimestampzRangeExp(jet.NewBinaryOperatorExpression(r.parent, rhs, RangeUnionOperator))
+}
+
+func (r *timestampzRangeInterfaceImpl) INTERSECTION(rhs TimestampzRangeExpression) TimestampzRangeExpression {
+	return TimestampzRangeExp(jet.NewBinaryOperatorExpression(r.parent, rhs, RangeIntersectionOperator))
+}
+
+func (r *timestampzRangeInterfaceImpl) DIFFERENCE(rhs TimestampzRangeExpression) TimestampzRangeExpression {
+	return TimestampzRangeExp(jet.NewBinaryOperatorExpression(r.parent, rhs, RangeDifferenceOperator))
+}
+
+func (r *timestampzRangeInterfaceImpl) LOWER_BOUND() TimestampzExpression {
+	return TimestampzExp(jet.NewFunc("LOWER", []jet.Expression{r.parent}, nil))
+}
+
+func (r *timestampzRangeInterfaceImpl) UPPER_BOUND() TimestampzExpression {
+	return TimestampzExp(jet.NewFunc("UPPER", []jet.Expression{r.parent}, nil))
+}
+
+func (r *timestampzRangeInterfaceImpl) IS_EMPTY() BoolExpression {
+	return BoolExp(jet.NewFunc("ISEMPTY", []jet.Expression{r.parent}, nil))
+}
+
+func (r *timestampzRangeInterfaceImpl) LOWER_INC() BoolExpression {
+	return BoolExp(jet.NewFunc("LOWER_INC", []jet.Expression{r.parent}, nil))
+}
+
+func (r *timestampzRangeInterfaceImpl) UPPER_INC() BoolExpression {
+	return BoolExp(jet.NewFunc("UPPER_INC", []jet.Expression{r.parent}, nil))
+}
+
+func (r *timestampzRangeInterfaceImpl) LOWER_INF() BoolExpression {
+	return BoolExp(jet.NewFunc("LOWER_INF", []jet.Expression{r.parent}, nil))
+}
+
+func (r *timestampzRangeInterfaceImpl) UPPER_INF() BoolExpression {
+	return BoolExp(jet.NewFunc("UPPER_INF", []jet.Expression{r.parent}, nil))
+}
+
+type timestampzRangeExpressionWrapper struct {
+	timestampzRangeInterfaceImpl
+	jet.Expression
+}
+
+func newTimestampzRangeExpressionWrap(expression Expression) TimestampzRangeExpression {
+	rangeExpressionWrap := timestampzRangeExpressionWrapper{Expression: expression}
+	rangeExpressionWrap.timestampzRangeInterfaceImpl.parent = &rangeExpressionWrap
+	return &rangeExpressionWrap
+}
+
+// TimestampzRangeExp is timestampz range expression wrapper around arbitrary expression.
+// Allows go compiler to see any expression as timestampz range expression.
+// Does not add sql cast to generated sql builder output.
+func TimestampzRangeExp(expression Expression) TimestampzRangeExpression {
+	return newTimestampzRangeExpressionWrap(expression)
+}
@@ -0,0 +1,58 @@
+package postgres
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRangeOperators(t *testing.T) {
+	assertSerialize(t, table3ColIntRange.EQ(INT4RANGE(Int(1), Int(5))), "(table3.col_int_range = INT4RANGE($1::integer, $2::integer))", int64(1), int64(5))
+	assertSerialize(t, table3ColIntRange.LT(IntegerRangeExp(Raw("r"))), "(table3.col_int_range < (r))")
+	assertSerialize(t, table3ColIntRange.CONTAINS(INT4RANGE(Int(1), Int(5), "[]")),
+		"(table3.col_int_range @> INT4RANGE($1::integer, $2::integer, $3::text))", int64(1), int64(5), "[]")
+	assertSerialize(t, table3ColIntRange.CONTAINS_ELEMENT(table3ColInt), "(table3.col_int_range @> table3.col_int)")
+	assertSerialize(t, table3ColIntRange.IS_CONTAINED_BY(table3ColIntRange), "(table3.col_int_range <@ table3.col_int_range)")
+	assertSerialize(t, table3ColIntRange.OVERLAP(table3ColIntRange), "(table3.col_int_range && table3.col_int_range)")
+	assertSerialize(t, table3ColIntRange.STRICTLY_LEFT_OF(table3ColIntRange), "(table3.col_int_range << table3.col_int_range)")
+	assertSerialize(t, table3ColIntRange.STRICTLY_RIGHT_OF(table3ColIntRange), "(table3.col_int_range >> table3.col_int_range)")
+	assertSerialize(t, table3ColIntRange.NOT_EXTEND_RIGHT_OF(table3ColIntRange), "(table3.col_int_range &< table3.col_int_range)")
+	assertSerialize(t, table3ColIntRange.NOT_EXTEND_LEFT_OF(table3ColIntRange), "(table3.col_int_range &> table3.col_int_range)")
+	assertSerialize(t, table3ColIntRange.ADJACENT_TO(table3ColIntRange), "(table3.col_int_range -|- table3.col_int_range)")
+	assertSerialize(t, table3ColIntRange.UNION(table3ColIntRange), "(table3.col_int_range + table3.col_int_range)")
+	assertSerialize(t, table3ColIntRange.INTERSECTION(table3ColIntRange), "(table3.col_int_range * table3.col_int_range)")
+	assertSerialize(t, table3ColIntRange.DIFFERENCE(table3ColIntRange), "(table3.col_int_range - table3.col_int_range)")
+}
+
+func TestRangeFunctions(t *testing.T) {
+	assertSerialize(t, table3ColIntRange.LOWER_BOUND().ADD(Int(1)), "(LOWER(table3.col_int_range) + $1)", int64(1))
+	assertSerialize(t, table3ColIntRange.UPPER_BOUND(), "UPPER(table3.col_int_range)")
+	assertSerialize(t, table3ColIntRange.IS_EMPTY(), "ISEMPTY(table3.col_int_range)")
+	assertSerialize(t, table3ColIntRange.LOWER_INC(), "LOWER_INC(table3.col_int_range)")
+	assertSerialize(t, table3ColIntRange.UPPER_INC(), "UPPER_INC(table3.col_int_range)")
+	assertSerialize(t, table3ColIntRange.LOWER_INF(), "LOWER_INF(table3.col_int_range)")
+	assertSerialize(t, table3ColIntRange.UPPER_INF(), "UPPER_INF(table3.col_int_range)")
+	assertSerialize(t, table3ColTimestampzRange.LOWER_BOUND().LT(table1ColTimestampz),
+		"(LOWER(table3.col_timestampz_range) < table1.col_timestampz)")
+}
+
+func TestRangeConstructors(t *testing.T) {
+	date := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	assertSerialize(t, INT8RANGE(Int(1), table3ColInt), "INT8RANGE($1::bigint, table3.col_int)", int64(1))
+	assertSerialize(t, NUMRANGE(Float(1.5), Float(2.5), "(]"), "NUMRANGE($1::numeric, $2::numeric, $3::text)", 1.5, 2.5, "(]")
+	assertSerialize(t, DATERANGE(DateT(date), DateExp(NULL)), "DATERANGE($1::date, NULL)", date)
+	assertSerialize(t, TSRANGE(table1ColTimestamp, table1ColTimestamp), "TSRANGE(table1.col_timestamp, table1.col_timestamp)")
+	assertSerialize(t, TSTZRANGE(table1ColTimestampz, TimestampzT(date), "[]"),
+		"TSTZRANGE(table1.col_timestampz, $1::timestamp with time zone, $2::text)", date, "[]")
+	assertSerialize(t, INT4MULTIRANGE(INT4RANGE(Int(1), Int(2)), table3ColIntRange),
+		"INT4MULTIRANGE(INT4RANGE($1::integer, $2::integer), table3.col_int_range)", int64(1), int64(2))
+	assertSerialize(t, TSTZMULTIRANGE(), "TSTZMULTIRANGE()")
+}
+
+func TestRangeColumn(t *testing.T) {
+	assertSerialize(t, table3ColIntRange.SET(INT4RANGE(Int(1), Int(2))),
+		"col_int_range = INT4RANGE($1::integer, $2::integer)", int64(1), int64(2))
+
+	subQuery := SELECT(table3ColIntRange).FROM(table3).AsTable("sub")
+	assertSerialize(t, table3ColIntRange.From(subQuery), `sub."table3.col_int_range"`)
+}
@@ -0,0 +1,616 @@
+package postgres
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-jet/jet/v2/internal/3rdparty/pq"
+)
+
+// Int32Range is model type for PostgreSQL int4range values. Nil bound means the range is unbounded on that side.
+type Int32Range struct {
+	Lower          *int32
+	Upper          *int32
+	LowerInclusive bool
+	UpperInclusive bool
+	Empty          bool
+}
+
+// Scan implements sql.Scanner interface
+func (r *Int32Range) Scan(value interface{}) error {
+	bounds, err := parseRange(value)
+	if err != nil {
+		return err
+	}
+
+	*r = Int32Range{LowerInclusive: bounds.lowerInc, UpperInclusive: bounds.upperInc, Empty: bounds.empty}
+
+	if r.Lower, err = parseInt32Bound(bounds.lower); err != nil {
+		return err
+	}
+	r.Upper, err = parseInt32Bound(bounds.upper)
+
+	return err
+}
+
+// Value implements driver.Valuer interface
+func (r Int32Range) Value() (driver.Value, error) {
+	bounds := rangeBounds{lowerInc: r.LowerInclusive, upperInc: r.UpperInclusive, empty: r.Empty}
+
+	if r.Lower != nil {
+		bounds.lower = stringPtr(strconv.FormatInt(int64(*r.Lower), 10))
+	}
+	if r.Upper != nil {
+		bounds.upper = stringPtr(strconv.FormatInt(int64(*r.Upper), 10))
+	}
+
+	return bounds.String(), nil
+}
+
+// Int64Range is model type for PostgreSQL int8range values. Nil bound means the range is unbounded on that side.
+type Int64Range struct {
+	Lower          *int64
+	Upper          *int64
+	LowerInclusive bool
+	UpperInclusive bool
+	Empty          bool
+}
+
+// Scan implements sql.Scanner interface
+func (r *Int64Range) Scan(value interface{}) error {
+	bounds, err := parseRange(value)
+	if err != nil {
+		return err
+	}
+
+	*r = Int64Range{LowerInclusive: bounds.lowerInc, UpperInclusive: bounds.upperInc, Empty: bounds.empty}
+
+	if r.Lower, err = parseInt64Bound(bounds.lower); err != nil {
+		return err
+	}
+	r.Upper, err = parseInt64Bound(bounds.upper)
+
+	return err
+}
+
+// Value implements driver.Valuer interface
+func (r Int64Range) Value() (driver.Value, error) {
+	bounds := rangeBounds{lowerInc: r.LowerInclusive, upperInc: r.UpperInclusive, empty: r.Empty}
+
+	if r.Lower != nil {
+		bounds.lower = stringPtr(strconv.FormatInt(*r.Lower, 10))
+	}
+	if r.Upper != nil {
+		bounds.upper = stringPtr(strconv.FormatInt(*r.Upper, 10))
+	}
+
+	return bounds.String(), nil
+}
+
+// Float64Range is model type for PostgreSQL numrange values. Nil bound means the range is unbounded on that side.
+type Float64Range struct {
+	Lower          *float64
+	Upper          *float64
+	LowerInclusive bool
+	UpperInclusive bool
+	Empty          bool
+}
+
+// Scan implements sql.Scanner interface
+func (r *Float64Range) Scan(value interface{}) error {
+	bounds, err := parseRange(value)
+	if err != nil {
+		return err
+	}
+
+	*r = Float64Range{LowerInclusive: bounds.lowerInc, UpperInclusive: bounds.upperInc, Empty: bounds.empty}
+
+	if r.Lower, err = parseFloat64Bound(bounds.lower); err != nil {
+		return err
+	}
+	r.Upper, err = parseFloat64Bound(bounds.upper)
+
+	return err
+}
+
+// Value implements driver.Valuer interface
+func (r Float64Range) Value() (driver.Value, error) {
+	bounds := rangeBounds{lowerInc: r.LowerInclusive, upperInc: r.UpperInclusive, empty: r.Empty}
+
+	if r.Lower != nil {
+		bounds.lower = stringPtr(strconv.FormatFloat(*r.Lower, 'f', -1, 64))
+	}
+	if r.Upper != nil {
+		bounds.upper = stringPtr(strconv.FormatFloat(*r.Upper, 'f', -1, 64))
+	}
+
+	return bounds.String(), nil
+}
+
+// TimeRange is model type for PostgreSQL daterange, tsrange and tstzrange values. Nil bound means the range is
+// unbounded on that side.
+type TimeRange struct {
+	Lower          *time.Time
+	Upper          *time.Time
+	LowerInclusive bool
+	UpperInclusive bool
+	Empty          bool
+}
+
+// Scan implements sql.Scanner interface
+func (r *TimeRange) Scan(value interface{}) error {
+	bounds, err := parseRange(value)
+	if err != nil {
+		return err
+	}
+
+	*r = TimeRange{LowerInclusive: bounds.lowerInc, UpperInclusive: bounds.upperInc, Empty: bounds.empty}
+
+	if r.Lower, err = parseTimeBound(bounds.lower); err != nil {
+		return err
+	}
+	r.Upper, err = parseTimeBound(bounds.upper)
+
+	return err
+}
+
+// Value implements driver.Valuer interface
+func (r TimeRange) Value() (driver.Value, error) {
+	bounds := rangeBounds{lowerInc: r.LowerInclusive, upperInc: r.UpperInclusive, empty: r.Empty}
+
+	if r.Lower != nil {
+		bounds.lower = stringPtr(string(pq.FormatTimestamp(*r.Lower)))
+	}
+	if r.Upper != nil {
+		bounds.upper = stringPtr(string(pq.FormatTimestamp(*r.Upper)))
+	}
+
+	return bounds.String(), nil
+}
+
+// Int32Multirange is model type for PostgreSQL int4multirange values.
+type Int32Multirange []Int32Range
+
+// Scan implements sql.Scanner interface
+func (m *Int32Multirange) Scan(value interface{}) error {
+	ranges, err := parseMultirange(value)
+	if err != nil {
+		return err
+	}
+
+	*m = make(Int32Multirange, len(ranges))
+
+	for i, rangeText := range ranges {
+		if err := (*m)[i].Scan(rangeText); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Value implements driver.Valuer interface
+func (m Int32Multirange) Value() (driver.Value, error) {
+	ranges := make([]driver.Valuer, len(m))
+
+	for i := range m {
+		ranges[i] = m[i]
+	}
+
+	return multirangeValue(ranges)
+}
+
+// Int64Multirange is model type for PostgreSQL int8multirange values.
+type Int64Multirange []Int64Range
+
+// Scan implements sql.Scanner interface
+func (m *Int64Multirange) Scan(value interface{}) error {
+	ranges, err := parseMultirange(value)
+	if err != nil {
+		return err
+	}
+
+	*m = make(Int64Multirange, len(ranges))
+
+	for i, rangeText := range ranges {
+		if err := (*m)[i].Scan(rangeText); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Value implements driver.Valuer interface
+func (m Int64Multirange) Value() (driver.Value, error) {
+	ranges := make([]driver.Valuer, len(m))
+
+	for i := range m {
+		ranges[i] = m[i]
+	}
+
+	return multirangeValue(ranges)
+}
+
+// Float64Multirange is model type for PostgreSQL nummultirange values.
+type Float64Multirange []Float64Range
+
+// Scan implements sql.Scanner interface
+func (m *Float64Multirange) Scan(value interface{}) error {
+	ranges, err := parseMultirange(value)
+	if err != nil {
+		return err
+	}
+
+	*m = make(Float64Multirange, len(ranges))
+
+	for i, rangeText := range ranges {
+		if err := (*m)[i].Scan(rangeText); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Value implements driver.Valuer interface
+func (m Float64Multirange) Value() (driver.Value, error) {
+	ranges := make([]driver.Valuer, len(m))
+
+	for i := range m {
+		ranges[i] = m[i]
+	}
+
+	return multirangeValue(ranges)
+}
+
+// TimeMultirange is model type for PostgreSQL datemultirange, tsmultirange and tstzmultirange values.
+type TimeMultirange []TimeRange
+
+// Scan implements sql.Scanner interface
+func (m *TimeMultirange) Scan(value interface{}) error {
+	ranges, err := parseMultirange(value)
+	if err != nil {
+		return err
+	}
+
+	*m = make(TimeMultirange, len(ranges))
+
+	for i, rangeText := range ranges {
+		if err := (*m)[i].Scan(rangeText); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Value implements driver.Valuer interface
+func (m TimeMultirange) Value() (driver.Value, error) {
+	ranges := make([]driver.Valuer, len(m))
+
+	for i := range m {
+		ranges[i] = m[i]
+	}
+
+	return multirangeValue(ranges)
+}
+
+//------------------------------------------------------//
+
+const emptyRange = "empty"
+
+// rangeBounds is text representation of range value bounds
+type rangeBounds struct {
+	lower, upper       *string
+	lowerInc, upperInc bool
+	empty              bool
+}
+
+func (r rangeBounds) String() string {
+	if r.empty {
+		return emptyRange
+	}
+
+	var b strings.Builder
+
+	if r.lowerInc && r.lower != nil {
+		b.WriteByte('[')
+	} else {
+		b.WriteByte('(')
+	}
+
+	writeRangeBound(&b, r.lower)
+	b.WriteByte(',')
+	writeRangeBound(&b, r.upper)
+
+	if r.upperInc && r.upper != nil {
+		b.WriteByte(']')
+	} else {
+		b.WriteByte(')')
+	}
+
+	return b.String()
+}
+
+func writeRangeBound(b *strings.Builder, bound *string) {
+	if bound == nil {
+		return
+	}
+
+	b.WriteByte('"')
+	for _, c := range *bound {
+		if c == '"' || c == '\\' {
+			b.WriteByte('\\')
+		}
+		b.WriteRune(c)
+	}
+	b.WriteByte('"')
+}
+
+// parseRange parses PostgreSQL text representation of range value, for instance [1,5), ("2020-01-01",) or empty.
+// NULL value is parsed as empty range bounds.
+func parseRange(value interface{}) (rangeBounds, error) {
+	var text string
+
+	switch v := value.(type) {
+	case nil:
+		return rangeBounds{}, nil
+	case string:
+		text = v
+	case []byte:
+		text = string(v)
+	default:
+		return rangeBounds{}, fmt.Errorf("jet: can't scan range from %T", value)
+	}
+
+	text = strings.TrimSpace(text)
+
+	if strings.EqualFold(text, emptyRange) {
+		return rangeBounds{empty: true}, nil
+	}
+
+	if len(text) < 3 || (text[0] != '[' && text[0] != '(') || (text[len(text)-1] != ']' && text[len(text)-1] != ')') {
+		return rangeBounds{}, fmt.Errorf("jet: invalid range format %q", text)
+	}
+
+	ret := rangeBounds{
+		lowerInc: text[0] == '[',
+		upperInc: text[len(text)-1] == ']',
+	}
+
+	lower, next, err := parseRangeBound(text, 1)
+	if err != nil {
+		return rangeBounds{}, err
+	}
+
+	if next >= len(text) || text[next] != ',' {
+		return rangeBounds{}, fmt.Errorf("jet: invalid range format %q", text)
+	}
+
+	upper, next, err := parseRangeBound(text, next+1)
+	if err != nil {
+		return rangeBounds{}, err
+	}
+
+	if next != len(text)-1 {
+		return rangeBounds{}, fmt.Errorf("jet: invalid range format %q", text)
+	}
+
+	ret.lower, ret.upper = lower, upper
+
+	return ret, nil
+}
+
+// parseMultirange splits PostgreSQL text representation of multirange value, for instance {[1,3),[5,7)}, into
+// text representations of the ranges. NULL value is parsed as empty list of ranges.
+func parseMultirange(value interface{}) ([]string, error) {
+	var text string
+
+	switch v := value.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		text = v
+	case []byte:
+		text = string(v)
+	default:
+		return nil, fmt.Errorf("jet: can't scan multirange from %T", value)
+	}
+
+	text = strings.TrimSpace(text)
+
+	if len(text) < 2 || text[0] != '{' || text[len(text)-1] != '}' {
+		return nil, fmt.Errorf("jet: invalid multirange format %q", text)
+	}
+
+	var ranges []string
+
+	for pos := 1; pos < len(text)-1; {
+		switch text[pos] {
+		case ' ', ',':
+			pos++
+			continue
+		case '[', '(':
+		default:
+			return nil, fmt.Errorf("jet: invalid multirange format %q", text)
+		}
+
+		end, err := rangeEnd(text, pos)
+		if err != nil {
+			return nil, fmt.Errorf("jet: invalid multirange format %q", text)
+		}
+
+		ranges = append(ranges, text[pos:end])
+		pos = end
+	}
+
+	return ranges, nil
+}
+
+// rangeEnd returns index just after the closing bracket of the range starting at start. Brackets inside quoted
+// bounds are skipped.
+func rangeEnd(text string, start int) (int, error) {
+	inQuotes := false
+
+	for i := start + 1; i < len(text); i++ {
+		switch text[i] {
+		case '\\':
+			i++
+		case '"':
+			inQuotes = !inQuotes
+		case ']', ')':
+			if !inQuotes {
+				return i + 1, nil
+			}
+		}
+	}
+
+	return 0, errors.New("jet: unterminated range")
+}
+
+// multirangeValue returns PostgreSQL text representation of multirange with the ranges
+func multirangeValue(ranges []driver.Valuer) (driver.Value, error) {
+	texts := make([]string, len(ranges))
+
+	for i, r := range ranges {
+		value, err := r.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		texts[i] = value.(string)
+	}
+
+	return "{" + strings.Join(texts, ",") + "}", nil
+}
+
+func parseRangeBound(text string, pos int) (*string, int, error) {
+	if pos < len(text) && text[pos] == '"' {
+		var b strings.Builder
+
+		for i := pos + 1; i < len(text); i++ {
+			switch text[i] {
+			case '\\':
+				i++
+				if i < len(text) {
+					b.WriteByte(text[i])
+				}
+			case '"':
+				if i+1 < len(text) && text[i+1] == '"' { // doubled quote
+					b.WriteByte('"')
+					i++
+					continue
+				}
+				return stringPtr(b.String()), i + 1, nil
+			default:
+				b.WriteByte(text[i])
+			}
+		}
+
+		return nil, 0, errors.New("jet: invalid range format, unterminated quoted bound")
+	}
+
+	end := pos
+	for end < len(text)-1 && text[end] != ',' {
+		end++
+	}
+
+	if end == pos {
+		return nil, end, nil // unbounded
+	}
+
+	return stringPtr(text[pos:end]), end, nil
+}
+
+func parseInt32Bound(bound *string) (*int32, error) {
+	if bound == nil {
+		return nil, nil
+	}
+
+	value, err := strconv.ParseInt(*bound, 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("jet: invalid int4range bound: %w", err)
+	}
+
+	ret := int32(value)
+	return &ret, nil
+}
+
+func parseInt64Bound(bound *string) (*int64, error) {
+	if bound == nil {
+		return nil, nil
+	}
+
+	value, err := strconv.ParseInt(*bound, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("jet: invalid int8range bound: %w", err)
+	}
+
+	return &value, nil
+}
+
+func parseFloat64Bound(bound *string) (*float64, error) {
+	if bound == nil {
+		return nil, nil
+	}
+
+	value, err := strconv.ParseFloat(*bound, 64)
+	if err != nil {
+		return nil, fmt.Errorf("jet: invalid numrange bound: %w", err)
+	}
+
+	return &value, nil
+}
+
+func parseTimeBound(bound *string) (*time.Time, error) {
+	if bound == nil {
+		return nil, nil
+	}
+
+	value, err := parseTimestamp(*bound)
+	if err != nil {
+		return nil, fmt.Errorf("jet: invalid time range bound: %w", err)
+	}
+
+	return &value, nil
+}
+
+// timestampLayouts are layouts of postgres text format of date, timestamp and timestamptz values
+var timestampLayouts = []string{
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02 15:04:05.999999999Z07",
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999Z07:00:00",
+	"2006-01-02",
+}
+
+// parseTimestamp parses postgres text format of date, timestamp and timestamptz values, with the ISO date style
+func parseTimestamp(text string) (time.Time, error) {
+	bc := strings.HasSuffix(text, " BC")
+	text = strings.TrimSuffix(text, " BC")
+
+	var err error
+
+	for _, layout := range timestampLayouts {
+		var value time.Time
+
+		if value, err = time.Parse(layout, text); err == nil {
+			if bc { // year 1 BC is year 0, 2 BC is year -1, and so on
+				value = value.AddDate(1-2*value.Year(), 0, 0)
+			}
+
+			return value, nil
+		}
+	}
+
+	return time.Time{}, err
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
@@ -0,0 +1,144 @@
+package postgres
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt32Range(t *testing.T) {
+	var r Int32Range
+
+	require.NoError(t, r.Scan("[1,5)"))
+	require.Equal(t, Int32Range{Lower: int32Ptr(1), Upper: int32Ptr(5), LowerInclusive: true}, r)
+
+	value, err := r.Value()
+	require.NoError(t, err)
+	require.Equal(t, `["1","5")`, value)
+
+	require.NoError(t, r.Scan([]byte("(,10]")))
+	require.Equal(t, Int32Range{Upper: int32Ptr(10), UpperInclusive: true}, r)
+
+	value, err = r.Value()
+	require.NoError(t, err)
+	require.Equal(t, `(,"10"]`, value)
+
+	require.NoError(t, r.Scan("empty"))
+	require.Equal(t, Int32Range{Empty: true}, r)
+
+	value, err = r.Value()
+	require.NoError(t, err)
+	require.Equal(t, "empty", value)
+
+	require.EqualError(t, r.Scan("[a,5)"), `jet: invalid int4range bound: strconv.ParseInt: parsing "a": invalid syntax`)
+	require.EqualError(t, r.Scan("1,5"), `jet: invalid range format "1,5"`)
+	require.EqualError(t, r.Scan(12), "jet: can't scan range from int")
+}
+
+func TestInt64AndFloat64Range(t *testing.T) {
+	var intRange Int64Range
+
+	require.NoError(t, intRange.Scan("[-9223372036854775808,0)"))
+	require.Equal(t, int64(-9223372036854775808), *intRange.Lower)
+	require.Equal(t, int64(0), *intRange.Upper)
+
+	var floatRange Float64Range
+
+	require.NoError(t, floatRange.Scan("(1.5,2.25]"))
+	require.Equal(t, Float64Range{Lower: float64Ptr(1.5), Upper: float64Ptr(2.25), UpperInclusive: true}, floatRange)
+
+	value, err := floatRange.Value()
+	require.NoError(t, err)
+	require.Equal(t, `("1.5","2.25"]`, value)
+}
+
+func TestTimeRange(t *testing.T) {
+	var r TimeRange
+
+	require.NoError(t, r.Scan(`["2020-01-01 10:00:00+00","2020-01-02 10:00:00+00")`))
+	require.True(t, r.Lower.Equal(time.Date(2020, 1, 1, 10, 0, 0, 0, time.UTC)))
+	require.True(t, r.Upper.Equal(time.Date(2020, 1, 2, 10, 0, 0, 0, time.UTC)))
+	require.True(t, r.LowerInclusive)
+	require.False(t, r.UpperInclusive)
+
+	value, err := r.Value()
+	require.NoError(t, err)
+	require.Equal(t, `["2020-01-01 10:00:00Z","2020-01-02 10:00:00Z")`, value)
+
+	require.NoError(t, r.Scan("[2020-01-01,)"))
+	require.True(t, r.Lower.Equal(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)))
+	require.Nil(t, r.Upper)
+
+	require.Error(t, r.Scan("[2020-13-01,)"))
+}
+
+func TestMultirange(t *testing.T) {
+	var intMultirange Int32Multirange
+
+	require.NoError(t, intMultirange.Scan("{[1,3), [5,7)}"))
+	require.Equal(t, Int32Multirange{
+		{Lower: int32Ptr(1), Upper: int32Ptr(3), LowerInclusive: true},
+		{Lower: int32Ptr(5), Upper: int32Ptr(7), LowerInclusive: true},
+	}, intMultirange)
+
+	value, err := intMultirange.Value()
+	require.NoError(t, err)
+	require.Equal(t, `{["1","3"),["5","7")}`, value)
+
+	require.NoError(t, intMultirange.Scan([]byte("{}")))
+	require.Empty(t, intMultirange)
+
+	value, err = intMultirange.Value()
+	require.NoError(t, err)
+	require.Equal(t, "{}", value)
+
+	var timeMultirange TimeMultirange
+
+	require.NoError(t, timeMultirange.Scan(`{["2020-01-01 10:00:00+00","2020-01-02 10:00:00+00"),["2020-02-01 00:00:00+00",)}`))
+	require.Len(t, timeMultirange, 2)
+	require.True(t, timeMultirange[0].Upper.Equal(time.Date(2020, 1, 2, 10, 0, 0, 0, time.UTC)))
+	require.True(t, timeMultirange[1].Lower.Equal(time.Date(2020, 2, 1, 0, 0, 0, 0, time.UTC)))
+	require.Nil(t, timeMultirange[1].Upper)
+
+	var floatMultirange Float64Multirange
+
+	require.NoError(t, floatMultirange.Scan("{(1.5,2.25]}"))
+	require.Equal(t, Float64Multirange{{Lower: float64Ptr(1.5), Upper: float64Ptr(2.25), UpperInclusive: true}}, floatMultirange)
+
+	var int64Multirange Int64Multirange
+
+	require.EqualError(t, int64Multirange.Scan("[1,3)"), `jet: invalid multirange format "[1,3)"`)
+	require.EqualError(t, int64Multirange.Scan("{[1,3)"), `jet: invalid multirange format "{[1,3)"`)
+	require.EqualError(t, int64Multirange.Scan("{[a,3)}"), `jet: invalid int8range bound: strconv.ParseInt: parsing "a": invalid syntax`)
+	require.EqualError(t, int64Multirange.Scan(12), "jet: can't scan multirange from int")
+}
+
+func TestParseTimestamp(t *testing.T) {
+	for text, expected := range map[string]time.Time{
+		"2020-01-02 10:20:30":              time.Date(2020, 1, 2, 10, 20, 30, 0, time.UTC),
+		"2020-01-02 10:20:30.123456":       time.Date(2020, 1, 2, 10, 20, 30, 123456000, time.UTC),
+		"2020-01-02 10:20:30+02":           time.Date(2020, 1, 2, 8, 20, 30, 0, time.UTC),
+		"2020-01-02 10:20:30.5-05:30":      time.Date(2020, 1, 2, 15, 50, 30, 500000000, time.UTC),
+		"2020-01-02 10:20:30+01:02:03":     time.Date(2020, 1, 2, 9, 18, 27, 0, time.UTC),
+		"2020-01-02":                       time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC),
+		"0001-01-02 00:00:00 BC":           time.Date(0, 1, 2, 0, 0, 0, 0, time.UTC),
+		"0010-01-02 00:00:00+00 BC":        time.Date(-9, 1, 2, 0, 0, 0, 0, time.UTC),
+		"2020-01-02 10:20:30.999999999+00": time.Date(2020, 1, 2, 10, 20, 30, 999999999, time.UTC),
+	} {
+		value, err := parseTimestamp(text)
+		require.NoError(t, err, text)
+		require.True(t, expected.Equal(value), "%s: %s", text, value)
+	}
+
+	_, err := parseTimestamp("infinity")
+	require.Error(t, err)
+}
+
+func int32Ptr(i int32) *int32 {
+	return &i
+}
+
+func float64Ptr(f float64) *float64 {
+	return &f
+}
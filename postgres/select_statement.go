@@ -38,6 +38,14 @@ func FOLLOWING(offset int64) jet.FrameExtent {
 // Window definition reference
 var Window = jet.WindowName
 
+// Grouping elements used in GROUP_BY clause
+var (
+	ROLLUP        = jet.ROLLUP
+	CUBE          = jet.CUBE
+	GROUPING_SETS = jet.GROUPING_SETS
+	GROUPING_SET  = jet.GROUPING_SET
+)
+
 // SelectStatement is interface for PostgreSQL SELECT statement
 type SelectStatement interface {
 	Statement
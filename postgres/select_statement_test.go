@@ -76,6 +76,39 @@ GROUP BY table2.col_float;
 `)
 }
 
+func TestSelectGroupByGroupingElements(t *testing.T) {
+	assertStatementSql(t,
+		SELECT(table2ColInt, GROUPING(table2ColInt, table2ColFloat)).
+			FROM(table2).
+			GROUP_BY(ROLLUP(table2ColInt, table2ColFloat)), `
+SELECT table2.col_int AS "table2.col_int",
+     GROUPING(table2.col_int, table2.col_float)
+FROM db.table2
+GROUP BY ROLLUP(table2.col_int, table2.col_float);
+`)
+	assertStatementSql(t,
+		SELECT(table2ColInt).
+			FROM(table2).
+			GROUP_BY(table2ColStr, CUBE(table2ColInt, GROUPING_SET(table2ColFloat, table2ColTime))), `
+SELECT table2.col_int AS "table2.col_int"
+FROM db.table2
+GROUP BY table2.col_str, CUBE(table2.col_int, (table2.col_float, table2.col_time));
+`)
+	assertStatementSql(t,
+		SELECT(table2ColInt).
+			FROM(table2).
+			GROUP_BY(GROUPING_SETS(
+				table2ColInt,
+				GROUPING_SET(table2ColInt, table2ColFloat),
+				ROLLUP(table2ColStr),
+				GROUPING_SET(),
+			)), `
+SELECT table2.col_int AS "table2.col_int"
+FROM db.table2
+GROUP BY GROUPING SETS(table2.col_int, (table2.col_int, table2.col_float), ROLLUP(table2.col_str), ());
+`)
+}
+
 func TestSelectHaving(t *testing.T) {
 	assertStatementSql(t, SELECT(table3ColInt).FROM(table3).HAVING(table1ColBool.EQ(Bool(true))), `
 SELECT table3.col_int AS "table3.col_int"
@@ -34,6 +34,7 @@ type writableTable interface {
 	UPDATE(columns ...jet.Column) UpdateStatement
 	DELETE() DeleteStatement
 	LOCK() LockStatement
+	MERGE() MergeStatement
 }
 
 // ReadableTable interface
@@ -101,6 +102,10 @@ func (w *writableTableInterfaceImpl) LOCK() LockStatement {
 	return LOCK(w.parent)
 }
 
+func (w *writableTableInterfaceImpl) MERGE() MergeStatement {
+	return newMergeStatement(w.parent)
+}
+
 type tableImpl struct {
 	readableTableInterfaceImpl
 	writableTableInterfaceImpl
@@ -121,6 +126,29 @@ func NewTable(schemaName, name, alias string, columns ...jet.ColumnExpression) T
 	return t
 }
 
+// TableFunction is interface for set-returning function calls, usable as readable table in FROM clause
+type TableFunction interface {
+	readableTable
+	jet.SerializerTable
+}
+
+type tableFunctionImpl struct {
+	readableTableInterfaceImpl
+	jet.SerializerTable
+}
+
+// NewTableFunction creates new table function from schema name, function name, function arguments and list of
+// result columns
+func NewTableFunction(schemaName, name, alias string, args []Expression, columns ...jet.ColumnExpression) TableFunction {
+	t := &tableFunctionImpl{
+		SerializerTable: jet.NewTableFunction(schemaName, name, alias, args, columns...),
+	}
+
+	t.readableTableInterfaceImpl.parent = t
+
+	return t
+}
+
 type joinTable struct {
 	readableTableInterfaceImpl
 	jet.JoinTable
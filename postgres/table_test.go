@@ -122,3 +122,26 @@ FROM db.table1,
      db.table3;
 `)
 }
+
+func TestTableFunction(t *testing.T) {
+	rentalID := IntegerColumn("rental_id")
+	rentalDate := TimestampColumn("rental_date")
+	rentals := NewTableFunction("db", "customer_rentals", "", []Expression{Int(1), table1ColInt}, rentalID, rentalDate)
+
+	assertStatementSql(t, rentals.SELECT(rentalID, rentalDate).WHERE(rentalID.GT(Int(10))), `
+SELECT customer_rentals.rental_id AS "customer_rentals.rental_id",
+     customer_rentals.rental_date AS "customer_rentals.rental_date"
+FROM db.customer_rentals($1, table1.col_int)
+WHERE customer_rentals.rental_id > $2;
+`, int64(1), int64(10))
+
+	rentalIDAlias := IntegerColumn("rental_id")
+	aliased := NewTableFunction("db", "customer_rentals", "r", nil, rentalIDAlias)
+
+	assertStatementSql(t, SELECT(table1Col1, rentalIDAlias).FROM(table1.CROSS_JOIN(aliased)), `
+SELECT table1.col1 AS "table1.col1",
+     r.rental_id AS "r.rental_id"
+FROM db.table1
+     CROSS JOIN db.customer_rentals() AS r;
+`)
+}
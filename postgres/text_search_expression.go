@@ -0,0 +1,174 @@
+package postgres
+
+import "github.com/go-jet/jet/v2/internal/jet"
+
+// Text search operators
+const (
+	TextSearchMatchOperator       = "@@"
+	TextSearchConcatOperator      = "||"
+	TextSearchAndOperator         = "&&"
+	TextSearchContainsOperator    = "@>"
+	TextSearchContainedByOperator = "<@"
+)
+
+// TsVectorExpression is representation of postgres tsvector (text search document)
+type TsVectorExpression interface {
+	Expression
+
+	EQ(rhs TsVectorExpression) BoolExpression
+	NOT_EQ(rhs TsVectorExpression) BoolExpression
+	IS_DISTINCT_FROM(rhs TsVectorExpression) BoolExpression
+	IS_NOT_DISTINCT_FROM(rhs TsVectorExpression) BoolExpression
+
+	// MATCH checks if tsvector matches tsquery (@@ operator)
+	MATCH(query TsQueryExpression) BoolExpression
+	// CONCAT concatenates two tsvectors (|| operator)
+	CONCAT(rhs TsVectorExpression) TsVectorExpression
+
+	isTsVector()
+}
+
+type tsVectorInterfaceImpl struct {
+	parent TsVectorExpression
+}
+
+func (t *tsVectorInterfaceImpl) isTsVector() {}
+
+func (t *tsVectorInterfaceImpl) EQ(rhs TsVectorExpression) BoolExpression {
+	return jet.Eq(t.parent, rhs)
+}
+
+func (t *tsVectorInterfaceImpl) NOT_EQ(rhs TsVectorExpression) BoolExpression {
+	return jet.NotEq(t.parent, rhs)
+}
+
+func (t *tsVectorInterfaceImpl) IS_DISTINCT_FROM(rhs TsVectorExpression) BoolExpression {
+	return jet.IsDistinctFrom(t.parent, rhs)
+}
+
+func (t *tsVectorInterfaceImpl) IS_NOT_DISTINCT_FROM(rhs TsVectorExpression) BoolExpression {
+	return jet.IsNotDistinctFrom(t.parent, rhs)
+}
+
+func (t *tsVectorInterfaceImpl) MATCH(query TsQueryExpression) BoolExpression {
+	return BoolExp(jet.NewBinaryOperatorExpression(t.parent, query, TextSearchMatchOperator))
+}
+
+func (t *tsVectorInterfaceImpl) CONCAT(rhs TsVectorExpression) TsVectorExpression {
+	return TsVectorExp(jet.NewBinaryOperatorExpression(t.parent, rhs, TextSearchConcatOperator))
+}
+
+type tsVectorExpressionWrapper struct {
+	jet.Expression
+	tsVectorInterfaceImpl
+}
+
+func newTsVectorExpressionWrap(expression Expression) TsVectorExpression {
+	tsVectorExpressionWrap := tsVectorExpressionWrapper{Expression: expression}
+	tsVectorExpressionWrap.tsVectorInterfaceImpl.parent = &tsVectorExpressionWrap
+	return &tsVectorExpressionWrap
+}
+
+// TsVectorExp is tsvector expression wrapper around arbitrary expression.
+// Allows go compiler to see any expression as tsvector expression.
+// Does not add sql cast to generated sql builder output.
+func TsVectorExp(expression Expression) TsVectorExpression {
+	return newTsVectorExpressionWrap(expression)
+}
+
+// TsVector creates new tsvector literal expression from already normalized text search document,
+// for instance 'a:1 fat:2 cat:3'.
+func TsVector(value string) TsVectorExpression {
+	return CAST(jet.String(value)).AS_TSVECTOR()
+}
+
+//------------------------------------------------------//
+
+// TsQueryExpression is representation of postgres tsquery (text search query)
+type TsQueryExpression interface {
+	Expression
+
+	EQ(rhs TsQueryExpression) BoolExpression
+	NOT_EQ(rhs TsQueryExpression) BoolExpression
+	IS_DISTINCT_FROM(rhs TsQueryExpression) BoolExpression
+	IS_NOT_DISTINCT_FROM(rhs TsQueryExpression) BoolExpression
+
+	// MATCH checks if tsquery matches tsvector (@@ operator)
+	MATCH(vector TsVectorExpression) BoolExpression
+	// AND combines two queries so that both have to match (&& operator)
+	AND(rhs TsQueryExpression) TsQueryExpression
+	// OR combines two queries so that either has to match (|| operator)
+	OR(rhs TsQueryExpression) TsQueryExpression
+	// CONTAINS checks if this tsquery contains rhs tsquery (@> operator)
+	CONTAINS(rhs TsQueryExpression) BoolExpression
+	// IS_CONTAINED_BY checks if this tsquery is contained in rhs tsquery (<@ operator)
+	IS_CONTAINED_BY(rhs TsQueryExpression) BoolExpression
+
+	isTsQuery()
+}
+
+type tsQueryInterfaceImpl struct {
+	parent TsQueryExpression
+}
+
+func (t *tsQueryInterfaceImpl) isTsQuery() {}
+
+func (t *tsQueryInterfaceImpl) EQ(rhs TsQueryExpression) BoolExpression {
+	return jet.Eq(t.parent, rhs)
+}
+
+func (t *tsQueryInterfaceImpl) NOT_EQ(rhs TsQueryExpression) BoolExpression {
+	return jet.NotEq(t.parent, rhs)
+}
+
+func (t *tsQueryInterfaceImpl) IS_DISTINCT_FROM(rhs TsQueryExpression) BoolExpression {
+	return jet.IsDistinctFrom(t.parent, rhs)
+}
+
+func (t *tsQueryInterfaceImpl) IS_NOT_DISTINCT_FROM(rhs TsQueryExpression) BoolExpression {
+	return jet.IsNotDistinctFrom(t.parent, rhs)
+}
+
+func (t *tsQueryInterfaceImpl) MATCH(vector TsVectorExpression) BoolExpression {
+	return BoolExp(jet.NewBinaryOperatorExpression(t.parent, vector, TextSearchMatchOperator))
+}
+
+func (t *tsQueryInterfaceImpl) AND(rhs TsQueryExpression) TsQueryExpression {
+	return TsQueryExp(jet.NewBinaryOperatorExpression(t.parent, rhs, TextSearchAndOperator))
+}
+
+func (t *tsQueryInterfaceImpl) OR(rhs TsQueryExpression) TsQueryExpression {
+	return TsQueryExp(jet.NewBinaryOperatorExpression(t.parent, rhs, TextSearchConcatOperator))
+}
+
+func (t *tsQueryInterfaceImpl) CONTAINS(rhs TsQueryExpression) BoolExpression {
+	return BoolExp(jet.NewBinaryOperatorExpression(t.parent, rhs, TextSearchContainsOperator))
+}
+
+func (t *tsQueryInterfaceImpl) IS_CONTAINED_BY(rhs TsQueryExpression) BoolExpression {
+	return BoolExp(jet.NewBinaryOperatorExpression(t.parent, rhs, TextSearchContainedByOperator))
+}
+
+type tsQueryExpressionWrapper struct {
+	jet.Expression
+	tsQueryInterfaceImpl
+}
+
+func newTsQueryExpressionWrap(expression Expression) TsQueryExpression {
+	tsQueryExpressionWrap := tsQueryExpressionWrapper{Expression: expression}
+	tsQueryExpressionWrap.tsQueryInterfaceImpl.parent = &tsQueryExpressionWrap
+	return &tsQueryExpressionWrap
+}
+
+// TsQueryExp is tsquery expression wrapper around arbitrary expression.
+// Allows go compiler to see any expression as tsquery expression.
+// Does not add sql cast to generated sql builder output.
+func TsQueryExp(expression Expression) TsQueryExpression {
+	return newTsQueryExpressionWrap(expression)
+}
+
+// TsQuery creates new tsquery literal expression from already normalized text search query,
+// for instance 'fat & (rat | cat)'.
+func TsQuery(value string) TsQueryExpression {
+	return CAST(jet.String(value)).AS_TSQUERY()
+}
@@ -0,0 +1,26 @@
+package postgres
+
+import "testing"
+
+func TestTsVectorExpression(t *testing.T) {
+	assertSerialize(t, table3ColTsVector.EQ(TsVector("a:1 fat:2")), "(table3.col_tsvector = $1::tsvector)", "a:1 fat:2")
+	assertSerialize(t, table3ColTsVector.MATCH(table3ColTsQuery), "(table3.col_tsvector @@ table3.col_tsquery)")
+	assertSerialize(t, table3ColTsVector.MATCH(TsQuery("fat & rat")), "(table3.col_tsvector @@ $1::tsquery)", "fat & rat")
+	assertSerialize(t, table3ColTsVector.CONCAT(TO_TSVECTOR(table3StrCol)),
+		"(table3.col_tsvector || TO_TSVECTOR(table3.col2))")
+	assertSerialize(t, TsVectorExp(Raw("vec")).IS_DISTINCT_FROM(table3ColTsVector), "((vec) IS DISTINCT FROM table3.col_tsvector)")
+}
+
+func TestTsQueryExpression(t *testing.T) {
+	assertSerialize(t, table3ColTsQuery.MATCH(table3ColTsVector), "(table3.col_tsquery @@ table3.col_tsvector)")
+	assertSerialize(t, table3ColTsQuery.AND(TsQuery("cat")), "(table3.col_tsquery && $1::tsquery)", "cat")
+	assertSerialize(t, table3ColTsQuery.OR(TsQuery("cat")), "(table3.col_tsquery || $1::tsquery)", "cat")
+	assertSerialize(t, table3ColTsQuery.CONTAINS(TsQuery("cat")), "(table3.col_tsquery @> $1::tsquery)", "cat")
+	assertSerialize(t, table3ColTsQuery.IS_CONTAINED_BY(TsQuery("cat")), "(table3.col_tsquery <@ $1::tsquery)", "cat")
+	assertSerialize(t, table3ColTsQuery.NOT_EQ(TsQueryExp(Raw("q"))), "(table3.col_tsquery != (q))")
+}
+
+func TestTsVectorColumnSet(t *testing.T) {
+	assertSerialize(t, table3ColTsVector.SET(TO_TSVECTOR(table3StrCol, "english")),
+		"col_tsvector = TO_TSVECTOR($1::regconfig, table3.col2)", "english")
+}
@@ -60,7 +60,7 @@ func (u *updateStatementImpl) SET(value interface{}, values ...interface{}) Upda
 }
 
 func (u *updateStatementImpl) MODEL(data interface{}) UpdateStatement {
-	u.Set.Values = jet.UnwindRowFromModel(u.Set.Columns, data)
+	u.Set.Values = jet.UnwindRowFromModelWithArrays(u.Set.Columns, data)
 	return u
 }
 
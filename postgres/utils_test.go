@@ -52,7 +52,13 @@ var table2 = NewTable("db", "table2", "", table2Col3, table2Col4, table2ColInt,
 var table3Col1 = IntegerColumn("col1")
 var table3ColInt = IntegerColumn("col_int")
 var table3StrCol = StringColumn("col2")
-var table3 = NewTable("db", "table3", "", table3Col1, table3ColInt, table3StrCol)
+var table3ColJson = JsonColumn("col_json")
+var table3ColTsVector = TsVectorColumn("col_tsvector")
+var table3ColTsQuery = TsQueryColumn("col_tsquery")
+var table3ColIntRange = IntegerRangeColumn("col_int_range")
+var table3ColTimestampzRange = TimestampzRangeColumn("col_timestampz_range")
+var table3 = NewTable("db", "table3", "", table3Col1, table3ColInt, table3StrCol, table3ColJson, table3ColTsVector, table3ColTsQuery,
+	table3ColIntRange, table3ColTimestampzRange)
 
 func assertSerialize(t *testing.T, serializer jet.Serializer, query string, args ...interface{}) {
 	testutils.AssertSerialize(t, Dialect, serializer, query, args...)
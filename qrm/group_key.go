@@ -0,0 +1,130 @@
+package qrm
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/maphash"
+	"math"
+	"time"
+)
+
+// groupKey is a 128-bit hash of the destination object group key, constructed from destination primary key column
+// values. Hashing avoids stringifying row values for every row, while two independently seeded 64-bit hashes make
+// collisions between different group keys negligible.
+type groupKey struct {
+	hash1, hash2 uint64
+}
+
+var groupKeySeeds = [2]maphash.Seed{maphash.MakeSeed(), maphash.MakeSeed()}
+
+// value type markers, so that different types with the same binary representation have different group keys
+const (
+	nilMarker byte = iota
+	int64Marker
+	float64Marker
+	boolMarker
+	stringMarker
+	bytesMarker
+	timeMarker
+	stringerMarker
+	otherMarker
+	groupKeyMarker
+	rowNumMarker
+)
+
+type groupKeyHash struct {
+	hash1, hash2 maphash.Hash
+	buf          [8]byte
+}
+
+func (g *groupKeyHash) reset() {
+	g.hash1.SetSeed(groupKeySeeds[0])
+	g.hash2.SetSeed(groupKeySeeds[1])
+}
+
+func (g *groupKeyHash) writeByte(b byte) {
+	_ = g.hash1.WriteByte(b)
+	_ = g.hash2.WriteByte(b)
+}
+
+func (g *groupKeyHash) writeUint64(value uint64) {
+	binary.LittleEndian.PutUint64(g.buf[:], value)
+	_, _ = g.hash1.Write(g.buf[:])
+	_, _ = g.hash2.Write(g.buf[:])
+}
+
+// writeString writes string length prefix as well, so that consecutive strings can not be ambiguous
+func (g *groupKeyHash) writeString(value string) {
+	g.writeUint64(uint64(len(value)))
+	_, _ = g.hash1.WriteString(value)
+	_, _ = g.hash2.WriteString(value)
+}
+
+func (g *groupKeyHash) writeBytes(value []byte) {
+	g.writeUint64(uint64(len(value)))
+	_, _ = g.hash1.Write(value)
+	_, _ = g.hash2.Write(value)
+}
+
+func (g *groupKeyHash) writeGroupKey(key groupKey) {
+	g.writeByte(groupKeyMarker)
+	g.writeUint64(key.hash1)
+	g.writeUint64(key.hash2)
+}
+
+// writeValue writes scanned row value. Database drivers return only a handful of types (driver.Value types), so
+// only unknown types are stringified.
+func (g *groupKeyHash) writeValue(value interface{}) {
+	switch v := value.(type) {
+	case nil:
+		g.writeByte(nilMarker)
+	case int64:
+		g.writeByte(int64Marker)
+		g.writeUint64(uint64(v))
+	case float64:
+		g.writeByte(float64Marker)
+		g.writeUint64(math.Float64bits(v))
+	case bool:
+		g.writeByte(boolMarker)
+		if v {
+			g.writeByte(1)
+		} else {
+			g.writeByte(0)
+		}
+	case string:
+		g.writeByte(stringMarker)
+		g.writeString(v)
+	case []byte:
+		g.writeByte(bytesMarker)
+		g.writeBytes(v)
+	case time.Time:
+		g.writeByte(timeMarker)
+		g.writeUint64(uint64(v.Unix()))
+		g.writeUint64(uint64(v.Nanosecond()))
+	case fmt.Stringer:
+		g.writeByte(stringerMarker)
+		g.writeString(v.String())
+	default:
+		g.writeByte(otherMarker)
+		g.writeString(fmt.Sprintf("%#v", v))
+	}
+}
+
+func (g *groupKeyHash) sum() groupKey {
+	return groupKey{
+		hash1: g.hash1.Sum64(),
+		hash2: g.hash2.Sum64(),
+	}
+}
+
+// concatGroupKeys returns group key of the nested destination object, combining parent object group key and nested
+// object group key.
+func concatGroupKeys(parentKey, key groupKey) groupKey {
+	var hash groupKeyHash
+	hash.reset()
+
+	hash.writeGroupKey(parentKey)
+	hash.writeGroupKey(key)
+
+	return hash.sum()
+}
@@ -0,0 +1,101 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ParseArray parses PostgreSQL text representation of one dimensional array, for instance {1,2,NULL,"a b"}.
+// Returned list contains nil for NULL array elements, and string value for all the other elements.
+func ParseArray(value interface{}) ([]interface{}, error) {
+	var text string
+
+	switch v := value.(type) {
+	case string:
+		text = v
+	case []byte:
+		text = string(v)
+	default:
+		return nil, fmt.Errorf("can't parse array from %T", value)
+	}
+
+	// array with non-default lower bounds is prefixed with dimension decoration, for instance [0:2]={1,2,3}
+	if strings.HasPrefix(text, "[") {
+		if index := strings.Index(text, "="); index >= 0 {
+			text = text[index+1:]
+		}
+	}
+
+	if len(text) < 2 || text[0] != '{' || text[len(text)-1] != '}' {
+		return nil, fmt.Errorf("invalid array format %q", text)
+	}
+
+	text = text[1 : len(text)-1]
+
+	if text == "" {
+		return []interface{}{}, nil
+	}
+
+	var elems []interface{}
+
+	for pos := 0; pos <= len(text); {
+		elem, next, err := parseArrayElement(text, pos)
+
+		if err != nil {
+			return nil, err
+		}
+
+		elems = append(elems, elem)
+
+		if next < len(text) && text[next] != ',' {
+			return nil, fmt.Errorf("invalid array format, unexpected %q at position %d", text[next], next)
+		}
+
+		pos = next + 1
+	}
+
+	return elems, nil
+}
+
+func parseArrayElement(text string, pos int) (elem interface{}, next int, err error) {
+	if pos < len(text) && text[pos] == '{' {
+		return nil, 0, errors.New("multidimensional arrays are not supported")
+	}
+
+	if pos < len(text) && text[pos] == '"' {
+		var b strings.Builder
+
+		for i := pos + 1; i < len(text); i++ {
+			switch text[i] {
+			case '\\':
+				i++
+				if i < len(text) {
+					b.WriteByte(text[i])
+				}
+			case '"':
+				return b.String(), i + 1, nil
+			default:
+				b.WriteByte(text[i])
+			}
+		}
+
+		return nil, 0, errors.New("invalid array format, unterminated quoted element")
+	}
+
+	end := strings.IndexByte(text[pos:], ',')
+
+	if end < 0 {
+		end = len(text)
+	} else {
+		end += pos
+	}
+
+	value := strings.TrimSpace(text[pos:end])
+
+	if strings.EqualFold(value, "NULL") {
+		return nil, end, nil
+	}
+
+	return value, end, nil
+}
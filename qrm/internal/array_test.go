@@ -0,0 +1,41 @@
+package internal
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestParseArray(t *testing.T) {
+	elems, err := ParseArray("{1,2,3}")
+	require.NoError(t, err)
+	require.Equal(t, elems, []interface{}{"1", "2", "3"})
+
+	elems, err = ParseArray([]byte(`{breakfast,"with space","with \"quote\"",NULL,"NULL"}`))
+	require.NoError(t, err)
+	require.Equal(t, elems, []interface{}{"breakfast", "with space", `with "quote"`, nil, "NULL"})
+
+	elems, err = ParseArray("{}")
+	require.NoError(t, err)
+	require.Equal(t, elems, []interface{}{})
+
+	elems, err = ParseArray("[0:1]={a,b}")
+	require.NoError(t, err)
+	require.Equal(t, elems, []interface{}{"a", "b"})
+}
+
+func TestParseArrayError(t *testing.T) {
+	_, err := ParseArray(int64(1))
+	require.EqualError(t, err, "can't parse array from int64")
+
+	_, err = ParseArray("1,2")
+	require.EqualError(t, err, `invalid array format "1,2"`)
+
+	_, err = ParseArray("{{1,2},{3,4}}")
+	require.EqualError(t, err, "multidimensional arrays are not supported")
+
+	_, err = ParseArray(`{"abc}`)
+	require.EqualError(t, err, "invalid array format, unterminated quoted element")
+
+	_, err = ParseArray(`{"a"b}`)
+	require.EqualError(t, err, `invalid array format, unexpected 'b' at position 3`)
+}
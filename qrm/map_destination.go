@@ -0,0 +1,169 @@
+package qrm
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/go-jet/jet/v2/qrm/internal"
+)
+
+var (
+	mapType       = reflect.TypeOf(map[string]interface{}{})
+	nestedMapType = reflect.TypeOf(map[string]map[string]interface{}{})
+)
+
+// isMapType returns true for map[string]interface{} destination, with column aliases as keys
+func isMapType(destType reflect.Type) bool {
+	return destType.Kind() == reflect.Map && destType.ConvertibleTo(mapType)
+}
+
+// isNestedMapType returns true for map[string]map[string]interface{} destination, with table names as keys of the
+// outer map, and column names as keys of the inner maps
+func isNestedMapType(destType reflect.Type) bool {
+	return destType.Kind() == reflect.Map && destType.ConvertibleTo(nestedMapType)
+}
+
+// isMapDestinationType returns true for map and nested map destination types
+func isMapDestinationType(destType reflect.Type) bool {
+	return isMapType(destType) || isNestedMapType(destType)
+}
+
+// mapRowToMapSlice appends current row, as a new map of destType, to the slice of maps or to the slice of map pointers
+func mapRowToMapSlice(scanContext *ScanContext, slicePtrValue reflect.Value, destType reflect.Type) bool {
+	sliceValue := slicePtrValue.Elem()
+	mapValue := scanContext.rowToMapValue(destType)
+
+	if sliceValue.Type().Elem().Kind() == reflect.Ptr {
+		mapPtrValue := reflect.New(destType)
+		mapPtrValue.Elem().Set(mapValue)
+		mapValue = mapPtrValue
+	}
+
+	sliceValue.Set(reflect.Append(sliceValue, mapValue))
+
+	return true
+}
+
+// rowToMapValue returns current row as a new map of destination map type
+func (s *ScanContext) rowToMapValue(destType reflect.Type) reflect.Value {
+	if isNestedMapType(destType) {
+		return reflect.ValueOf(s.rowToNestedMap()).Convert(destType)
+	}
+
+	return reflect.ValueOf(s.rowToMap()).Convert(destType)
+}
+
+// rowToMap returns current row as a map, with column aliases as keys
+func (s *ScanContext) rowToMap() map[string]interface{} {
+	row := make(map[string]interface{}, len(s.row))
+
+	for i, alias := range s.mapping.aliases {
+		row[alias] = s.normalizedRowElem(i)
+	}
+
+	return row
+}
+
+// rowToNestedMap returns current row as a nested map. For column alias in the 'table.column' format, column value is
+// stored in the inner map of the table, with column name as a key. Column values of the aliases without table
+// name are stored in the inner map with an empty string key.
+func (s *ScanContext) rowToNestedMap() map[string]map[string]interface{} {
+	row := make(map[string]map[string]interface{})
+
+	for i, alias := range s.mapping.aliases {
+		tableName, columnName := "", alias
+
+		if dotIndex := strings.Index(alias, "."); dotIndex >= 0 {
+			tableName, columnName = alias[:dotIndex], alias[dotIndex+1:]
+		}
+
+		table, ok := row[tableName]
+
+		if !ok {
+			table = make(map[string]interface{})
+			row[tableName] = table
+		}
+
+		table[columnName] = s.normalizedRowElem(i)
+	}
+
+	return row
+}
+
+// normalizedRowElem returns row value normalized, so that it does not depend on the database driver and protocol
+// used. Text values returned as []byte are converted into string, integer, float and boolean values returned as text
+// are parsed, and PostgreSQL arrays are parsed into slices. Values of binary columns remain []byte.
+func (s *ScanContext) normalizedRowElem(index int) interface{} {
+	value := s.rowElem(index)
+
+	if value == nil {
+		return nil
+	}
+
+	typeName := ""
+
+	if index < len(s.columnTypes) {
+		typeName = strings.ToUpper(s.columnTypes[index].DatabaseTypeName())
+	}
+
+	if s.arrayColumns[index] {
+		arrayElems, err := internal.ParseArray(value)
+
+		if err != nil {
+			return value
+		}
+
+		elemTypeName := strings.TrimPrefix(typeName, "_")
+
+		for i, arrayElem := range arrayElems {
+			if text, ok := arrayElem.(string); ok {
+				arrayElems[i] = normalizeText(text, elemTypeName)
+			}
+		}
+
+		return arrayElems
+	}
+
+	bytes, ok := value.([]byte)
+
+	if !ok {
+		return value
+	}
+
+	if isBinaryType(typeName) {
+		return bytes // database/sql already returns a copy of driver bytes
+	}
+
+	return normalizeText(string(bytes), typeName)
+}
+
+func isBinaryType(typeName string) bool {
+	return typeName == "BYTEA" || strings.HasSuffix(typeName, "BLOB") || strings.HasSuffix(typeName, "BINARY") ||
+		typeName == "BIT"
+}
+
+// normalizeText parses text value of integer, float and boolean database types. Values of all the other types,
+// including decimal types to preserve the precision, remain string.
+func normalizeText(text, typeName string) interface{} {
+	switch strings.TrimPrefix(typeName, "UNSIGNED ") {
+	case "INT", "INTEGER", "TINYINT", "SMALLINT", "MEDIUMINT", "BIGINT", "INT2", "INT4", "INT8", "YEAR":
+		if value, err := strconv.ParseInt(text, 10, 64); err == nil {
+			return value
+		}
+
+		if value, err := strconv.ParseUint(text, 10, 64); err == nil {
+			return value
+		}
+	case "FLOAT", "DOUBLE", "REAL", "FLOAT4", "FLOAT8", "DOUBLE PRECISION":
+		if value, err := strconv.ParseFloat(text, 64); err == nil {
+			return value
+		}
+	case "BOOL", "BOOLEAN":
+		if value, err := strconv.ParseBool(text); err == nil {
+			return value
+		}
+	}
+
+	return text
+}
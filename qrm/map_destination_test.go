@@ -0,0 +1,167 @@
+package qrm
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const mapTestQuery = `
+SELECT actor_id AS "actor.actor_id",
+       first_name AS "actor.first_name",
+       photo AS "actor.photo",
+       rating AS "actor.rating",
+       CAST(first_name AS BLOB) AS "name_bytes",
+       actor_id * 10 AS "score"
+FROM actor
+ORDER BY actor_id`
+
+const mapTestEmptyQuery = "SELECT actor_id FROM actor WHERE actor_id > 10"
+
+// openMapTestDB returns stub database with values as text protocol drivers return them
+func openMapTestDB() *sql.DB {
+	return openStubDB(map[string]stubResult{
+		mapTestQuery: {
+			columns: []string{"actor.actor_id", "actor.first_name", "actor.photo", "actor.rating", "name_bytes", "score"},
+			types:   []string{"INTEGER", "TEXT", "BLOB", "REAL", "", ""},
+			rows: [][]driver.Value{
+				{[]byte("1"), []byte("Penelope"), []byte{1, 2}, []byte("4.5"), []byte("Penelope"), int64(10)},
+				{[]byte("2"), []byte("Nick"), nil, nil, []byte("Nick"), int64(20)},
+			},
+		},
+		mapTestEmptyQuery: {
+			columns: []string{"actor_id"},
+		},
+	})
+}
+
+func TestQuery_MapDestination(t *testing.T) {
+	db := openMapTestDB()
+	defer db.Close()
+
+	var dest []map[string]interface{}
+
+	rowsProcessed, err := Query(context.Background(), db, mapTestQuery, nil, &dest)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), rowsProcessed)
+
+	require.Equal(t, []map[string]interface{}{
+		{
+			"actor.actor_id":   int64(1),
+			"actor.first_name": "Penelope",
+			"actor.photo":      []byte{1, 2},
+			"actor.rating":     4.5,
+			"name_bytes":       "Penelope",
+			"score":            int64(10),
+		},
+		{
+			"actor.actor_id":   int64(2),
+			"actor.first_name": "Nick",
+			"actor.photo":      nil,
+			"actor.rating":     nil,
+			"name_bytes":       "Nick",
+			"score":            int64(20),
+		},
+	}, dest)
+
+	var single map[string]interface{}
+
+	_, err = Query(context.Background(), db, mapTestQuery, nil, &single)
+	require.NoError(t, err)
+	require.Equal(t, dest[0], single)
+
+	_, err = Query(context.Background(), db, mapTestEmptyQuery, nil, &single)
+	require.ErrorIs(t, err, ErrNoRows)
+
+	var ptrs []*map[string]interface{}
+
+	_, err = Query(context.Background(), db, mapTestQuery, nil, &ptrs)
+	require.NoError(t, err)
+	require.Len(t, ptrs, 2)
+	require.Equal(t, dest[1], *ptrs[1])
+
+	_, err = Query(WithStrictMode(context.Background(), Strict), db, mapTestQuery, nil, &dest)
+	require.NoError(t, err, "all the columns are mapped into map destination")
+}
+
+func TestQuery_NestedMapDestination(t *testing.T) {
+	db := openMapTestDB()
+	defer db.Close()
+
+	var dest []map[string]map[string]interface{}
+
+	_, err := Query(context.Background(), db, mapTestQuery, nil, &dest)
+	require.NoError(t, err)
+
+	require.Len(t, dest, 2)
+	require.Equal(t, map[string]map[string]interface{}{
+		"actor": {
+			"actor_id":   int64(1),
+			"first_name": "Penelope",
+			"photo":      []byte{1, 2},
+			"rating":     4.5,
+		},
+		"": {
+			"name_bytes": "Penelope",
+			"score":      int64(10),
+		},
+	}, dest[0])
+
+	type Row map[string]map[string]interface{}
+
+	var named []Row
+
+	_, err = Query(context.Background(), db, mapTestQuery, nil, &named)
+	require.NoError(t, err)
+	require.Equal(t, Row(dest[1]), named[1])
+}
+
+func TestScanOneRowToDest_Map(t *testing.T) {
+	db := openMapTestDB()
+	defer db.Close()
+
+	rows, err := db.Query(mapTestQuery)
+	require.NoError(t, err)
+	defer rows.Close()
+
+	scanContext, err := NewScanContext(rows)
+	require.NoError(t, err)
+
+	var actorNames []interface{}
+
+	for rows.Next() {
+		var row map[string]map[string]interface{}
+
+		require.NoError(t, ScanOneRowToDest(scanContext, rows, &row))
+
+		actorNames = append(actorNames, row["actor"]["first_name"])
+	}
+
+	require.NoError(t, rows.Err())
+	require.Equal(t, []interface{}{"Penelope", "Nick"}, actorNames)
+}
+
+func TestNormalizeText(t *testing.T) {
+	require.Equal(t, int64(-12), normalizeText("-12", "INT4"))
+	require.Equal(t, int64(12), normalizeText("12", "UNSIGNED INT"))
+	require.Equal(t, uint64(18446744073709551615), normalizeText("18446744073709551615", "UNSIGNED BIGINT"))
+	require.Equal(t, 1.25, normalizeText("1.25", "DOUBLE"))
+	require.Equal(t, true, normalizeText("t", "BOOL"))
+	require.Equal(t, "12.50", normalizeText("12.50", "NUMERIC"))
+	require.Equal(t, "abc", normalizeText("abc", "INT"))
+	require.Equal(t, "2020-01-02", normalizeText("2020-01-02", "DATE"))
+}
+
+func TestNormalizedRowElem_Array(t *testing.T) {
+	var array interface{} = []byte(`{1,NULL,"a b"}`)
+
+	scanContext := &ScanContext{
+		row:          []interface{}{&array},
+		arrayColumns: []bool{true},
+	}
+
+	require.Equal(t, []interface{}{"1", nil, "a b"}, scanContext.normalizedRowElem(0))
+}
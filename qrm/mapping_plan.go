@@ -0,0 +1,253 @@
+package qrm
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/go-jet/jet/v2/internal/utils"
+)
+
+// MappingPlan describes how query result columns are mapped into destination fields
+type MappingPlan struct {
+	Destination string          // destination type
+	Columns     []ColumnMapping // query result columns, in the query result order
+	Fields      []FieldMapping  // destination fields, in the destination declaration order
+}
+
+// ColumnMapping lists destination fields query result column is mapped into
+type ColumnMapping struct {
+	Column string   // query result column alias
+	Fields []string // destination field paths, empty if column is not mapped
+}
+
+// FieldMapping describes destination field mapping
+type FieldMapping struct {
+	Field    string // destination field path, for instance Films.Title
+	Column   string // query result column alias, empty if there is no column for the field
+	Optional bool   // pointer fields, and fields of the pointer struct fields, are optional
+}
+
+// UnmappedColumns returns query result columns not mapped into any destination field
+func (m MappingPlan) UnmappedColumns() []string {
+	var ret []string
+
+	for _, column := range m.Columns {
+		if len(column.Fields) == 0 {
+			ret = append(ret, column.Column)
+		}
+	}
+
+	return ret
+}
+
+// UnmappedFields returns non-optional destination fields without query result column
+func (m MappingPlan) UnmappedFields() []string {
+	var ret []string
+
+	for _, field := range m.Fields {
+		if field.Column == "" && !field.Optional {
+			ret = append(ret, field.Field)
+		}
+	}
+
+	return ret
+}
+
+// String returns mapping plan in a human-readable table format
+func (m MappingPlan) String() string {
+	var builder strings.Builder
+
+	builder.WriteString("destination: " + m.Destination + "\n")
+
+	writer := tabwriter.NewWriter(&builder, 0, 4, 1, ' ', 0)
+
+	for _, column := range m.Columns {
+		if len(column.Fields) == 0 {
+			fmt.Fprintf(writer, "%s\t-> (unmapped)\n", column.Column)
+			continue
+		}
+
+		fmt.Fprintf(writer, "%s\t-> %s\n", column.Column, strings.Join(column.Fields, ", "))
+	}
+
+	for _, field := range m.Fields {
+		if field.Column != "" {
+			continue
+		}
+
+		if field.Optional {
+			fmt.Fprintf(writer, "(no column)\t-> %s (optional)\n", field.Field)
+		} else {
+			fmt.Fprintf(writer, "(no column)\t-> %s\n", field.Field)
+		}
+	}
+
+	_ = writer.Flush()
+
+	return builder.String()
+}
+
+// NewMappingPlan returns the mapping plan of the query result columns, with the columns aliases, into the destination
+// destPtr. Destination can be either pointer to struct or pointer to slice, the same as for Query. Query is not
+// executed, so the mapping plan can be inspected for any statement, including data modifying statements with the
+// RETURNING clause. Use it only for debug purposes.
+func NewMappingPlan(columns []string, destPtr interface{}) MappingPlan {
+	utils.MustBeInitializedPtr(destPtr, "jet: destination is nil")
+	utils.MustBe(destPtr, reflect.Ptr, "jet: destination has to be a pointer to slice or pointer to struct")
+
+	scanContext := &ScanContext{
+		mapping: newColumnSetMapping(columns),
+	}
+
+	return scanContext.newMappingPlan(destinationType(destPtr))
+}
+
+// destinationType returns the type of a single destination object, for destination pointer to slice it is slice
+// element type
+func destinationType(destPtr interface{}) reflect.Type {
+	destType := reflect.TypeOf(destPtr).Elem()
+
+	if destType.Kind() == reflect.Slice {
+		return indirectType(destType.Elem())
+	}
+
+	return destType
+}
+
+// destinationMapping is a mapping plan of the column set into destination type, with unmapped columns and fields
+// extracted for the strict mapping checks
+type destinationMapping struct {
+	plan            MappingPlan
+	unmappedColumns []string
+	unmappedFields  []string
+}
+
+func (s *ScanContext) getDestinationMapping(destType reflect.Type) destinationMapping {
+	s.mapping.lock.RLock()
+	destMapping, ok := s.mapping.destinations[destType]
+	s.mapping.lock.RUnlock()
+
+	if ok {
+		return destMapping
+	}
+
+	plan := s.newMappingPlan(destType)
+
+	destMapping = destinationMapping{
+		plan:            plan,
+		unmappedColumns: plan.UnmappedColumns(),
+		unmappedFields:  plan.UnmappedFields(),
+	}
+
+	s.mapping.lock.Lock()
+	s.mapping.destinations[destType] = destMapping
+	s.mapping.lock.Unlock()
+
+	return destMapping
+}
+
+// newMappingPlan walks destination type the same way rows are mapped into destination, and records the column
+// used for each of the destination fields
+func (s *ScanContext) newMappingPlan(destType reflect.Type) MappingPlan {
+	builder := mappingPlanBuilder{
+		scanContext:  s,
+		columnFields: make([][]string, len(s.mapping.aliases)),
+		typesVisited: newTypeStack(),
+	}
+
+	if isSimpleModelType(destType) {
+		builder.addField(destType.String(), 0, false)
+	} else if isMapDestinationType(destType) {
+		for i, alias := range s.mapping.aliases {
+			builder.addField(alias, i, false)
+		}
+	} else if destType.Kind() == reflect.Struct {
+		builder.addStructFields(destType, nil, "", false)
+	}
+
+	plan := MappingPlan{
+		Destination: destType.String(),
+		Fields:      builder.fields,
+	}
+
+	for i, alias := range s.mapping.aliases {
+		plan.Columns = append(plan.Columns, ColumnMapping{
+			Column: alias,
+			Fields: builder.columnFields[i],
+		})
+	}
+
+	return plan
+}
+
+type mappingPlanBuilder struct {
+	scanContext  *ScanContext
+	columnFields [][]string
+	fields       []FieldMapping
+	typesVisited typeStack
+}
+
+func (b *mappingPlanBuilder) addField(fieldPath string, columnIndex int, optional bool) {
+	fieldMap := FieldMapping{
+		Field:    fieldPath,
+		Optional: optional,
+	}
+
+	if columnIndex >= 0 && columnIndex < len(b.columnFields) {
+		fieldMap.Column = b.scanContext.mapping.aliases[columnIndex]
+		b.columnFields[columnIndex] = append(b.columnFields[columnIndex], fieldPath)
+	}
+
+	b.fields = append(b.fields, fieldMap)
+}
+
+func (b *mappingPlanBuilder) addStructFields(structType reflect.Type, parentField *reflect.StructField, path string, optional bool) {
+	if b.typesVisited.contains(structType) {
+		return
+	}
+
+	b.typesVisited.push(structType)
+	defer b.typesVisited.pop()
+
+	typeInf := b.scanContext.getTypeInfo(structType, parentField)
+
+	for i := range typeInf.fieldMappings {
+		fieldMap := &typeInf.fieldMappings[i]
+		field := &fieldMap.field
+
+		if !field.IsExported() { // private fields are not mapped
+			continue
+		}
+
+		fieldPath := field.Name
+
+		if path != "" {
+			fieldPath = concat(path, ".", field.Name)
+		}
+
+		fieldOptional := optional || field.Type.Kind() == reflect.Ptr
+
+		if !fieldMap.complexType {
+			b.addField(fieldPath, fieldMap.rowIndex, fieldOptional)
+			continue
+		}
+
+		fieldType := indirectType(field.Type)
+
+		switch fieldType.Kind() {
+		case reflect.Struct:
+			b.addStructFields(fieldType, field, fieldPath, fieldOptional)
+		case reflect.Slice:
+			elemType := indirectType(fieldType.Elem())
+
+			if isSimpleModelType(elemType) {
+				typeName, columnName := getTypeAndFieldName("", *field)
+				b.addField(fieldPath, b.scanContext.typeToColumnIndex(typeName, columnName), fieldOptional)
+			} else if elemType.Kind() == reflect.Struct {
+				b.addStructFields(elemType, field, fieldPath, fieldOptional)
+			}
+		}
+	}
+}
@@ -0,0 +1,129 @@
+package qrm
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type PlanActor struct {
+	ActorID   int64 `sql:"primary_key"`
+	FirstName string
+	LastName  *string
+}
+
+type PlanFilm struct {
+	FilmID int64 `sql:"primary_key"`
+	Title  string
+}
+
+type PlanActorFilms struct {
+	PlanActor
+
+	Films []PlanFilm
+	Tags  []string `alias:"tags"`
+}
+
+const planTestQuery = `
+SELECT actor_id AS "PlanActor.actor_id",
+       first_name AS "PlanActor.first_name",
+       'Academy Dinosaur' AS "PlanFilm.title",
+       'extra' AS "PlanActor.middle_name"
+FROM actor`
+
+const planTestEmptyQuery = `
+SELECT actor_id AS "PlanActor.actor_id",
+       first_name AS "PlanActor.first_name"
+FROM actor
+WHERE actor_id > 10`
+
+func openPlanTestDB() *sql.DB {
+	return openStubDB(map[string]stubResult{
+		planTestQuery: {
+			columns: []string{"PlanActor.actor_id", "PlanActor.first_name", "PlanFilm.title", "PlanActor.middle_name"},
+			rows:    [][]driver.Value{{int64(1), "Penelope", "Academy Dinosaur", "extra"}},
+		},
+		planTestEmptyQuery: {
+			columns: []string{"PlanActor.actor_id", "PlanActor.first_name"},
+		},
+	})
+}
+
+func TestNewMappingPlan(t *testing.T) {
+	var dest []PlanActorFilms
+
+	plan := NewMappingPlan([]string{"PlanActor.actor_id", "PlanActor.first_name", "PlanFilm.title", "PlanActor.middle_name"}, &dest)
+
+	require.Equal(t, "qrm.PlanActorFilms", plan.Destination)
+	require.Equal(t, []ColumnMapping{
+		{Column: "PlanActor.actor_id", Fields: []string{"PlanActor.ActorID"}},
+		{Column: "PlanActor.first_name", Fields: []string{"PlanActor.FirstName"}},
+		{Column: "PlanFilm.title", Fields: []string{"Films.Title"}},
+		{Column: "PlanActor.middle_name"},
+	}, plan.Columns)
+	require.Equal(t, []string{"PlanActor.middle_name"}, plan.UnmappedColumns())
+	require.Equal(t, []string{"Films.FilmID", "Tags"}, plan.UnmappedFields())
+
+	require.Equal(t, `destination: qrm.PlanActorFilms
+PlanActor.actor_id    -> PlanActor.ActorID
+PlanActor.first_name  -> PlanActor.FirstName
+PlanFilm.title        -> Films.Title
+PlanActor.middle_name -> (unmapped)
+(no column)           -> PlanActor.LastName (optional)
+(no column)           -> Films.FilmID
+(no column)           -> Tags
+`, plan.String())
+
+	var single int64
+
+	plan = NewMappingPlan([]string{"count"}, &single)
+	require.Equal(t, []ColumnMapping{{Column: "count", Fields: []string{"int64"}}}, plan.Columns)
+}
+
+func TestQuery_StrictMode(t *testing.T) {
+	db := openPlanTestDB()
+	defer db.Close()
+
+	var dest []PlanActor
+
+	_, err := Query(context.Background(), db, planTestQuery, nil, &dest)
+	require.NoError(t, err)
+	require.Len(t, dest, 1)
+
+	ctx := WithStrictMode(context.Background(), StrictColumns)
+
+	_, err = Query(ctx, db, planTestQuery, nil, &dest)
+	require.EqualError(t, err, "jet: strict mapping into qrm.PlanActor failed, unmapped columns: PlanFilm.title, PlanActor.middle_name")
+
+	var mappingErr *StrictMappingError
+	require.True(t, errors.As(err, &mappingErr))
+	require.Equal(t, []string{"PlanFilm.title", "PlanActor.middle_name"}, mappingErr.UnmappedColumns)
+	require.Empty(t, mappingErr.UnmappedFields)
+
+	var films PlanActorFilms
+
+	_, err = Query(WithStrictMode(context.Background(), StrictFields), db, planTestQuery, nil, &films)
+	require.EqualError(t, err, "jet: strict mapping into qrm.PlanActorFilms failed, unmapped fields: Films.FilmID, Tags")
+
+	var empty []PlanActor
+
+	_, err = Query(WithStrictMode(context.Background(), Strict), db, planTestEmptyQuery, nil, &empty)
+	require.NoError(t, err, "pointer fields are optional")
+	require.Empty(t, empty)
+
+	rows, err := db.Query(planTestQuery)
+	require.NoError(t, err)
+	defer rows.Close()
+
+	scanContext, err := NewScanContext(rows, Strict)
+	require.NoError(t, err)
+	require.True(t, rows.Next())
+
+	var actor PlanActor
+	err = ScanOneRowToDest(scanContext, rows, &actor)
+	require.EqualError(t, err, "jet: strict mapping into qrm.PlanActor failed, unmapped columns: PlanFilm.title, PlanActor.middle_name")
+}
@@ -8,6 +8,7 @@ import (
 	"reflect"
 
 	"github.com/go-jet/jet/v2/internal/utils"
+	"github.com/go-jet/jet/v2/qrm/internal"
 )
 
 // ErrNoRows is returned by Query when query result set is empty
@@ -17,6 +18,10 @@ var ErrNoRows = errors.New("qrm: no rows in result set")
 // using context `ctx` into destination `destPtr`.
 // Destination can be either pointer to struct or pointer to slice of structs.
 // If destination is pointer to struct and query result set is empty, method returns qrm.ErrNoRows.
+// Destination can also be pointer to slice of map[string]interface{}, with column aliases as keys, or pointer to
+// slice of map[string]map[string]interface{}, with 'table.column' aliases split into table and column keys. Map
+// values are normalized driver values. If destination is pointer to map, only the first row is returned.
+// Strict mapping mode, set in the context with WithStrictMode, reports unmapped columns and fields as errors.
 func Query(ctx context.Context, db Queryable, query string, args []interface{}, destPtr interface{}) (rowsProcessed int64, err error) {
 
 	utils.MustBeInitializedPtr(db, "jet: db is nil")
@@ -56,6 +61,22 @@ func Query(ctx context.Context, db Queryable, query string, args []interface{},
 		if structValue.Type().AssignableTo(firstTempStruct.Type()) {
 			structValue.Set(tempSliceValue.Index(0).Elem())
 		}
+		return rowsProcessed, nil
+	} else if isMapDestinationType(destinationPtrType.Elem()) {
+		tempSlicePtrValue := reflect.New(reflect.SliceOf(destinationPtrType.Elem()))
+
+		rowsProcessed, err := queryToSlice(ctx, db, query, args, tempSlicePtrValue.Interface())
+
+		if err != nil {
+			return rowsProcessed, fmt.Errorf("jet: %w", err)
+		}
+
+		if rowsProcessed == 0 {
+			return 0, ErrNoRows
+		}
+
+		reflect.ValueOf(destPtr).Elem().Set(tempSlicePtrValue.Elem().Index(0))
+
 		return rowsProcessed, nil
 	} else {
 		panic("jet: destination has to be a pointer to slice or pointer to struct")
@@ -71,6 +92,10 @@ func ScanOneRowToDest(scanContext *ScanContext, rows *sql.Rows, destPtr interfac
 		return errors.New("empty row slice")
 	}
 
+	if err := scanContext.checkStrictMode(destinationType(destPtr)); err != nil {
+		return fmt.Errorf("jet: %w", err)
+	}
+
 	err := rows.Scan(scanContext.row...)
 
 	if err != nil {
@@ -79,7 +104,12 @@ func ScanOneRowToDest(scanContext *ScanContext, rows *sql.Rows, destPtr interfac
 
 	destValuePtr := reflect.ValueOf(destPtr)
 
-	_, err = mapRowToStruct(scanContext, "", destValuePtr, nil)
+	if destType := destValuePtr.Type().Elem(); isMapDestinationType(destType) {
+		destValuePtr.Elem().Set(scanContext.rowToMapValue(destType))
+		return nil
+	}
+
+	_, err = mapRowToStruct(scanContext, groupKey{}, destValuePtr, nil)
 
 	if err != nil {
 		return fmt.Errorf("jet: failed to scan a row into destination, %w", err)
@@ -88,6 +118,75 @@ func ScanOneRowToDest(scanContext *ScanContext, rows *sql.Rows, destPtr interfac
 	return nil
 }
 
+// ScanGroupToDest maps the next group of rows into struct destination. Group contains consecutive rows with the
+// same destination group key (destination primary key column values), so rows have to be ordered by the group key.
+// Destination object is complete as soon as the group key changes, and only the rows of the current group are
+// processed in memory. Method returns false when there are no more rows.
+func ScanGroupToDest(scanContext *ScanContext, rows *sql.Rows, destPtr interface{}) (bool, error) {
+	utils.MustBeInitializedPtr(destPtr, "jet: destination is nil")
+	utils.MustBe(destPtr, reflect.Ptr, "jet: destination has to be a pointer to struct")
+
+	destType := reflect.TypeOf(destPtr).Elem()
+	utils.TypeMustBe(destType, reflect.Struct, "jet: destination has to be a pointer to struct")
+
+	if len(scanContext.row) == 0 {
+		return false, errors.New("empty row slice")
+	}
+
+	if err := scanContext.checkStrictMode(destType); err != nil {
+		return false, fmt.Errorf("jet: %w", err)
+	}
+
+	slicePtrValue := reflect.New(reflect.SliceOf(destType))
+	scanContext.uniqueDestObjectsMap = make(map[groupKey]int) // group keys of the previous groups are not needed anymore
+
+	var currentGroupKey groupKey
+	var groupStarted bool
+
+	for {
+		if !scanContext.pendingRow {
+			if !rows.Next() {
+				break
+			}
+
+			if err := rows.Scan(scanContext.row...); err != nil {
+				return false, fmt.Errorf("jet: rows scan error, %w", err)
+			}
+
+			scanContext.rowNum++
+		}
+
+		scanContext.pendingRow = false
+
+		rowGroupKey := scanContext.getGroupKey(destType, nil)
+
+		if groupStarted && rowGroupKey != currentGroupKey {
+			scanContext.pendingRow = true // current row is the first row of the next group
+			break
+		}
+
+		currentGroupKey, groupStarted = rowGroupKey, true
+
+		if _, err := mapRowToSlice(scanContext, groupKey{}, slicePtrValue, nil); err != nil {
+			return false, fmt.Errorf("jet: failed to scan a row into destination, %w", err)
+		}
+	}
+
+	if !groupStarted {
+		return false, rows.Err()
+	}
+
+	destValue := reflect.ValueOf(destPtr).Elem()
+
+	if sliceValue := slicePtrValue.Elem(); sliceValue.Len() > 0 {
+		destValue.Set(sliceValue.Index(0))
+	} else {
+		destValue.Set(reflect.Zero(destType)) // edge case when group rows contain only NULLs
+	}
+
+	return true, nil
+}
+
 func queryToSlice(ctx context.Context, db Queryable, query string, args []interface{}, slicePtr interface{}) (rowsProcessed int64, err error) {
 	if ctx == nil {
 		ctx = context.Background()
@@ -100,12 +199,16 @@ func queryToSlice(ctx context.Context, db Queryable, query string, args []interf
 	}
 	defer rows.Close()
 
-	scanContext, err := NewScanContext(rows)
+	scanContext, err := NewScanContext(rows, StrictModeFromContext(ctx))
 
 	if err != nil {
 		return
 	}
 
+	if err = scanContext.checkStrictMode(destinationType(slicePtr)); err != nil {
+		return
+	}
+
 	if len(scanContext.row) == 0 {
 		return
 	}
@@ -121,7 +224,7 @@ func queryToSlice(ctx context.Context, db Queryable, query string, args []interf
 
 		scanContext.rowNum++
 
-		_, err = mapRowToSlice(scanContext, "", slicePtrValue, nil)
+		_, err = mapRowToSlice(scanContext, groupKey{}, slicePtrValue, nil)
 
 		if err != nil {
 			return scanContext.rowNum, err
@@ -138,7 +241,7 @@ func queryToSlice(ctx context.Context, db Queryable, query string, args []interf
 
 func mapRowToSlice(
 	scanContext *ScanContext,
-	groupKey string,
+	parentGroupKey groupKey,
 	slicePtrValue reflect.Value,
 	field *reflect.StructField) (updated bool, err error) {
 
@@ -149,30 +252,34 @@ func mapRowToSlice(
 		return
 	}
 
-	utils.TypeMustBe(sliceElemType, reflect.Struct, "jet: unsupported slice element type"+fieldToString(field))
+	if field == nil && isMapDestinationType(sliceElemType) {
+		return mapRowToMapSlice(scanContext, slicePtrValue, sliceElemType), nil
+	}
 
-	structGroupKey := scanContext.getGroupKey(sliceElemType, field)
+	if sliceElemType.Kind() != reflect.Struct {
+		panic("jet: unsupported slice element type" + fieldToString(field))
+	}
 
-	groupKey = concat(groupKey, ",", structGroupKey)
+	key := concatGroupKeys(parentGroupKey, scanContext.getGroupKey(sliceElemType, field))
 
-	index, ok := scanContext.uniqueDestObjectsMap[groupKey]
+	index, ok := scanContext.uniqueDestObjectsMap[key]
 
 	if ok {
 		structPtrValue := getSliceElemPtrAt(slicePtrValue, index)
 
-		return mapRowToStruct(scanContext, groupKey, structPtrValue, field, true)
+		return mapRowToStruct(scanContext, key, structPtrValue, field, true)
 	}
 
 	destinationStructPtr := newElemPtrValueForSlice(slicePtrValue)
 
-	updated, err = mapRowToStruct(scanContext, groupKey, destinationStructPtr, field)
+	updated, err = mapRowToStruct(scanContext, key, destinationStructPtr, field)
 
 	if err != nil {
 		return
 	}
 
 	if updated {
-		scanContext.uniqueDestObjectsMap[groupKey] = slicePtrValue.Elem().Len()
+		scanContext.uniqueDestObjectsMap[key] = slicePtrValue.Elem().Len()
 		err = appendElemToSlice(slicePtrValue, destinationStructPtr)
 
 		if err != nil {
@@ -191,6 +298,10 @@ func mapRowToBaseTypeSlice(scanContext *ScanContext, slicePtrValue reflect.Value
 			return
 		}
 	}
+	if scanContext.arrayColumns[index] {
+		return mapArrayToBaseTypeSlice(scanContext, index, slicePtrValue, field)
+	}
+
 	rowElemPtr := scanContext.rowElemValueClonePtr(index)
 
 	if rowElemPtr.IsValid() && !rowElemPtr.IsNil() {
@@ -204,9 +315,58 @@ func mapRowToBaseTypeSlice(scanContext *ScanContext, slicePtrValue reflect.Value
 	return
 }
 
+// mapArrayToBaseTypeSlice maps array column value to the slice of base types. If slice is struct field, array elements
+// will replace slice content, otherwise array elements are appended to the slice.
+func mapArrayToBaseTypeSlice(scanContext *ScanContext, index int, slicePtrValue reflect.Value, field *reflect.StructField) (updated bool, err error) {
+	rowElem := scanContext.rowElemValue(index)
+
+	if !rowElem.IsValid() {
+		return
+	}
+
+	arrayElems, err := internal.ParseArray(rowElem.Interface())
+
+	if err != nil {
+		return false, fmt.Errorf("can't scan array%s: %w", fieldToString(field), err)
+	}
+
+	sliceValue := slicePtrValue.Elem()
+	sliceElemType := sliceValue.Type().Elem()
+
+	if field != nil {
+		sliceValue.Set(reflect.MakeSlice(sliceValue.Type(), 0, len(arrayElems)))
+	}
+
+	for _, arrayElem := range arrayElems {
+		newSliceElemValue := reflect.New(sliceElemType).Elem()
+
+		if arrayElem != nil {
+			err = assignArrayElem(arrayElem, newSliceElemValue)
+
+			if err != nil {
+				return false, fmt.Errorf("can't append %q to %s slice%s: %w", arrayElem, sliceValue.Type(), fieldToString(field), err)
+			}
+		}
+
+		sliceValue.Set(reflect.Append(sliceValue, newSliceElemValue))
+	}
+
+	return true, nil
+}
+
+func assignArrayElem(arrayElem interface{}, destination reflect.Value) error {
+	if implementsScannerType(destination.Type()) {
+		initializeValueIfNilPtr(destination)
+
+		return getScanner(destination).Scan(arrayElem)
+	}
+
+	return assign(reflect.ValueOf(arrayElem), destination)
+}
+
 func mapRowToStruct(
 	scanContext *ScanContext,
-	groupKey string,
+	key groupKey,
 	structPtrValue reflect.Value,
 	parentField *reflect.StructField,
 	onlySlices ...bool, // small optimization, not to assign to already assigned struct fields
@@ -215,11 +375,11 @@ func mapRowToStruct(
 	mapOnlySlices := len(onlySlices) > 0
 	structType := structPtrValue.Type().Elem()
 
-	if scanContext.typesVisited.contains(&structType) {
+	if scanContext.typesVisited.contains(structType) {
 		return false, nil
 	}
 
-	scanContext.typesVisited.push(&structType)
+	scanContext.typesVisited.push(structType)
 	defer scanContext.typesVisited.pop()
 
 	typeInf := scanContext.getTypeInfo(structType, parentField)
@@ -227,18 +387,18 @@ func mapRowToStruct(
 	structValue := structPtrValue.Elem()
 
 	for i := 0; i < structValue.NumField(); i++ {
-		field := structType.Field(i)
 		fieldValue := structValue.Field(i)
 
 		if !fieldValue.CanSet() { // private field
 			continue
 		}
 
-		fieldMap := typeInf.fieldMappings[i]
+		fieldMap := &typeInf.fieldMappings[i]
+		field := &fieldMap.field // shared by all the rows, it must not be modified
 
 		if fieldMap.complexType {
 			var changed bool
-			changed, err = mapRowToDestinationValue(scanContext, groupKey, fieldValue, &field)
+			changed, err = mapRowToDestinationValue(scanContext, key, fieldValue, field)
 
 			if err != nil {
 				return
@@ -289,7 +449,7 @@ func mapRowToStruct(
 
 func mapRowToDestinationValue(
 	scanContext *ScanContext,
-	groupKey string,
+	key groupKey,
 	dest reflect.Value,
 	structField *reflect.StructField) (updated bool, err error) {
 
@@ -305,7 +465,7 @@ func mapRowToDestinationValue(
 		}
 	}
 
-	updated, err = mapRowToDestinationPtr(scanContext, groupKey, destPtrValue, structField)
+	updated, err = mapRowToDestinationPtr(scanContext, key, destPtrValue, structField)
 
 	if err != nil {
 		return
@@ -320,7 +480,7 @@ func mapRowToDestinationValue(
 
 func mapRowToDestinationPtr(
 	scanContext *ScanContext,
-	groupKey string,
+	key groupKey,
 	destPtrValue reflect.Value,
 	structField *reflect.StructField) (updated bool, err error) {
 
@@ -329,9 +489,9 @@ func mapRowToDestinationPtr(
 	destValueKind := destPtrValue.Elem().Kind()
 
 	if destValueKind == reflect.Struct {
-		return mapRowToStruct(scanContext, groupKey, destPtrValue, structField)
+		return mapRowToStruct(scanContext, key, destPtrValue, structField)
 	} else if destValueKind == reflect.Slice {
-		return mapRowToSlice(scanContext, groupKey, destPtrValue, structField)
+		return mapRowToSlice(scanContext, key, destPtrValue, structField)
 	} else {
 		panic("jet: unsupported dest type: " + structField.Name + " " + structField.Type.String())
 	}
@@ -0,0 +1,155 @@
+package qrm
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type benchCustomer struct {
+	CustomerID int64 `sql:"primary_key"`
+	Name       string
+	CreatedAt  time.Time
+}
+
+type benchOrder struct {
+	OrderID int64 `sql:"primary_key"`
+	Status  string
+}
+
+type benchLineItem struct {
+	OrderID  int64  `sql:"primary_key"`
+	Product  string `sql:"primary_key"`
+	Quantity int32
+	Price    float64
+}
+
+type benchCustomerOrders struct {
+	benchCustomer
+
+	Orders []struct {
+		benchOrder
+
+		LineItems []benchLineItem
+	}
+}
+
+const benchQuery = `
+SELECT c.customer_id AS "benchCustomer.customer_id",
+       c.name AS "benchCustomer.name",
+       c.created_at AS "benchCustomer.created_at",
+       o.order_id AS "benchOrder.order_id",
+       o.status AS "benchOrder.status",
+       l.order_id AS "benchLineItem.order_id",
+       l.product AS "benchLineItem.product",
+       l.quantity AS "benchLineItem.quantity",
+       l.price AS "benchLineItem.price"
+FROM customer AS c
+     JOIN orders AS o ON o.customer_id = c.customer_id
+     JOIN line_item AS l ON l.order_id = o.order_id
+ORDER BY c.customer_id, o.order_id, l.product`
+
+// openBenchDB returns stub database with customers, orders and line items. Each customer has 5 orders,
+// and each order has 4 line items. Rows are served from memory, so the benchmarks measure qrm mapping allocations only.
+func openBenchDB(customers int) *sql.DB {
+	createdAt := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	var rows [][]driver.Value
+
+	for c := 1; c <= customers; c++ {
+		for o := 1; o <= 5; o++ {
+			orderID := int64(c*10 + o)
+
+			for l := 1; l <= 4; l++ {
+				rows = append(rows, []driver.Value{
+					int64(c), fmt.Sprintf("customer %d", c), createdAt,
+					orderID, "shipped",
+					orderID, fmt.Sprintf("product %d", l), int64(l), float64(l) + 0.5,
+				})
+			}
+		}
+	}
+
+	return openStubDB(map[string]stubResult{
+		benchQuery: {
+			columns: []string{
+				"benchCustomer.customer_id", "benchCustomer.name", "benchCustomer.created_at",
+				"benchOrder.order_id", "benchOrder.status",
+				"benchLineItem.order_id", "benchLineItem.product", "benchLineItem.quantity", "benchLineItem.price",
+			},
+			types: []string{"INTEGER", "TEXT", "TIMESTAMP", "INTEGER", "TEXT", "INTEGER", "TEXT", "INTEGER", "REAL"},
+			rows:  rows,
+		},
+	})
+}
+
+func BenchmarkQuery_NestedSlice(b *testing.B) {
+	db := openBenchDB(50) // 1000 rows
+	defer db.Close()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		var dest []benchCustomerOrders
+
+		_, err := Query(context.Background(), db, benchQuery, nil, &dest)
+		require.NoError(b, err)
+		require.Len(b, dest, 50)
+	}
+}
+
+func BenchmarkQuery_SmallResult(b *testing.B) {
+	db := openBenchDB(1) // 20 rows, mapping setup dominates
+	defer db.Close()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		var dest benchCustomerOrders
+
+		_, err := Query(context.Background(), db, benchQuery, nil, &dest)
+		require.NoError(b, err)
+		require.Len(b, dest.Orders, 5)
+	}
+}
+
+func BenchmarkScanGroupToDest(b *testing.B) {
+	db := openBenchDB(50)
+	defer db.Close()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		rows, err := db.Query(benchQuery)
+		require.NoError(b, err)
+
+		scanContext, err := NewScanContext(rows)
+		require.NoError(b, err)
+
+		customers := 0
+
+		for {
+			var dest benchCustomerOrders
+
+			ok, err := ScanGroupToDest(scanContext, rows, &dest)
+			require.NoError(b, err)
+
+			if !ok {
+				break
+			}
+
+			customers++
+		}
+
+		require.Equal(b, 50, customers)
+		require.NoError(b, rows.Close())
+	}
+}
@@ -2,26 +2,30 @@ package qrm
 
 import (
 	"database/sql"
-	"fmt"
 	"reflect"
 	"strings"
+	"sync"
+	"sync/atomic"
 )
 
 // ScanContext  contains information about current row processed, mapping from the row to the
 // destination types and type grouping information.
 type ScanContext struct {
-	rowNum                   int64
-	row                      []interface{}
-	uniqueDestObjectsMap     map[string]int
-	commonIdentToColumnIndex map[string]int
-	groupKeyInfoCache        map[string]groupKeyInfo
-	typeInfoMap              map[string]typeInfo
+	rowNum               int64
+	row                  []interface{}
+	pendingRow           bool // row is scanned, but not yet mapped by ScanGroupToDest
+	uniqueDestObjectsMap map[groupKey]int
+	arrayColumns         []bool // true for PostgreSQL array columns
+	columnTypes          []*sql.ColumnType
+	strictMode           StrictMode
+	mapping              *columnSetMapping
 
 	typesVisited typeStack // to prevent circular dependency scan
 }
 
-// NewScanContext creates new ScanContext from rows
-func NewScanContext(rows *sql.Rows) (*ScanContext, error) {
+// NewScanContext creates new ScanContext from rows. Optional strict mode defines which mapping mismatches are
+// reported as errors.
+func NewScanContext(rows *sql.Rows, strictMode ...StrictMode) (*ScanContext, error) {
 	aliases, err := rows.Columns()
 
 	if err != nil {
@@ -34,6 +38,99 @@ func NewScanContext(rows *sql.Rows) (*ScanContext, error) {
 		return nil, err
 	}
 
+	arrayColumns := make([]bool, len(columnTypes))
+
+	for i, columnType := range columnTypes {
+		// PostgreSQL array type names are element type names prefixed with underscore, for instance _INT4
+		arrayColumns[i] = strings.HasPrefix(columnType.DatabaseTypeName(), "_")
+	}
+
+	var mode StrictMode
+
+	for _, m := range strictMode {
+		mode |= m
+	}
+
+	return &ScanContext{
+		row:                  createScanSlice(len(columnTypes)),
+		uniqueDestObjectsMap: make(map[groupKey]int),
+		arrayColumns:         arrayColumns,
+		columnTypes:          columnTypes,
+		strictMode:           mode,
+		mapping:              getColumnSetMapping(aliases, arrayColumns),
+
+		typesVisited: newTypeStack(),
+	}, nil
+}
+
+// columnSetMapping is a compiled mapping of the query result column set into destination types. Destination type
+// mappings and group key information are compiled lazily, the first time a destination type is mapped, and column
+// set mappings are cached process-wide, so the queries with the same column set reuse compiled mappings for all the
+// destination types.
+type columnSetMapping struct {
+	aliases                  []string
+	commonIdentToColumnIndex map[string]int
+
+	lock          sync.RWMutex
+	typeInfos     map[typeInfoKey]typeInfo
+	groupKeyInfos map[typeInfoKey]groupKeyInfo
+	destinations  map[reflect.Type]destinationMapping
+}
+
+// typeInfoKey identifies destination struct type mapping. Parent field tag is part of the key, because parent
+// field alias and primary key overwrite tags change the destination struct mapping.
+type typeInfoKey struct {
+	structType reflect.Type
+	parentTag  reflect.StructTag
+}
+
+// maxColumnSetMappings limits the number of cached column set mappings, in case of the applications constructing
+// unbounded number of different queries. Column set mappings over the limit are not cached.
+const maxColumnSetMappings = 1000
+
+var (
+	columnSetMappings      sync.Map // column set key -> *columnSetMapping
+	columnSetMappingsCount int64
+)
+
+func getColumnSetMapping(aliases []string, arrayColumns []bool) *columnSetMapping {
+	key := columnSetKey(aliases, arrayColumns)
+
+	if mapping, ok := columnSetMappings.Load(key); ok {
+		return mapping.(*columnSetMapping)
+	}
+
+	mapping := newColumnSetMapping(aliases)
+
+	if atomic.LoadInt64(&columnSetMappingsCount) >= maxColumnSetMappings {
+		return mapping
+	}
+
+	cachedMapping, loaded := columnSetMappings.LoadOrStore(key, mapping)
+
+	if !loaded {
+		atomic.AddInt64(&columnSetMappingsCount, 1)
+	}
+
+	return cachedMapping.(*columnSetMapping)
+}
+
+func columnSetKey(aliases []string, arrayColumns []bool) string {
+	var key strings.Builder
+
+	for i, alias := range aliases {
+		if arrayColumns[i] {
+			key.WriteString("[]")
+		}
+
+		key.WriteString(alias)
+		key.WriteByte(0)
+	}
+
+	return key.String()
+}
+
+func newColumnSetMapping(aliases []string) *columnSetMapping {
 	commonIdentToColumnIndex := map[string]int{}
 
 	for i, alias := range aliases {
@@ -47,17 +144,13 @@ func NewScanContext(rows *sql.Rows) (*ScanContext, error) {
 		commonIdentToColumnIndex[commonIdentifier] = i
 	}
 
-	return &ScanContext{
-		row:                  createScanSlice(len(columnTypes)),
-		uniqueDestObjectsMap: make(map[string]int),
-
-		groupKeyInfoCache:        make(map[string]groupKeyInfo),
+	return &columnSetMapping{
+		aliases:                  aliases,
 		commonIdentToColumnIndex: commonIdentToColumnIndex,
-
-		typeInfoMap: make(map[string]typeInfo),
-
-		typesVisited: newTypeStack(),
-	}, nil
+		typeInfos:                make(map[typeInfoKey]typeInfo),
+		groupKeyInfos:            make(map[typeInfoKey]groupKeyInfo),
+		destinations:             make(map[reflect.Type]destinationMapping),
+	}
 }
 
 func createScanSlice(columnCount int) []interface{} {
@@ -76,21 +169,31 @@ type typeInfo struct {
 }
 
 type fieldMapping struct {
+	field             reflect.StructField
 	complexType       bool // slice and struct are complex types
 	rowIndex          int  // index in ScanContext.row
 	implementsScanner bool
 }
 
-func (s *ScanContext) getTypeInfo(structType reflect.Type, parentField *reflect.StructField) typeInfo {
-
-	typeMapKey := structType.String()
+func newTypeInfoKey(structType reflect.Type, parentField *reflect.StructField) typeInfoKey {
+	key := typeInfoKey{structType: structType}
 
 	if parentField != nil {
-		typeMapKey = concat(typeMapKey, string(parentField.Tag))
+		key.parentTag = parentField.Tag
 	}
 
-	if typeInfo, ok := s.typeInfoMap[typeMapKey]; ok {
-		return typeInfo
+	return key
+}
+
+func (s *ScanContext) getTypeInfo(structType reflect.Type, parentField *reflect.StructField) typeInfo {
+	typeMapKey := newTypeInfoKey(structType, parentField)
+
+	s.mapping.lock.RLock()
+	typeInf, ok := s.mapping.typeInfos[typeMapKey]
+	s.mapping.lock.RUnlock()
+
+	if ok {
+		return typeInf
 	}
 
 	typeName := getTypeName(structType, parentField)
@@ -104,6 +207,7 @@ func (s *ScanContext) getTypeInfo(structType reflect.Type, parentField *reflect.
 		columnIndex := s.typeToColumnIndex(newTypeName, fieldName)
 
 		fieldMap := fieldMapping{
+			field:    field,
 			rowIndex: columnIndex,
 		}
 
@@ -116,7 +220,9 @@ func (s *ScanContext) getTypeInfo(structType reflect.Type, parentField *reflect.
 		newTypeInfo.fieldMappings = append(newTypeInfo.fieldMappings, fieldMap)
 	}
 
-	s.typeInfoMap[typeMapKey] = newTypeInfo
+	s.mapping.lock.Lock()
+	s.mapping.typeInfos[typeMapKey] = newTypeInfo
+	s.mapping.lock.Unlock()
 
 	return newTypeInfo
 }
@@ -127,43 +233,47 @@ type groupKeyInfo struct {
 	subTypes []groupKeyInfo
 }
 
-func (s *ScanContext) getGroupKey(structType reflect.Type, structField *reflect.StructField) string {
+func (s *ScanContext) getGroupKey(structType reflect.Type, structField *reflect.StructField) groupKey {
+	mapKey := newTypeInfoKey(structType, structField)
 
-	mapKey := structType.Name()
+	s.mapping.lock.RLock()
+	keyInfo, ok := s.mapping.groupKeyInfos[mapKey]
+	s.mapping.lock.RUnlock()
 
-	if structField != nil {
-		mapKey = concat(mapKey, structField.Type.String())
-	}
+	if !ok {
+		tempTypeStack := newTypeStack()
+		keyInfo = s.getGroupKeyInfo(structType, structField, &tempTypeStack)
 
-	if groupKeyInfo, ok := s.groupKeyInfoCache[mapKey]; ok {
-		return s.constructGroupKey(groupKeyInfo)
+		s.mapping.lock.Lock()
+		s.mapping.groupKeyInfos[mapKey] = keyInfo
+		s.mapping.lock.Unlock()
 	}
 
-	tempTypeStack := newTypeStack()
-	groupKeyInfo := s.getGroupKeyInfo(structType, structField, &tempTypeStack)
-
-	s.groupKeyInfoCache[mapKey] = groupKeyInfo
-
-	return s.constructGroupKey(groupKeyInfo)
+	return s.constructGroupKey(keyInfo)
 }
 
-func (s *ScanContext) constructGroupKey(groupKeyInfo groupKeyInfo) string {
+func (s *ScanContext) constructGroupKey(groupKeyInfo groupKeyInfo) groupKey {
+	var hash groupKeyHash
+	hash.reset()
+
 	if len(groupKeyInfo.indexes) == 0 && len(groupKeyInfo.subTypes) == 0 {
-		return fmt.Sprintf("|ROW:%d|", s.rowNum)
+		hash.writeByte(rowNumMarker)
+		hash.writeUint64(uint64(s.rowNum))
+
+		return hash.sum()
 	}
 
-	var groupKeys []string
+	hash.writeString(groupKeyInfo.typeName)
 
 	for _, index := range groupKeyInfo.indexes {
-		groupKeys = append(groupKeys, s.rowElemToString(index))
+		hash.writeValue(s.rowElem(index))
 	}
 
-	var subTypesGroupKeys []string
 	for _, subType := range groupKeyInfo.subTypes {
-		subTypesGroupKeys = append(subTypesGroupKeys, s.constructGroupKey(subType))
+		hash.writeGroupKey(s.constructGroupKey(subType))
 	}
 
-	return concat(groupKeyInfo.typeName, "(", strings.Join(groupKeys, ","), strings.Join(subTypesGroupKeys, ","), ")")
+	return hash.sum()
 }
 
 func (s *ScanContext) getGroupKeyInfo(
@@ -173,11 +283,11 @@ func (s *ScanContext) getGroupKeyInfo(
 
 	ret := groupKeyInfo{typeName: structType.Name()}
 
-	if typeVisited.contains(&structType) {
+	if typeVisited.contains(structType) {
 		return ret
 	}
 
-	typeVisited.push(&structType)
+	typeVisited.push(structType)
 	defer typeVisited.pop()
 
 	typeName := getTypeName(structType, parentField)
@@ -220,7 +330,7 @@ func (s *ScanContext) typeToColumnIndex(typeName, fieldName string) int {
 		key = strings.ToLower(fieldName)
 	}
 
-	index, ok := s.commonIdentToColumnIndex[key]
+	index, ok := s.mapping.commonIdentToColumnIndex[key]
 
 	if !ok {
 		return -1
@@ -236,20 +346,9 @@ func (s *ScanContext) rowElemValue(index int) reflect.Value {
 	return scannedValue.Elem().Elem() // no need to check validity of Elem, because s.row[index] always contains interface in interface
 }
 
-func (s *ScanContext) rowElemToString(index int) string {
-	value := s.rowElemValue(index)
-
-	if !value.IsValid() {
-		return "nil"
-	}
-
-	valueInterface := value.Interface()
-
-	if t, ok := valueInterface.(fmt.Stringer); ok {
-		return t.String()
-	}
-
-	return fmt.Sprintf("%#v", valueInterface)
+// rowElem returns scanned driver value
+func (s *ScanContext) rowElem(index int) interface{} {
+	return *(s.row[index].(*interface{}))
 }
 
 func (s *ScanContext) rowElemValueClonePtr(index int) reflect.Value {
@@ -0,0 +1,111 @@
+package qrm
+
+import (
+	"context"
+	"database/sql/driver"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetColumnSetMapping(t *testing.T) {
+	mapping := getColumnSetMapping([]string{"actor.actor_id", "actor.first_name"}, []bool{false, false})
+
+	require.Same(t, mapping, getColumnSetMapping([]string{"actor.actor_id", "actor.first_name"}, []bool{false, false}))
+	require.NotSame(t, mapping, getColumnSetMapping([]string{"actor.actor_id"}, []bool{false}))
+	require.NotSame(t, mapping, getColumnSetMapping([]string{"actor.actor_id", "actor.first_name"}, []bool{false, true}))
+	require.NotSame(t, mapping, getColumnSetMapping([]string{"actor.actor_id", "actor.first_name", ""}, []bool{false, false, false}))
+
+	require.Equal(t, map[string]int{"actor.actorid": 0, "actor.firstname": 1}, mapping.commonIdentToColumnIndex)
+}
+
+func TestGroupKeyHash(t *testing.T) {
+	hashOf := func(values ...interface{}) groupKey {
+		var hash groupKeyHash
+		hash.reset()
+
+		for _, value := range values {
+			hash.writeValue(value)
+		}
+
+		return hash.sum()
+	}
+
+	now := time.Now()
+
+	require.Equal(t, hashOf(int64(1), "a", now), hashOf(int64(1), "a", now))
+	require.Equal(t, hashOf(nil), hashOf(nil))
+
+	require.NotEqual(t, hashOf(int64(1)), hashOf(int64(2)))
+	require.NotEqual(t, hashOf(int64(1)), hashOf(float64(1)))
+	require.NotEqual(t, hashOf("1"), hashOf([]byte("1")))
+	require.NotEqual(t, hashOf("ab", "c"), hashOf("a", "bc"))
+	require.NotEqual(t, hashOf(nil), hashOf(int64(0)))
+	require.NotEqual(t, hashOf(now), hashOf(now.Add(time.Nanosecond)))
+	require.NotEqual(t, hashOf(int32(1)), hashOf(int64(1)))
+
+	key1, key2 := hashOf(int64(1)), hashOf(int64(2))
+	require.NotEqual(t, concatGroupKeys(key1, key2), concatGroupKeys(key2, key1))
+}
+
+func TestQuery_ConcurrentPlanUse(t *testing.T) {
+	query := `SELECT item_id AS "item.item_id", name AS "item.name", tag AS "tag.tag" FROM item ORDER BY item_id, tag`
+
+	db := openStubDB(map[string]stubResult{
+		query: {
+			columns: []string{"item.item_id", "item.name", "tag.tag"},
+			rows: [][]driver.Value{
+				{int64(1), "first", "a"},
+				{int64(1), "first", "b"},
+				{int64(2), "second", "c"},
+			},
+		},
+	})
+	defer db.Close()
+
+	type Item struct {
+		ItemID int64 `sql:"primary_key"`
+		Name   string
+	}
+
+	type Tag struct {
+		Tag string `sql:"primary_key"`
+	}
+
+	var dest []struct {
+		Item
+
+		Tags []Tag
+	}
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			var dest []struct {
+				Item
+
+				Tags []Tag
+			}
+
+			_, err := Query(context.Background(), db, query, nil, &dest)
+			assert.NoError(t, err)
+			assert.Len(t, dest, 2)
+		}()
+	}
+
+	wg.Wait()
+
+	_, err := Query(context.Background(), db, query, nil, &dest)
+	require.NoError(t, err)
+	require.Len(t, dest, 2)
+	require.Equal(t, []Tag{{Tag: "a"}, {Tag: "b"}}, dest[0].Tags)
+	require.Equal(t, []Tag{{Tag: "c"}}, dest[1].Tags)
+}
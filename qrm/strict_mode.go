@@ -0,0 +1,89 @@
+package qrm
+
+import (
+	"context"
+	"reflect"
+	"strings"
+)
+
+// StrictMode defines which query result mapping mismatches are reported as errors. By default, query result columns
+// without destination field are ignored, and destination fields without query result column are left unchanged.
+type StrictMode uint8
+
+const (
+	// StrictColumns reports an error if any of the query result columns is not mapped into a destination field
+	StrictColumns StrictMode = 1 << iota
+	// StrictFields reports an error if any of the destination non-pointer fields has no query result column.
+	// Pointer fields, and all the fields of the pointer struct fields, are optional.
+	StrictFields
+
+	// Strict reports both unmapped columns and unmapped destination fields
+	Strict = StrictColumns | StrictFields
+)
+
+type strictModeContextKey struct{}
+
+// WithStrictMode returns a copy of ctx with strict mapping mode set. Strict mapping mode is used by all the queries
+// executed with the returned context.
+func WithStrictMode(ctx context.Context, mode StrictMode) context.Context {
+	return context.WithValue(ctx, strictModeContextKey{}, mode)
+}
+
+// StrictModeFromContext returns strict mapping mode set with WithStrictMode, or zero mode if not set
+func StrictModeFromContext(ctx context.Context) StrictMode {
+	if ctx == nil {
+		return 0
+	}
+
+	mode, _ := ctx.Value(strictModeContextKey{}).(StrictMode)
+
+	return mode
+}
+
+// StrictMappingError is returned when strict mapping mode is set, and the query result columns or the destination
+// fields are not mapped
+type StrictMappingError struct {
+	Destination     string   // destination type
+	UnmappedColumns []string // query result columns without destination field, reported in StrictColumns mode
+	UnmappedFields  []string // destination fields without query result column, reported in StrictFields mode
+}
+
+func (e *StrictMappingError) Error() string {
+	var problems []string
+
+	if len(e.UnmappedColumns) > 0 {
+		problems = append(problems, "unmapped columns: "+strings.Join(e.UnmappedColumns, ", "))
+	}
+
+	if len(e.UnmappedFields) > 0 {
+		problems = append(problems, "unmapped fields: "+strings.Join(e.UnmappedFields, ", "))
+	}
+
+	return "strict mapping into " + e.Destination + " failed, " + strings.Join(problems, "; ")
+}
+
+// checkStrictMode returns StrictMappingError if the column set mapping into destination type has mismatches
+// reported by scan context strict mode
+func (s *ScanContext) checkStrictMode(destType reflect.Type) error {
+	if s.strictMode == 0 {
+		return nil
+	}
+
+	destMapping := s.getDestinationMapping(destType)
+
+	err := &StrictMappingError{Destination: destMapping.plan.Destination}
+
+	if s.strictMode&StrictColumns != 0 {
+		err.UnmappedColumns = destMapping.unmappedColumns
+	}
+
+	if s.strictMode&StrictFields != 0 {
+		err.UnmappedFields = destMapping.unmappedFields
+	}
+
+	if len(err.UnmappedColumns) == 0 && len(err.UnmappedFields) == 0 {
+		return nil
+	}
+
+	return err
+}
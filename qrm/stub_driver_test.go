@@ -0,0 +1,97 @@
+package qrm
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// stubResult is a result set returned by the stub database for a query
+type stubResult struct {
+	columns []string
+	types   []string // database type names of the columns, as reported by the driver
+	rows    [][]driver.Value
+}
+
+// openStubDB returns database handle, backed by the stub driver, which returns predefined results for the
+// queries, so the mapping can be tested without a database server. Query text has to match exactly.
+func openStubDB(results map[string]stubResult) *sql.DB {
+	return sql.OpenDB(stubConnector{results: results})
+}
+
+type stubConnector struct {
+	results map[string]stubResult
+}
+
+func (c stubConnector) Connect(context.Context) (driver.Conn, error) {
+	return stubConn(c), nil
+}
+
+func (c stubConnector) Driver() driver.Driver {
+	return stubDriver{}
+}
+
+type stubDriver struct{}
+
+func (d stubDriver) Open(name string) (driver.Conn, error) {
+	return nil, errors.New("stub driver connections are opened with connector only")
+}
+
+type stubConn stubConnector
+
+func (c stubConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	result, ok := c.results[query]
+
+	if !ok {
+		return nil, fmt.Errorf("stub result for the query is not defined: %s", query)
+	}
+
+	return &stubRows{stubResult: result}, nil
+}
+
+func (c stubConn) Prepare(query string) (driver.Stmt, error) {
+	return nil, errors.New("stub driver does not support prepared statements")
+}
+
+func (c stubConn) Close() error {
+	return nil
+}
+
+func (c stubConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("stub driver does not support transactions")
+}
+
+type stubRows struct {
+	stubResult
+	index int
+}
+
+func (r *stubRows) Columns() []string {
+	return r.columns
+}
+
+func (r *stubRows) ColumnTypeDatabaseTypeName(index int) string {
+	if index < len(r.types) {
+		return r.types[index]
+	}
+
+	return ""
+}
+
+func (r *stubRows) Close() error {
+	return nil
+}
+
+func (r *stubRows) Next(dest []driver.Value) error {
+	if r.index >= len(r.rows) {
+		return io.EOF
+	}
+
+	copy(dest, r.rows[r.index])
+	r.index++
+
+	return nil
+}
@@ -154,7 +154,70 @@ func isSimpleModelType(objType reflect.Type) bool {
 		return true
 	}
 
-	return objType == timeType || objType == uuidType || objType == byteArrayType
+	return objType == timeType || objType == uuidType || objType == byteArrayType || isNullWrapperType(objType)
+}
+
+// isNullWrapperType returns true for struct types that wrap nullable simple type value, for instance null.Null[T],
+// sql.Null[T] or sql.NullString. Null wrapper is detected by its structure: exactly two exported fields, Valid bool
+// field and the value field.
+func isNullWrapperType(objType reflect.Type) bool {
+	valueIndex, _, ok := nullWrapperFields(objType)
+
+	if !ok {
+		return false
+	}
+
+	valueType := objType.Field(valueIndex).Type
+
+	return isSimpleModelType(valueType) || implementsScannerType(valueType)
+}
+
+// nullWrapperFields returns indexes of the null wrapper value and Valid fields
+func nullWrapperFields(objType reflect.Type) (valueIndex, validIndex int, ok bool) {
+	if objType.Kind() != reflect.Struct || objType.NumField() != 2 {
+		return 0, 0, false
+	}
+
+	for i := 0; i < 2; i++ {
+		if objType.Field(i).PkgPath != "" {
+			return 0, 0, false
+		}
+	}
+
+	for i := 0; i < 2; i++ {
+		field := objType.Field(i)
+
+		if field.Name == "Valid" && field.Type.Kind() == reflect.Bool && !field.Anonymous {
+			return 1 - i, i, true
+		}
+	}
+
+	return 0, 0, false
+}
+
+// assignToNullWrapper assigns non-nil source to the null wrapper value field, and marks null wrapper as valid.
+// Null wrappers implementing sql.Scanner (for instance sql.NullInt64) scan source themselves.
+func assignToNullWrapper(source, destination reflect.Value) error {
+	if implementsScannerType(destination.Type()) {
+		return getScanner(destination).Scan(source.Interface())
+	}
+
+	valueIndex, validIndex, _ := nullWrapperFields(destination.Type())
+	valueField := destination.Field(valueIndex)
+
+	if implementsScannerType(valueField.Type()) {
+		initializeValueIfNilPtr(valueField)
+
+		if err := getScanner(valueField).Scan(source.Interface()); err != nil {
+			return err
+		}
+	} else if err := assign(source, valueField); err != nil {
+		return err
+	}
+
+	destination.Field(validIndex).SetBool(true)
+
+	return nil
 }
 
 // source can't be pointer
@@ -168,6 +231,10 @@ func assign(source, destination reflect.Value) error {
 		destination = destination.Elem()
 	}
 
+	if isNullWrapperType(destination.Type()) {
+		return assignToNullWrapper(source, destination)
+	}
+
 	err := tryAssign(source, destination)
 
 	if err != nil {
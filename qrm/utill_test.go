@@ -1,6 +1,8 @@
 package qrm
 
 import (
+	"database/sql"
+	"github.com/go-jet/jet/v2/null"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 	"reflect"
@@ -79,3 +81,108 @@ func TestTryAssign(t *testing.T) {
 	require.NoError(t, tryAssign(reflect.ValueOf(str), testValue.FieldByName("Str")))
 	require.Equal(t, str, destination.Str)
 }
+
+func TestMapArrayToBaseTypeSlice(t *testing.T) {
+	var integerArray interface{} = []byte("{1,2,NULL}")
+	var uuidArray interface{} = "{a2bf8b21-bf9e-4fe1-9a8e-1f0a0d3c1c1d}"
+
+	scanContext := &ScanContext{
+		row:          []interface{}{&integerArray, &uuidArray},
+		arrayColumns: []bool{true, true},
+	}
+
+	destination := struct {
+		IntArray    []int32
+		IntPtrArray []*int32
+		UUIDArray   []uuid.UUID
+	}{
+		IntArray: []int32{5, 6}, // existing elements are replaced with array elements
+	}
+
+	field, _ := reflect.TypeOf(destination).FieldByName("IntArray")
+	updated, err := mapArrayToBaseTypeSlice(scanContext, 0, reflect.ValueOf(&destination.IntArray), &field)
+	require.NoError(t, err)
+	require.True(t, updated)
+	require.Equal(t, []int32{1, 2, 0}, destination.IntArray)
+
+	field, _ = reflect.TypeOf(destination).FieldByName("IntPtrArray")
+	_, err = mapArrayToBaseTypeSlice(scanContext, 0, reflect.ValueOf(&destination.IntPtrArray), &field)
+	require.NoError(t, err)
+	require.Len(t, destination.IntPtrArray, 3)
+	require.Equal(t, int32(2), *destination.IntPtrArray[1])
+	require.Nil(t, destination.IntPtrArray[2])
+
+	field, _ = reflect.TypeOf(destination).FieldByName("UUIDArray")
+	_, err = mapArrayToBaseTypeSlice(scanContext, 1, reflect.ValueOf(&destination.UUIDArray), &field)
+	require.NoError(t, err)
+	require.Equal(t, []uuid.UUID{uuid.MustParse("a2bf8b21-bf9e-4fe1-9a8e-1f0a0d3c1c1d")}, destination.UUIDArray)
+}
+
+func TestIsNullWrapperType(t *testing.T) {
+	require.True(t, isNullWrapperType(reflect.TypeOf(null.Null[int32]{})))
+	require.True(t, isNullWrapperType(reflect.TypeOf(null.Null[time.Time]{})))
+	require.True(t, isNullWrapperType(reflect.TypeOf(null.Null[uuid.UUID]{})))
+	require.True(t, isNullWrapperType(reflect.TypeOf(sql.NullString{})))
+	require.True(t, isSimpleModelType(reflect.TypeOf(&null.Null[string]{})))
+
+	require.True(t, isNullWrapperType(reflect.TypeOf(struct {
+		Name  string
+		Valid bool
+	}{})), "null wrapper doesn't have to implement driver.Valuer")
+	require.True(t, isNullWrapperType(reflect.TypeOf(struct {
+		Valid bool
+		Count int64
+	}{})))
+
+	require.False(t, isNullWrapperType(reflect.TypeOf(null.Null[[]string]{})))
+	require.False(t, isNullWrapperType(reflect.TypeOf(struct {
+		Name  string
+		Valid string
+	}{})))
+	require.False(t, isNullWrapperType(reflect.TypeOf(struct {
+		Name  string
+		Valid bool
+		Extra int
+	}{})))
+	require.False(t, isNullWrapperType(reflect.TypeOf(struct {
+		name  string
+		Valid bool
+	}{})))
+}
+
+func TestAssign_NullWrapper(t *testing.T) {
+	destination := struct {
+		Int    null.Null[int32]
+		Bool   null.Null[bool]
+		Time   *null.Null[time.Time]
+		UUID   null.Null[uuid.UUID]
+		String sql.NullString
+	}{}
+
+	testValue := reflect.ValueOf(&destination).Elem()
+	timestamp := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	id := uuid.New()
+
+	require.NoError(t, assign(reflect.ValueOf(int64(11)), testValue.Field(0)))
+	require.NoError(t, assign(reflect.ValueOf(int64(1)), testValue.Field(1)))
+	require.NoError(t, assign(reflect.ValueOf(timestamp), testValue.Field(2)))
+	require.NoError(t, assign(reflect.ValueOf(id.String()), testValue.Field(3)))
+	require.NoError(t, assign(reflect.ValueOf([]byte("text")), testValue.Field(4)))
+
+	require.Equal(t, null.From(int32(11)), destination.Int)
+	require.Equal(t, null.From(true), destination.Bool)
+	require.Equal(t, null.From(timestamp), *destination.Time)
+	require.Equal(t, null.From(id), destination.UUID)
+	require.Equal(t, sql.NullString{String: "text", Valid: true}, destination.String)
+
+	require.Error(t, assign(reflect.ValueOf("text"), testValue.Field(0)))
+
+	var custom struct {
+		Valid bool
+		Count int64
+	}
+
+	require.NoError(t, assign(reflect.ValueOf(int32(5)), reflect.ValueOf(&custom).Elem()))
+	require.True(t, custom.Valid)
+	require.Equal(t, int64(5), custom.Count)
+}
@@ -0,0 +1,223 @@
+package jet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/go-jet/jet/v2/internal/jet"
+	"github.com/go-jet/jet/v2/qrm"
+)
+
+// Statement is common interface for all statements(SELECT, INSERT, UPDATE, DELETE, LOCK) of all the dialects
+type Statement = jet.Statement
+
+// QueryAll executes statement over database connection/transaction db and returns all the result rows mapped into
+// slice of T, using the same query result mapping as Statement.QueryContext. T is usually model type, or custom
+// struct type combining model types, but it can also be a base type when statement projects a single column.
+// Optional strict mode is combined with the context strict mode (see qrm.WithStrictMode).
+func QueryAll[T any](ctx context.Context, db qrm.Queryable, stmt Statement, strictMode ...qrm.StrictMode) ([]T, error) {
+	ctx = withStrictMode(ctx, strictMode)
+
+	var dest []T
+
+	if err := stmt.QueryContext(ctx, db, &dest); err != nil {
+		return nil, err
+	}
+
+	return dest, nil
+}
+
+// QueryOne executes statement over database connection/transaction db and returns result mapped into T. If T is a
+// struct, all the result rows are mapped into single destination, same as when Statement.QueryContext destination is
+// pointer to struct. Otherwise, only the first result row is returned. If query result set is empty, QueryOne
+// returns qrm.ErrNoRows. Optional strict mode is combined with the context strict mode (see qrm.WithStrictMode).
+func QueryOne[T any](ctx context.Context, db qrm.Queryable, stmt Statement, strictMode ...qrm.StrictMode) (T, error) {
+	ctx = withStrictMode(ctx, strictMode)
+
+	var dest T
+
+	if reflect.TypeOf(&dest).Elem().Kind() == reflect.Struct {
+		err := stmt.QueryContext(ctx, db, &dest)
+
+		return dest, err
+	}
+
+	all, err := QueryAll[T](ctx, db, stmt)
+
+	if err != nil {
+		return dest, err
+	}
+
+	if len(all) == 0 {
+		return dest, qrm.ErrNoRows
+	}
+
+	return all[0], nil
+}
+
+// DebugMappingPlan returns the mapping plan of the statement result columns into T fields. Statement is not executed,
+// the mapping plan is built from the statement projection aliases, so it is safe to use with the data modifying
+// statements with RETURNING clause. Raw statements, and statements without projections, return an error. Use it only
+// for debug purposes.
+func DebugMappingPlan[T any](stmt Statement) (qrm.MappingPlan, error) {
+	aliases, ok := jet.ProjectionAliases(stmt)
+
+	if !ok {
+		return qrm.MappingPlan{}, errors.New("jet: mapping plan can not be built for the statement without projections")
+	}
+
+	return qrm.NewMappingPlan(aliases, new([]T)), nil
+}
+
+// withStrictMode returns ctx with per call strict mode added to the context strict mode
+func withStrictMode(ctx context.Context, strictMode []qrm.StrictMode) context.Context {
+	if len(strictMode) == 0 {
+		return ctx
+	}
+
+	mode := qrm.StrictModeFromContext(ctx)
+
+	for _, m := range strictMode {
+		mode |= m
+	}
+
+	return qrm.WithStrictMode(ctx, mode)
+}
+
+// Rows is typed iterator over statement result rows. Each row is mapped into struct T, using the same query result
+// mapping as Statement.Rows.
+type Rows[T any] struct {
+	rows *jet.Rows
+}
+
+// QueryRows executes statement over database connection/transaction db and returns typed rows iterator.
+// T has to be a struct type. Rows has to be closed after use. Optional strict mode is combined with the context
+// strict mode (see qrm.WithStrictMode).
+func QueryRows[T any](ctx context.Context, db qrm.Queryable, stmt Statement, strictMode ...qrm.StrictMode) (*Rows[T], error) {
+	var dest T
+
+	if kind := reflect.TypeOf(&dest).Elem().Kind(); kind != reflect.Struct {
+		return nil, fmt.Errorf("jet: Rows type has to be a struct, got %s", kind)
+	}
+
+	rows, err := stmt.Rows(withStrictMode(ctx, strictMode), db)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &Rows[T]{rows: rows}, nil
+}
+
+// Next prepares the next result row for reading with the Scan method. It returns false when there are no more
+// rows or an error happened, and Err should be consulted to distinguish between the two cases.
+func (r *Rows[T]) Next() bool {
+	return r.rows.Next()
+}
+
+// Scan returns current result row mapped into T
+func (r *Rows[T]) Scan() (T, error) {
+	var dest T
+
+	if err := r.rows.Scan(&dest); err != nil {
+		return dest, err
+	}
+
+	return dest, nil
+}
+
+// Err returns the error, if any, that was encountered during iteration
+func (r *Rows[T]) Err() error {
+	return r.rows.Err()
+}
+
+// Close closes the rows, preventing further enumeration
+func (r *Rows[T]) Close() error {
+	return r.rows.Close()
+}
+
+// All reads all the remaining rows, closes the rows and returns the rows mapped into slice of T
+func (r *Rows[T]) All() ([]T, error) {
+	defer r.rows.Close()
+
+	var ret []T
+
+	for r.Next() {
+		row, err := r.Scan()
+
+		if err != nil {
+			return nil, err
+		}
+
+		ret = append(ret, row)
+	}
+
+	return ret, r.Err()
+}
+
+// GroupedRows is typed streaming iterator over statement result, that maps consecutive rows with the same group key
+// (T primary key column values) into a single T, including nested slices of T. Statement has to be ordered by
+// the T primary key columns, because each T is complete as soon as the group key changes. Only the rows of a
+// single group are processed in memory, so large result sets can be processed in bounded memory.
+type GroupedRows[T any] struct {
+	rows    *jet.Rows
+	current T
+	err     error
+}
+
+// QueryGroupedRows executes statement over database connection/transaction db and returns grouped rows iterator.
+// T has to be a struct type. Rows has to be closed after use. Optional strict mode is combined with the context
+// strict mode (see qrm.WithStrictMode).
+func QueryGroupedRows[T any](ctx context.Context, db qrm.Queryable, stmt Statement, strictMode ...qrm.StrictMode) (*GroupedRows[T], error) {
+	var dest T
+
+	if kind := reflect.TypeOf(&dest).Elem().Kind(); kind != reflect.Struct {
+		return nil, fmt.Errorf("jet: GroupedRows type has to be a struct, got %s", kind)
+	}
+
+	rows, err := stmt.Rows(withStrictMode(ctx, strictMode), db)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &GroupedRows[T]{rows: rows}, nil
+}
+
+// Next maps the next group of result rows, to be returned by the Scan method. It returns false when there are no
+// more rows or an error happened, and Err should be consulted to distinguish between the two cases.
+func (g *GroupedRows[T]) Next() bool {
+	var dest T
+
+	ok, err := g.rows.ScanGroup(&dest)
+
+	if err != nil {
+		g.err = err
+		return false
+	}
+
+	g.current = dest
+
+	return ok
+}
+
+// Scan returns the current group of result rows mapped into T
+func (g *GroupedRows[T]) Scan() (T, error) {
+	return g.current, g.err
+}
+
+// Err returns the error, if any, that was encountered during iteration
+func (g *GroupedRows[T]) Err() error {
+	if g.err != nil {
+		return g.err
+	}
+
+	return g.rows.Err()
+}
+
+// Close closes the rows, preventing further enumeration
+func (g *GroupedRows[T]) Close() error {
+	return g.rows.Close()
+}
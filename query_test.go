@@ -0,0 +1,74 @@
+package jet
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/go-jet/jet/v2/sqlite"
+	"github.com/stretchr/testify/require"
+)
+
+type Actor struct {
+	ActorID   int64 `sql:"primary_key"`
+	FirstName string
+}
+
+type Film struct {
+	Title string
+}
+
+type actorFilms struct {
+	Actor
+
+	Films []Film
+}
+
+var selectActors = sqlite.RawStatement(`
+SELECT actor.actor_id AS "actor.actor_id",
+       actor.first_name AS "actor.first_name"
+FROM actor
+WHERE actor.actor_id >= #minID
+ORDER BY actor.actor_id`, sqlite.RawArgs{"#minID": 1})
+
+func TestQueryRows_DestinationType(t *testing.T) {
+	_, err := QueryRows[int64](context.Background(), nil, selectActors)
+	require.EqualError(t, err, "jet: Rows type has to be a struct, got int64")
+
+	_, err = QueryGroupedRows[[]Actor](context.Background(), nil, selectActors)
+	require.EqualError(t, err, "jet: GroupedRows type has to be a struct, got slice")
+}
+
+func TestDebugMappingPlan(t *testing.T) {
+	actorID := postgres.IntegerColumn("actor_id")
+	firstName := postgres.StringColumn("first_name")
+	actor := postgres.NewTable("dvds", "actor", "", actorID, firstName)
+
+	plan, err := DebugMappingPlan[actorFilms](postgres.SELECT(
+		postgres.ColumnList{actorID, firstName},
+		postgres.String("Academy Dinosaur").AS("film.title"),
+		postgres.Int(1).ADD(actorID),
+	).FROM(actor))
+	require.NoError(t, err)
+	require.Equal(t, `destination: jet.actorFilms
+actor.actor_id       -> Actor.ActorID
+actor.first_name     -> Actor.FirstName
+film.title           -> Films.Title
+(1 + actor.actor_id) -> (unmapped)
+`, plan.String())
+
+	plan, err = DebugMappingPlan[Actor](postgres.WITH()(
+		actor.UPDATE(firstName).SET(postgres.String("Nick")).
+			WHERE(actorID.EQ(postgres.Int(2))).
+			RETURNING(actorID),
+	))
+	require.NoError(t, err)
+	require.Equal(t, []string{"actor.actor_id"}, []string{plan.Columns[0].Column})
+	require.Equal(t, []string{"FirstName"}, plan.UnmappedFields())
+
+	_, err = DebugMappingPlan[Actor](actor.DELETE().WHERE(actorID.EQ(postgres.Int(2))))
+	require.EqualError(t, err, "jet: mapping plan can not be built for the statement without projections")
+
+	_, err = DebugMappingPlan[Actor](selectActors)
+	require.EqualError(t, err, "jet: mapping plan can not be built for the statement without projections")
+}
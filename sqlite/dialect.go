@@ -13,10 +13,17 @@ func newDialect() jet.Dialect {
 	operatorSerializeOverrides["IS NOT DISTINCT FROM"] = sqlite_IS_NOT_DISTINCT_FROM
 	operatorSerializeOverrides["#"] = sqliteBitXOR
 
+	functionSerializeOverrides := map[string]jet.SerializeOverride{}
+	functionSerializeOverrides[jet.GroupByRollup] = sqliteUnsupportedGrouping(jet.GroupByRollup)
+	functionSerializeOverrides[jet.GroupByCube] = sqliteUnsupportedGrouping(jet.GroupByCube)
+	functionSerializeOverrides[jet.GroupByGroupingSets] = sqliteUnsupportedGrouping(jet.GroupByGroupingSets)
+	functionSerializeOverrides["GROUPING"] = sqliteUnsupportedGrouping("GROUPING")
+
 	mySQLDialectParams := jet.DialectParams{
 		Name:                       "SQLite",
 		PackageName:                "sqlite",
 		OperatorSerializeOverrides: operatorSerializeOverrides,
+		FunctionSerializeOverrides: functionSerializeOverrides,
 		AliasQuoteChar:             '"',
 		IdentifierQuoteChar:        '`',
 		ArgumentPlaceholder: func(int) string {
@@ -74,6 +81,14 @@ func sqlite_IS_DISTINCT_FROM(expressions ...jet.Serializer) jet.SerializerFunc {
 	}
 }
 
+func sqliteUnsupportedGrouping(name string) jet.SerializeOverride {
+	return func(expressions ...jet.Serializer) jet.SerializerFunc {
+		return func(statement jet.StatementType, out *jet.SQLBuilder, options ...jet.SerializeOption) {
+			panic("jet: " + name + " is not supported by SQLite")
+		}
+	}
+}
+
 var reservedWords2 = []string{
 	"ABORT",
 	"ACTION",
@@ -1,6 +1,7 @@
 package sqlite
 
 import (
+	"github.com/go-jet/jet/v2/internal/jet"
 	"github.com/go-jet/jet/v2/internal/testutils"
 	"testing"
 )
@@ -77,6 +78,17 @@ GROUP BY table2.col_float;
 `)
 }
 
+func TestSelectGroupByGroupingElements(t *testing.T) {
+	assertStatementSqlErr(t, SELECT(table2ColInt).FROM(table2).GROUP_BY(jet.ROLLUP(table2ColInt)),
+		"jet: ROLLUP is not supported by SQLite")
+	assertStatementSqlErr(t, SELECT(table2ColInt).FROM(table2).GROUP_BY(jet.CUBE(table2ColInt)),
+		"jet: CUBE is not supported by SQLite")
+	assertStatementSqlErr(t, SELECT(table2ColInt).FROM(table2).GROUP_BY(jet.GROUPING_SETS(table2ColInt)),
+		"jet: GROUPING SETS is not supported by SQLite")
+	assertStatementSqlErr(t, SELECT(jet.GROUPING(table2ColInt)).FROM(table2).GROUP_BY(table2ColInt),
+		"jet: GROUPING is not supported by SQLite")
+}
+
 func TestSelectHaving(t *testing.T) {
 	assertStatementSql(t, SELECT(table3ColInt).FROM(table3).HAVING(table1ColBool.EQ(Bool(true))), `
 SELECT table3.col_int AS "table3.col_int"
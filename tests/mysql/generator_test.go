@@ -250,8 +250,9 @@ type actorTable struct {
 	LastName   mysql.ColumnString
 	LastUpdate mysql.ColumnTimestamp
 
-	AllColumns     mysql.ColumnList
-	MutableColumns mysql.ColumnList
+	AllColumns         mysql.ColumnList
+	MutableColumns     mysql.ColumnList
+	DefaultableColumns mysql.ColumnList
 }
 
 type ActorTable struct {
@@ -289,12 +290,13 @@ func newActorTable(schemaName, tableName, alias string) *ActorTable {
 
 func newActorTableImpl(schemaName, tableName, alias string) actorTable {
 	var (
-		ActorIDColumn    = mysql.IntegerColumn("actor_id")
-		FirstNameColumn  = mysql.StringColumn("first_name")
-		LastNameColumn   = mysql.StringColumn("last_name")
-		LastUpdateColumn = mysql.TimestampColumn("last_update")
-		allColumns       = mysql.ColumnList{ActorIDColumn, FirstNameColumn, LastNameColumn, LastUpdateColumn}
-		mutableColumns   = mysql.ColumnList{FirstNameColumn, LastNameColumn, LastUpdateColumn}
+		ActorIDColumn      = mysql.IntegerColumn("actor_id")
+		FirstNameColumn    = mysql.StringColumn("first_name")
+		LastNameColumn     = mysql.StringColumn("last_name")
+		LastUpdateColumn   = mysql.TimestampColumn("last_update")
+		allColumns         = mysql.ColumnList{ActorIDColumn, FirstNameColumn, LastNameColumn, LastUpdateColumn}
+		mutableColumns     = mysql.ColumnList{FirstNameColumn, LastNameColumn, LastUpdateColumn}
+		defaultableColumns = mysql.ColumnList{ActorIDColumn, LastUpdateColumn}
 	)
 
 	return actorTable{
@@ -306,8 +308,9 @@ func newActorTableImpl(schemaName, tableName, alias string) actorTable {
 		LastName:   LastNameColumn,
 		LastUpdate: LastUpdateColumn,
 
-		AllColumns:     allColumns,
-		MutableColumns: mutableColumns,
+		AllColumns:         allColumns,
+		MutableColumns:     mutableColumns,
+		DefaultableColumns: defaultableColumns,
 	}
 }
 `
@@ -392,8 +395,9 @@ type actorInfoTable struct {
 	LastName  mysql.ColumnString
 	FilmInfo  mysql.ColumnString
 
-	AllColumns     mysql.ColumnList
-	MutableColumns mysql.ColumnList
+	AllColumns         mysql.ColumnList
+	MutableColumns     mysql.ColumnList
+	DefaultableColumns mysql.ColumnList
 }
 
 type ActorInfoTable struct {
@@ -431,12 +435,13 @@ func newActorInfoTable(schemaName, tableName, alias string) *ActorInfoTable {
 
 func newActorInfoTableImpl(schemaName, tableName, alias string) actorInfoTable {
 	var (
-		ActorIDColumn   = mysql.IntegerColumn("actor_id")
-		FirstNameColumn = mysql.StringColumn("first_name")
-		LastNameColumn  = mysql.StringColumn("last_name")
-		FilmInfoColumn  = mysql.StringColumn("film_info")
-		allColumns      = mysql.ColumnList{ActorIDColumn, FirstNameColumn, LastNameColumn, FilmInfoColumn}
-		mutableColumns  = mysql.ColumnList{ActorIDColumn, FirstNameColumn, LastNameColumn, FilmInfoColumn}
+		ActorIDColumn      = mysql.IntegerColumn("actor_id")
+		FirstNameColumn    = mysql.StringColumn("first_name")
+		LastNameColumn     = mysql.StringColumn("last_name")
+		FilmInfoColumn     = mysql.StringColumn("film_info")
+		allColumns         = mysql.ColumnList{ActorIDColumn, FirstNameColumn, LastNameColumn, FilmInfoColumn}
+		mutableColumns     = mysql.ColumnList{ActorIDColumn, FirstNameColumn, LastNameColumn, FilmInfoColumn}
+		defaultableColumns = mysql.ColumnList{}
 	)
 
 	return actorInfoTable{
@@ -448,8 +453,9 @@ func newActorInfoTableImpl(schemaName, tableName, alias string) actorInfoTable {
 		LastName:  LastNameColumn,
 		FilmInfo:  FilmInfoColumn,
 
-		AllColumns:     allColumns,
-		MutableColumns: mutableColumns,
+		AllColumns:         allColumns,
+		MutableColumns:     mutableColumns,
+		DefaultableColumns: defaultableColumns,
 	}
 }
 `
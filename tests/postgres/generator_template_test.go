@@ -7,6 +7,7 @@ import (
 	"github.com/go-jet/jet/v2/generator/postgres"
 	"github.com/go-jet/jet/v2/generator/template"
 	"github.com/go-jet/jet/v2/internal/3rdparty/snaker"
+	"github.com/go-jet/jet/v2/internal/testutils"
 	"github.com/go-jet/jet/v2/internal/utils"
 	postgres2 "github.com/go-jet/jet/v2/postgres"
 	"github.com/go-jet/jet/v2/tests/dbconfig"
@@ -271,6 +272,29 @@ func TestGeneratorTemplate_SQLBuilder_ChangeTypeAndFileName(t *testing.T) {
 	require.Contains(t, mpaaRating, "var MpaaRatingEnumSQLBuilder = &struct {")
 }
 
+func TestGeneratorTemplate_SQLBuilder_TypedEnumColumns(t *testing.T) {
+	err := postgres.Generate(
+		tempTestDir,
+		dbConnection,
+		template.Default(postgres2.Dialect).
+			UseSchema(func(schemaMetaData metadata.Schema) template.Schema {
+				return template.DefaultSchema(schemaMetaData).
+					UseSQLBuilder(template.DefaultSQLBuilder().
+						UseEnum(func(enum metadata.Enum) template.EnumSQLBuilder {
+							return template.DefaultEnumSQLBuilder(enum).UseTypedColumns(true)
+						}),
+					)
+			}),
+	)
+	require.Nil(t, err)
+
+	testutils.AssertFileContent(t, path.Join(defaultEnumSQLBuilderFilePath, "mpaa_rating.go"), typedMpaaRatingEnumFile)
+
+	film := file2.Exists(t, defaultTableSQLBuilderFilePath, "film.go")
+	require.Contains(t, film, "Rating          enum.ColumnMpaaRating")
+	require.Contains(t, film, "RatingColumn          = enum.MpaaRatingColumn(\"rating\")")
+}
+
 func TestGeneratorTemplate_Model_AddTags(t *testing.T) {
 
 	err := postgres.Generate(
@@ -385,3 +409,134 @@ func TestGeneratorTemplate_SQLBuilder_ChangeColumnTypes(t *testing.T) {
 	actor := file2.Exists(t, defaultActorSQLBuilderFilePath)
 	require.Contains(t, actor, "ActorID    postgres.ColumnString")
 }
+
+var typedMpaaRatingEnumFile = `
+//
+// Code generated by go-jet DO NOT EDIT.
+//
+// WARNING: Changes to this file may cause incorrect behavior
+// and will be lost if the code is regenerated
+//
+
+package enum
+
+import "github.com/go-jet/jet/v2/postgres"
+
+var MpaaRating = &struct {
+	G    MpaaRatingExpression
+	Pg   MpaaRatingExpression
+	Pg13 MpaaRatingExpression
+	R    MpaaRatingExpression
+	Nc17 MpaaRatingExpression
+}{
+	G:    MpaaRatingExp(postgres.NewEnumValue("G")),
+	Pg:   MpaaRatingExp(postgres.NewEnumValue("PG")),
+	Pg13: MpaaRatingExp(postgres.NewEnumValue("PG-13")),
+	R:    MpaaRatingExp(postgres.NewEnumValue("R")),
+	Nc17: MpaaRatingExp(postgres.NewEnumValue("NC-17")),
+}
+
+// MpaaRatingExpression is expression of mpaa_rating enum type. IN and NOT_IN operators are inherited from Expression.
+type MpaaRatingExpression interface {
+	postgres.Expression
+	mpaaRatingOperators
+}
+
+// ColumnMpaaRating is column of mpaa_rating enum type
+type ColumnMpaaRating interface {
+	postgres.Column
+	mpaaRatingOperators
+
+	From(subQuery postgres.SelectTable) ColumnMpaaRating
+	SET(value MpaaRatingExpression) postgres.ColumnAssigment
+}
+
+type mpaaRatingOperators interface {
+	EQ(rhs MpaaRatingExpression) postgres.BoolExpression
+	NOT_EQ(rhs MpaaRatingExpression) postgres.BoolExpression
+	IS_DISTINCT_FROM(rhs MpaaRatingExpression) postgres.BoolExpression
+	IS_NOT_DISTINCT_FROM(rhs MpaaRatingExpression) postgres.BoolExpression
+
+	// AsString returns enum expression as string expression, for string operators and functions
+	AsString() postgres.StringExpression
+
+	isMpaaRating()
+}
+
+// MpaaRatingExp is mpaa_rating enum expression wrapper around arbitrary expression.
+// Allows go compiler to see any expression as mpaa_rating enum expression.
+// Does not add sql cast to generated sql builder output.
+func MpaaRatingExp(expression postgres.Expression) MpaaRatingExpression {
+	return mpaaRatingExpression{Expression: expression}
+}
+
+type mpaaRatingExpression struct {
+	postgres.Expression
+}
+
+func (e mpaaRatingExpression) isMpaaRating() {}
+
+func (e mpaaRatingExpression) EQ(rhs MpaaRatingExpression) postgres.BoolExpression {
+	return postgres.StringExp(e.Expression).EQ(postgres.StringExp(rhs))
+}
+
+func (e mpaaRatingExpression) NOT_EQ(rhs MpaaRatingExpression) postgres.BoolExpression {
+	return postgres.StringExp(e.Expression).NOT_EQ(postgres.StringExp(rhs))
+}
+
+func (e mpaaRatingExpression) IS_DISTINCT_FROM(rhs MpaaRatingExpression) postgres.BoolExpression {
+	return postgres.StringExp(e.Expression).IS_DISTINCT_FROM(postgres.StringExp(rhs))
+}
+
+func (e mpaaRatingExpression) IS_NOT_DISTINCT_FROM(rhs MpaaRatingExpression) postgres.BoolExpression {
+	return postgres.StringExp(e.Expression).IS_NOT_DISTINCT_FROM(postgres.StringExp(rhs))
+}
+
+func (e mpaaRatingExpression) AsString() postgres.StringExpression {
+	return postgres.StringExp(e.Expression)
+}
+
+// MpaaRatingColumn creates named mpaa_rating enum column
+func MpaaRatingColumn(name string) ColumnMpaaRating {
+	return newMpaaRatingColumn(postgres.StringColumn(name))
+}
+
+type mpaaRatingColumn struct {
+	postgres.Column
+	column postgres.ColumnString
+}
+
+func newMpaaRatingColumn(column postgres.ColumnString) ColumnMpaaRating {
+	return mpaaRatingColumn{Column: column, column: column}
+}
+
+func (c mpaaRatingColumn) isMpaaRating() {}
+
+func (c mpaaRatingColumn) EQ(rhs MpaaRatingExpression) postgres.BoolExpression {
+	return c.column.EQ(postgres.StringExp(rhs))
+}
+
+func (c mpaaRatingColumn) NOT_EQ(rhs MpaaRatingExpression) postgres.BoolExpression {
+	return c.column.NOT_EQ(postgres.StringExp(rhs))
+}
+
+func (c mpaaRatingColumn) IS_DISTINCT_FROM(rhs MpaaRatingExpression) postgres.BoolExpression {
+	return c.column.IS_DISTINCT_FROM(postgres.StringExp(rhs))
+}
+
+func (c mpaaRatingColumn) IS_NOT_DISTINCT_FROM(rhs MpaaRatingExpression) postgres.BoolExpression {
+	return c.column.IS_NOT_DISTINCT_FROM(postgres.StringExp(rhs))
+}
+
+func (c mpaaRatingColumn) AsString() postgres.StringExpression {
+	return c.column
+}
+
+func (c mpaaRatingColumn) From(subQuery postgres.SelectTable) ColumnMpaaRating {
+	return newMpaaRatingColumn(c.column.From(subQuery))
+}
+
+func (c mpaaRatingColumn) SET(value MpaaRatingExpression) postgres.ColumnAssigment {
+	return c.column.SET(postgres.StringExp(value))
+}
+`
@@ -59,6 +59,7 @@ func TestCmdGenerator(t *testing.T) {
 		"-user=jet",
 		"-password=jet",
 		"-schema=dvds",
+		"-routines",
 		"-path="+genTestDir2)
 
 	cmd.Stderr = os.Stderr
@@ -80,7 +81,7 @@ func TestCmdGenerator(t *testing.T) {
 		dbconfig.PgPort,
 		"jetdb",
 	)
-	cmd = exec.Command("jet", "-dsn="+dsn, "-schema=dvds", "-path="+genTestDir2)
+	cmd = exec.Command("jet", "-dsn="+dsn, "-schema=dvds", "-routines", "-path="+genTestDir2)
 	cmd.Stderr = os.Stderr
 	cmd.Stdout = os.Stdout
 
@@ -263,6 +264,16 @@ func assertGeneratedFiles(t *testing.T) {
 	testutils.AssertFileNamesEqual(t, enumFiles, "mpaa_rating.go")
 	testutils.AssertFileContent(t, "./.gentestdata2/jetdb/dvds/enum/mpaa_rating.go", mpaaRatingEnumFile)
 
+	// Function SQL Builder files
+	filmInStock, err := ioutil.ReadFile("./.gentestdata2/jetdb/dvds/function/film_in_stock.go")
+	require.NoError(t, err)
+	require.Contains(t, string(filmInStock), "func FilmInStock(pFilmID postgres.IntegerExpression, pStoreID postgres.IntegerExpression) *FilmInStockTable {")
+	require.Contains(t, string(filmInStock), "PFilmCount postgres.ColumnInteger")
+
+	inventoryInStock, err := ioutil.ReadFile("./.gentestdata2/jetdb/dvds/function/inventory_in_stock.go")
+	require.NoError(t, err)
+	require.Contains(t, string(inventoryInStock), "func InventoryInStock(pInventoryID postgres.IntegerExpression) postgres.BoolExpression {")
+
 	// Model files
 	modelFiles, err := ioutil.ReadDir("./.gentestdata2/jetdb/dvds/model")
 	require.NoError(t, err)
@@ -328,8 +339,9 @@ type actorTable struct {
 	LastName   postgres.ColumnString
 	LastUpdate postgres.ColumnTimestamp
 
-	AllColumns     postgres.ColumnList
-	MutableColumns postgres.ColumnList
+	AllColumns         postgres.ColumnList
+	MutableColumns     postgres.ColumnList
+	DefaultableColumns postgres.ColumnList
 }
 
 type ActorTable struct {
@@ -367,12 +379,13 @@ func newActorTable(schemaName, tableName, alias string) *ActorTable {
 
 func newActorTableImpl(schemaName, tableName, alias string) actorTable {
 	var (
-		ActorIDColumn    = postgres.IntegerColumn("actor_id")
-		FirstNameColumn  = postgres.StringColumn("first_name")
-		LastNameColumn   = postgres.StringColumn("last_name")
-		LastUpdateColumn = postgres.TimestampColumn("last_update")
-		allColumns       = postgres.ColumnList{ActorIDColumn, FirstNameColumn, LastNameColumn, LastUpdateColumn}
-		mutableColumns   = postgres.ColumnList{FirstNameColumn, LastNameColumn, LastUpdateColumn}
+		ActorIDColumn      = postgres.IntegerColumn("actor_id")
+		FirstNameColumn    = postgres.StringColumn("first_name")
+		LastNameColumn     = postgres.StringColumn("last_name")
+		LastUpdateColumn   = postgres.TimestampColumn("last_update")
+		allColumns         = postgres.ColumnList{ActorIDColumn, FirstNameColumn, LastNameColumn, LastUpdateColumn}
+		mutableColumns     = postgres.ColumnList{FirstNameColumn, LastNameColumn, LastUpdateColumn}
+		defaultableColumns = postgres.ColumnList{ActorIDColumn, LastUpdateColumn}
 	)
 
 	return actorTable{
@@ -384,8 +397,9 @@ func newActorTableImpl(schemaName, tableName, alias string) actorTable {
 		LastName:   LastNameColumn,
 		LastUpdate: LastUpdateColumn,
 
-		AllColumns:     allColumns,
-		MutableColumns: mutableColumns,
+		AllColumns:         allColumns,
+		MutableColumns:     mutableColumns,
+		DefaultableColumns: defaultableColumns,
 	}
 }
 `
@@ -469,8 +483,9 @@ type actorInfoTable struct {
 	LastName  postgres.ColumnString
 	FilmInfo  postgres.ColumnString
 
-	AllColumns     postgres.ColumnList
-	MutableColumns postgres.ColumnList
+	AllColumns         postgres.ColumnList
+	MutableColumns     postgres.ColumnList
+	DefaultableColumns postgres.ColumnList
 }
 
 type ActorInfoTable struct {
@@ -508,12 +523,13 @@ func newActorInfoTable(schemaName, tableName, alias string) *ActorInfoTable {
 
 func newActorInfoTableImpl(schemaName, tableName, alias string) actorInfoTable {
 	var (
-		ActorIDColumn   = postgres.IntegerColumn("actor_id")
-		FirstNameColumn = postgres.StringColumn("first_name")
-		LastNameColumn  = postgres.StringColumn("last_name")
-		FilmInfoColumn  = postgres.StringColumn("film_info")
-		allColumns      = postgres.ColumnList{ActorIDColumn, FirstNameColumn, LastNameColumn, FilmInfoColumn}
-		mutableColumns  = postgres.ColumnList{ActorIDColumn, FirstNameColumn, LastNameColumn, FilmInfoColumn}
+		ActorIDColumn      = postgres.IntegerColumn("actor_id")
+		FirstNameColumn    = postgres.StringColumn("first_name")
+		LastNameColumn     = postgres.StringColumn("last_name")
+		FilmInfoColumn     = postgres.StringColumn("film_info")
+		allColumns         = postgres.ColumnList{ActorIDColumn, FirstNameColumn, LastNameColumn, FilmInfoColumn}
+		mutableColumns     = postgres.ColumnList{ActorIDColumn, FirstNameColumn, LastNameColumn, FilmInfoColumn}
+		defaultableColumns = postgres.ColumnList{}
 	)
 
 	return actorInfoTable{
@@ -525,8 +541,9 @@ func newActorInfoTableImpl(schemaName, tableName, alias string) actorInfoTable {
 		LastName:  LastNameColumn,
 		FilmInfo:  FilmInfoColumn,
 
-		AllColumns:     allColumns,
-		MutableColumns: mutableColumns,
+		AllColumns:         allColumns,
+		MutableColumns:     mutableColumns,
+		DefaultableColumns: defaultableColumns,
 	}
 }
 `
@@ -706,10 +723,10 @@ type AllTypes struct {
 	JSON                 string
 	JsonbPtr             *string
 	Jsonb                string
-	IntegerArrayPtr      *string
-	IntegerArray         string
-	TextArrayPtr         *string
-	TextArray            string
+	IntegerArrayPtr      *[]int32
+	IntegerArray         []int32
+	TextArrayPtr         *[]string
+	TextArray            []string
 	JsonbArray           string
 	TextMultiDimArrayPtr *string
 	TextMultiDimArray    string
@@ -780,26 +797,27 @@ type allTypesTable struct {
 	Bit                  postgres.ColumnString
 	BitVaryingPtr        postgres.ColumnString
 	BitVarying           postgres.ColumnString
-	TsvectorPtr          postgres.ColumnString
-	Tsvector             postgres.ColumnString
+	TsvectorPtr          postgres.ColumnTsVector
+	Tsvector             postgres.ColumnTsVector
 	UUIDPtr              postgres.ColumnString
 	UUID                 postgres.ColumnString
 	XMLPtr               postgres.ColumnString
 	XML                  postgres.ColumnString
-	JSONPtr              postgres.ColumnString
-	JSON                 postgres.ColumnString
-	JsonbPtr             postgres.ColumnString
-	Jsonb                postgres.ColumnString
-	IntegerArrayPtr      postgres.ColumnString
-	IntegerArray         postgres.ColumnString
-	TextArrayPtr         postgres.ColumnString
-	TextArray            postgres.ColumnString
+	JSONPtr              postgres.ColumnJson
+	JSON                 postgres.ColumnJson
+	JsonbPtr             postgres.ColumnJson
+	Jsonb                postgres.ColumnJson
+	IntegerArrayPtr      postgres.ColumnIntegerArray
+	IntegerArray         postgres.ColumnIntegerArray
+	TextArrayPtr         postgres.ColumnStringArray
+	TextArray            postgres.ColumnStringArray
 	JsonbArray           postgres.ColumnString
 	TextMultiDimArrayPtr postgres.ColumnString
 	TextMultiDimArray    postgres.ColumnString
 
-	AllColumns     postgres.ColumnList
-	MutableColumns postgres.ColumnList
+	AllColumns         postgres.ColumnList
+	MutableColumns     postgres.ColumnList
+	DefaultableColumns postgres.ColumnList
 }
 
 type AllTypesTable struct {
@@ -881,25 +899,26 @@ func newAllTypesTableImpl(schemaName, tableName, alias string) allTypesTable {
 		BitColumn                  = postgres.StringColumn("bit")
 		BitVaryingPtrColumn        = postgres.StringColumn("bit_varying_ptr")
 		BitVaryingColumn           = postgres.StringColumn("bit_varying")
-		TsvectorPtrColumn          = postgres.StringColumn("tsvector_ptr")
-		TsvectorColumn             = postgres.StringColumn("tsvector")
+		TsvectorPtrColumn          = postgres.TsVectorColumn("tsvector_ptr")
+		TsvectorColumn             = postgres.TsVectorColumn("tsvector")
 		UUIDPtrColumn              = postgres.StringColumn("uuid_ptr")
 		UUIDColumn                 = postgres.StringColumn("uuid")
 		XMLPtrColumn               = postgres.StringColumn("xml_ptr")
 		XMLColumn                  = postgres.StringColumn("xml")
-		JSONPtrColumn              = postgres.StringColumn("json_ptr")
-		JSONColumn                 = postgres.StringColumn("json")
-		JsonbPtrColumn             = postgres.StringColumn("jsonb_ptr")
-		JsonbColumn                = postgres.StringColumn("jsonb")
-		IntegerArrayPtrColumn      = postgres.StringColumn("integer_array_ptr")
-		IntegerArrayColumn         = postgres.StringColumn("integer_array")
-		TextArrayPtrColumn         = postgres.StringColumn("text_array_ptr")
-		TextArrayColumn            = postgres.StringColumn("text_array")
+		JSONPtrColumn              = postgres.JsonColumn("json_ptr")
+		JSONColumn                 = postgres.JsonColumn("json")
+		JsonbPtrColumn             = postgres.JsonColumn("jsonb_ptr")
+		JsonbColumn                = postgres.JsonColumn("jsonb")
+		IntegerArrayPtrColumn      = postgres.IntegerArrayColumn("integer_array_ptr")
+		IntegerArrayColumn         = postgres.IntegerArrayColumn("integer_array")
+		TextArrayPtrColumn         = postgres.StringArrayColumn("text_array_ptr")
+		TextArrayColumn            = postgres.StringArrayColumn("text_array")
 		JsonbArrayColumn           = postgres.StringColumn("jsonb_array")
 		TextMultiDimArrayPtrColumn = postgres.StringColumn("text_multi_dim_array_ptr")
 		TextMultiDimArrayColumn    = postgres.StringColumn("text_multi_dim_array")
 		allColumns                 = postgres.ColumnList{SmallIntPtrColumn, SmallIntColumn, IntegerPtrColumn, IntegerColumn, BigIntPtrColumn, BigIntColumn, DecimalPtrColumn, DecimalColumn, NumericPtrColumn, NumericColumn, RealPtrColumn, RealColumn, DoublePrecisionPtrColumn, DoublePrecisionColumn, SmallserialColumn, SerialColumn, BigserialColumn, VarCharPtrColumn, VarCharColumn, CharPtrColumn, CharColumn, TextPtrColumn, TextColumn, ByteaPtrColumn, ByteaColumn, TimestampzPtrColumn, TimestampzColumn, TimestampPtrColumn, TimestampColumn, DatePtrColumn, DateColumn, TimezPtrColumn, TimezColumn, TimePtrColumn, TimeColumn, IntervalPtrColumn, IntervalColumn, BooleanPtrColumn, BooleanColumn, PointPtrColumn, BitPtrColumn, BitColumn, BitVaryingPtrColumn, BitVaryingColumn, TsvectorPtrColumn, TsvectorColumn, UUIDPtrColumn, UUIDColumn, XMLPtrColumn, XMLColumn, JSONPtrColumn, JSONColumn, JsonbPtrColumn, JsonbColumn, IntegerArrayPtrColumn, IntegerArrayColumn, TextArrayPtrColumn, TextArrayColumn, JsonbArrayColumn, TextMultiDimArrayPtrColumn, TextMultiDimArrayColumn}
 		mutableColumns             = postgres.ColumnList{SmallIntPtrColumn, SmallIntColumn, IntegerPtrColumn, IntegerColumn, BigIntPtrColumn, BigIntColumn, DecimalPtrColumn, DecimalColumn, NumericPtrColumn, NumericColumn, RealPtrColumn, RealColumn, DoublePrecisionPtrColumn, DoublePrecisionColumn, SmallserialColumn, SerialColumn, BigserialColumn, VarCharPtrColumn, VarCharColumn, CharPtrColumn, CharColumn, TextPtrColumn, TextColumn, ByteaPtrColumn, ByteaColumn, TimestampzPtrColumn, TimestampzColumn, TimestampPtrColumn, TimestampColumn, DatePtrColumn, DateColumn, TimezPtrColumn, TimezColumn, TimePtrColumn, TimeColumn, IntervalPtrColumn, IntervalColumn, BooleanPtrColumn, BooleanColumn, PointPtrColumn, BitPtrColumn, BitColumn, BitVaryingPtrColumn, BitVaryingColumn, TsvectorPtrColumn, TsvectorColumn, UUIDPtrColumn, UUIDColumn, XMLPtrColumn, XMLColumn, JSONPtrColumn, JSONColumn, JsonbPtrColumn, JsonbColumn, IntegerArrayPtrColumn, IntegerArrayColumn, TextArrayPtrColumn, TextArrayColumn, JsonbArrayColumn, TextMultiDimArrayPtrColumn, TextMultiDimArrayColumn}
+		defaultableColumns         = postgres.ColumnList{SmallserialColumn, SerialColumn, BigserialColumn}
 	)
 
 	return allTypesTable{
@@ -968,8 +987,9 @@ func newAllTypesTableImpl(schemaName, tableName, alias string) allTypesTable {
 		TextMultiDimArrayPtr: TextMultiDimArrayPtrColumn,
 		TextMultiDimArray:    TextMultiDimArrayColumn,
 
-		AllColumns:     allColumns,
-		MutableColumns: mutableColumns,
+		AllColumns:         allColumns,
+		MutableColumns:     mutableColumns,
+		DefaultableColumns: defaultableColumns,
 	}
 }
 `
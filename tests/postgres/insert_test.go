@@ -312,6 +312,36 @@ VALUES ('http://www.postgresqltutorial.com', 'PostgreSQL Tutorial', DEFAULT),
 	testutils.AssertExecAndRollback(t, stmt, db, 4)
 }
 
+func TestInsertDefaultableColumnsOnZero(t *testing.T) {
+	links := []model.Link{
+		{URL: "http://www.google.com", Name: "Google"},
+		{ID: 1000, URL: "http://www.yahoo.com", Name: "Yahoo"},
+	}
+
+	stmt := Link.
+		INSERT(Link.AllColumns).
+		MODELS(DefaultOnZero(links, Link.DefaultableColumns)).
+		RETURNING(Link.ID)
+
+	testutils.AssertDebugStatementSql(t, stmt, `
+INSERT INTO test_sample.link (id, url, name, description)
+VALUES (DEFAULT, 'http://www.google.com', 'Google', NULL),
+       (1000, 'http://www.yahoo.com', 'Yahoo', NULL)
+RETURNING link.id AS "link.id";
+`)
+
+	testutils.ExecuteInTxAndRollback(t, db, func(tx *sql.Tx) {
+		var inserted []model.Link
+
+		err := stmt.Query(tx, &inserted)
+
+		require.NoError(t, err)
+		require.Len(t, inserted, 2)
+		require.NotZero(t, inserted[0].ID)
+		require.Equal(t, int64(1000), inserted[1].ID)
+	})
+}
+
 func TestInsertQuery(t *testing.T) {
 	query := Link.
 		INSERT(Link.URL, Link.Name).
@@ -889,6 +889,37 @@ func TestJoinQuerySliceWithPtrs(t *testing.T) {
 	require.Equal(t, len(*filmsPerLanguageWithPtrs[0].Film), int(limit))
 }
 
+func TestJoinForeignKeyHelpers(t *testing.T) {
+	lang := Language.AS("lang")
+
+	query := SELECT(Film.FilmID, Language.Name, lang.Name).
+		FROM(Film.
+			INNER_JOIN_Language().
+			LEFT_JOIN(lang, Film.Language(lang)),
+		).
+		WHERE(Film.FilmID.EQ(Int(1)))
+
+	testutils.AssertDebugStatementSql(t, query, `
+SELECT film.film_id AS "film.film_id",
+     language.name AS "language.name",
+     lang.name AS "lang.name"
+FROM dvds.film
+     INNER JOIN dvds.language ON (film.language_id = language.language_id)
+     LEFT JOIN dvds.language AS lang ON (film.language_id = lang.language_id)
+WHERE film.film_id = 1;
+`)
+
+	var dest struct {
+		model.Film
+		Language model.Language
+	}
+
+	err := query.Query(db, &dest)
+	require.NoError(t, err)
+	require.Equal(t, int32(1), dest.FilmID)
+	require.Equal(t, "English             ", dest.Language.Name)
+}
+
 func TestSelect_WithoutUniqueColumnSelected(t *testing.T) {
 	query := Customer.SELECT(Customer.FirstName, Customer.LastName, Customer.Email)
 
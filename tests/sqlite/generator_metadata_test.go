@@ -0,0 +1,154 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-jet/jet/v2/generator/logger"
+	"github.com/go-jet/jet/v2/generator/metadata"
+	sqlitegen "github.com/go-jet/jet/v2/generator/sqlite"
+	"github.com/go-jet/jet/v2/generator/template"
+	"github.com/go-jet/jet/v2/sqlite"
+	"github.com/stretchr/testify/require"
+)
+
+func createMetadataTestDB(t *testing.T) string {
+	dsn := "file:" + filepath.Join(t.TempDir(), "metadata.db")
+
+	db, err := sql.Open("sqlite3", dsn)
+	require.NoError(t, err)
+	defer db.Close()
+
+	_, err = db.Exec(`
+CREATE TABLE language (language_id INTEGER PRIMARY KEY, name TEXT NOT NULL DEFAULT 'en');
+CREATE TABLE film (
+	film_id INTEGER PRIMARY KEY, -- Unique film id
+	title TEXT NOT NULL,
+	language_id INTEGER REFERENCES language
+);
+CREATE TABLE film_actor (
+	film_id INTEGER NOT NULL REFERENCES film (film_id),
+	actor_id INTEGER NOT NULL,
+	PRIMARY KEY (film_id, actor_id)
+);
+CREATE TABLE audit_log (id INTEGER);
+CREATE VIEW film_list AS SELECT film_id, title FROM film;
+`)
+	require.NoError(t, err)
+
+	return dsn
+}
+
+func dumpMetadata(t *testing.T, dsn string, filters metadata.Filters) metadata.Schema {
+	snapshotPath := filepath.Join(t.TempDir(), "metadata.json")
+	generatorTemplate := template.Default(sqlite.Dialect).UseFilters(filters)
+
+	_, err := sqlitegen.Run(context.Background(), sqlitegen.Options{
+		DSN:          dsn,
+		DumpMetadata: snapshotPath,
+		Template:     &generatorTemplate,
+	})
+	require.NoError(t, err)
+
+	snapshot, err := metadata.LoadDialectSnapshot(snapshotPath, sqlitegen.DialectName)
+	require.NoError(t, err)
+
+	return snapshot.Schema
+}
+
+func TestGeneratorMetadata(t *testing.T) {
+	dsn := createMetadataTestDB(t)
+
+	schema := dumpMetadata(t, dsn, metadata.Filters{Tables: metadata.Filter{Exclude: []string{"audit_*"}}})
+
+	integerColumn := func(name string, isPrimaryKey, isNullable, hasDefault bool) metadata.Column {
+		return metadata.Column{
+			Name:         name,
+			IsPrimaryKey: isPrimaryKey,
+			IsNullable:   isNullable,
+			HasDefault:   hasDefault,
+			DataType:     metadata.DataType{Name: "INTEGER", Kind: metadata.BaseType},
+		}
+	}
+
+	textColumn := metadata.DataType{Name: "TEXT", Kind: metadata.BaseType}
+
+	require.Equal(t, []metadata.Table{
+		{
+			Name: "film",
+			Columns: []metadata.Column{
+				{Name: "film_id", IsPrimaryKey: true, IsNullable: true, HasDefault: true, Comment: "Unique film id",
+					DataType: metadata.DataType{Name: "INTEGER", Kind: metadata.BaseType}},
+				{Name: "title", DataType: textColumn},
+				integerColumn("language_id", false, true, false),
+			},
+			ForeignKeys: []metadata.ForeignKey{{
+				Name:              "film_language_id_fkey",
+				Columns:           []string{"language_id"},
+				ReferencedSchema:  "",
+				ReferencedTable:   "language",
+				ReferencedColumns: []string{"language_id"},
+			}},
+		},
+		{
+			Name: "film_actor",
+			Columns: []metadata.Column{
+				integerColumn("film_id", true, false, false),
+				integerColumn("actor_id", true, false, false),
+			},
+			ForeignKeys: []metadata.ForeignKey{{
+				Name:              "film_actor_film_id_fkey",
+				Columns:           []string{"film_id"},
+				ReferencedSchema:  "",
+				ReferencedTable:   "film",
+				ReferencedColumns: []string{"film_id"},
+			}},
+		},
+		{
+			Name: "language",
+			Columns: []metadata.Column{
+				integerColumn("language_id", true, true, true),
+				{Name: "name", HasDefault: true, DefaultValue: "'en'", DataType: textColumn},
+			},
+		},
+	}, schema.TablesMetaData)
+
+	require.Len(t, schema.ViewsMetaData, 1)
+	require.Equal(t, "film_list", schema.ViewsMetaData[0].Name)
+	require.Equal(t, []string{"film_id", "title"},
+		[]string{schema.ViewsMetaData[0].Columns[0].Name, schema.ViewsMetaData[0].Columns[1].Name})
+
+	schema = dumpMetadata(t, dsn, metadata.Filters{
+		Tables: metadata.Filter{Include: []string{"missing"}},
+		Views:  metadata.Filter{Exclude: []string{"*_list"}},
+	})
+	require.Empty(t, schema.TablesMetaData)
+	require.Empty(t, schema.ViewsMetaData)
+}
+
+func TestGeneratorRun_Context(t *testing.T) {
+	dsn := createMetadataTestDB(t)
+	destDir := filepath.Join(t.TempDir(), "gen")
+
+	var messages []string
+	ctx := logger.WithLogger(context.Background(), logger.Func(func(level logger.Level, message string) {
+		messages = append(messages, message)
+	}))
+
+	canceledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+
+	_, err := sqlitegen.Run(canceledCtx, sqlitegen.Options{DSN: dsn, DestDir: destDir})
+	require.ErrorIs(t, err, context.Canceled)
+	require.EqualError(t, err, "failed to retrieve tables metadata: failed to query tables: jet: context canceled")
+
+	messages = nil
+	generatorTemplate := template.Default(sqlite.Dialect).
+		UseFilters(metadata.Filters{Tables: metadata.Filter{Include: []string{"missing"}}, Views: metadata.Filter{Include: []string{"missing"}}})
+
+	_, err = sqlitegen.Run(ctx, sqlitegen.Options{DSN: dsn, DestDir: destDir, Template: &generatorTemplate})
+	require.NoError(t, err)
+	require.Equal(t, []string{"Retrieving schema information...", "\tFOUND 0 table(s), 0 view(s), 0 enum(s), 0 routine(s)"}, messages)
+}
@@ -167,8 +167,9 @@ type actorTable struct {
 	LastName   sqlite.ColumnString
 	LastUpdate sqlite.ColumnTimestamp
 
-	AllColumns     sqlite.ColumnList
-	MutableColumns sqlite.ColumnList
+	AllColumns         sqlite.ColumnList
+	MutableColumns     sqlite.ColumnList
+	DefaultableColumns sqlite.ColumnList
 }
 
 type ActorTable struct {
@@ -206,12 +207,13 @@ func newActorTable(schemaName, tableName, alias string) *ActorTable {
 
 func newActorTableImpl(schemaName, tableName, alias string) actorTable {
 	var (
-		ActorIDColumn    = sqlite.IntegerColumn("actor_id")
-		FirstNameColumn  = sqlite.StringColumn("first_name")
-		LastNameColumn   = sqlite.StringColumn("last_name")
-		LastUpdateColumn = sqlite.TimestampColumn("last_update")
-		allColumns       = sqlite.ColumnList{ActorIDColumn, FirstNameColumn, LastNameColumn, LastUpdateColumn}
-		mutableColumns   = sqlite.ColumnList{FirstNameColumn, LastNameColumn, LastUpdateColumn}
+		ActorIDColumn      = sqlite.IntegerColumn("actor_id")
+		FirstNameColumn    = sqlite.StringColumn("first_name")
+		LastNameColumn     = sqlite.StringColumn("last_name")
+		LastUpdateColumn   = sqlite.TimestampColumn("last_update")
+		allColumns         = sqlite.ColumnList{ActorIDColumn, FirstNameColumn, LastNameColumn, LastUpdateColumn}
+		mutableColumns     = sqlite.ColumnList{FirstNameColumn, LastNameColumn, LastUpdateColumn}
+		defaultableColumns = sqlite.ColumnList{ActorIDColumn, LastUpdateColumn}
 	)
 
 	return actorTable{
@@ -223,8 +225,9 @@ func newActorTableImpl(schemaName, tableName, alias string) actorTable {
 		LastName:   LastNameColumn,
 		LastUpdate: LastUpdateColumn,
 
-		AllColumns:     allColumns,
-		MutableColumns: mutableColumns,
+		AllColumns:         allColumns,
+		MutableColumns:     mutableColumns,
+		DefaultableColumns: defaultableColumns,
 	}
 }
 `
@@ -290,8 +293,9 @@ type filmListTable struct {
 	Rating      sqlite.ColumnString
 	Actors      sqlite.ColumnString
 
-	AllColumns     sqlite.ColumnList
-	MutableColumns sqlite.ColumnList
+	AllColumns         sqlite.ColumnList
+	MutableColumns     sqlite.ColumnList
+	DefaultableColumns sqlite.ColumnList
 }
 
 type FilmListTable struct {
@@ -329,16 +333,17 @@ func newFilmListTable(schemaName, tableName, alias string) *FilmListTable {
 
 func newFilmListTableImpl(schemaName, tableName, alias string) filmListTable {
 	var (
-		FidColumn         = sqlite.IntegerColumn("FID")
-		TitleColumn       = sqlite.StringColumn("title")
-		DescriptionColumn = sqlite.StringColumn("description")
-		CategoryColumn    = sqlite.StringColumn("category")
-		PriceColumn       = sqlite.FloatColumn("price")
-		LengthColumn      = sqlite.IntegerColumn("length")
-		RatingColumn      = sqlite.StringColumn("rating")
-		ActorsColumn      = sqlite.StringColumn("actors")
-		allColumns        = sqlite.ColumnList{FidColumn, TitleColumn, DescriptionColumn, CategoryColumn, PriceColumn, LengthColumn, RatingColumn, ActorsColumn}
-		mutableColumns    = sqlite.ColumnList{FidColumn, TitleColumn, DescriptionColumn, CategoryColumn, PriceColumn, LengthColumn, RatingColumn, ActorsColumn}
+		FidColumn          = sqlite.IntegerColumn("FID")
+		TitleColumn        = sqlite.StringColumn("title")
+		DescriptionColumn  = sqlite.StringColumn("description")
+		CategoryColumn     = sqlite.StringColumn("category")
+		PriceColumn        = sqlite.FloatColumn("price")
+		LengthColumn       = sqlite.IntegerColumn("length")
+		RatingColumn       = sqlite.StringColumn("rating")
+		ActorsColumn       = sqlite.StringColumn("actors")
+		allColumns         = sqlite.ColumnList{FidColumn, TitleColumn, DescriptionColumn, CategoryColumn, PriceColumn, LengthColumn, RatingColumn, ActorsColumn}
+		mutableColumns     = sqlite.ColumnList{FidColumn, TitleColumn, DescriptionColumn, CategoryColumn, PriceColumn, LengthColumn, RatingColumn, ActorsColumn}
+		defaultableColumns = sqlite.ColumnList{}
 	)
 
 	return filmListTable{
@@ -354,8 +359,9 @@ func newFilmListTableImpl(schemaName, tableName, alias string) filmListTable {
 		Rating:      RatingColumn,
 		Actors:      ActorsColumn,
 
-		AllColumns:     allColumns,
-		MutableColumns: mutableColumns,
+		AllColumns:         allColumns,
+		MutableColumns:     mutableColumns,
+		DefaultableColumns: defaultableColumns,
 	}
 }
 `
@@ -0,0 +1,265 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/go-jet/jet/v2"
+	"github.com/go-jet/jet/v2/qrm"
+	. "github.com/go-jet/jet/v2/sqlite"
+	"github.com/stretchr/testify/require"
+)
+
+type QueryActor struct {
+	ActorID   int64 `sql:"primary_key"`
+	FirstName string
+}
+
+type QueryFilm struct {
+	Title string
+}
+
+type queryActorFilms struct {
+	QueryActor
+
+	Films []QueryFilm
+}
+
+func openQueryTestDB(t *testing.T) *sql.DB {
+	db, err := sql.Open("sqlite3", ":memory:")
+	require.NoError(t, err)
+
+	db.SetMaxOpenConns(1)
+
+	_, err = db.Exec(`
+CREATE TABLE actor (actor_id INTEGER PRIMARY KEY, first_name TEXT NOT NULL);
+CREATE TABLE film (actor_id INTEGER NOT NULL, title TEXT NOT NULL);
+INSERT INTO actor VALUES (1, 'Penelope'), (2, 'Nick');
+INSERT INTO film VALUES (1, 'Academy Dinosaur'), (1, 'Anaconda Confessions'), (2, 'Adaptation Holes');
+`)
+	require.NoError(t, err)
+
+	return db
+}
+
+var selectActors = RawStatement(`
+SELECT actor.actor_id AS "query_actor.actor_id",
+       actor.first_name AS "query_actor.first_name"
+FROM actor
+WHERE actor.actor_id >= #minID
+ORDER BY actor.actor_id`, RawArgs{"#minID": 1})
+
+var selectActorFilms = RawStatement(`
+SELECT actor.actor_id AS "query_actor.actor_id",
+       actor.first_name AS "query_actor.first_name",
+       film.title AS "query_film.title"
+FROM actor
+     JOIN film ON film.actor_id = actor.actor_id
+ORDER BY actor.actor_id, film.title`)
+
+func TestQueryAll(t *testing.T) {
+	db := openQueryTestDB(t)
+	defer db.Close()
+
+	ctx := context.Background()
+
+	actors, err := jet.QueryAll[QueryActor](ctx, db, selectActors)
+	require.NoError(t, err)
+	require.Equal(t, []QueryActor{{ActorID: 1, FirstName: "Penelope"}, {ActorID: 2, FirstName: "Nick"}}, actors)
+
+	actorsFilms, err := jet.QueryAll[queryActorFilms](ctx, db, selectActorFilms)
+	require.NoError(t, err)
+	require.Len(t, actorsFilms, 2)
+	require.Len(t, actorsFilms[0].Films, 2)
+	require.Equal(t, "Adaptation Holes", actorsFilms[1].Films[0].Title)
+
+	names, err := jet.QueryAll[string](ctx, db, RawStatement(`SELECT first_name FROM actor ORDER BY actor_id`))
+	require.NoError(t, err)
+	require.Equal(t, []string{"Penelope", "Nick"}, names)
+
+	_, err = jet.QueryAll[QueryActor](ctx, db, RawStatement(`SELECT * FROM missing`))
+	require.EqualError(t, err, "jet: no such table: missing")
+}
+
+func TestQueryOne(t *testing.T) {
+	db := openQueryTestDB(t)
+	defer db.Close()
+
+	ctx := context.Background()
+
+	penelope, err := jet.QueryOne[queryActorFilms](ctx, db, RawStatement(`
+SELECT actor.actor_id AS "query_actor.actor_id",
+       actor.first_name AS "query_actor.first_name",
+       film.title AS "query_film.title"
+FROM actor
+     JOIN film ON film.actor_id = actor.actor_id
+WHERE actor.actor_id = 1`))
+	require.NoError(t, err)
+	require.Equal(t, "Penelope", penelope.FirstName)
+	require.Len(t, penelope.Films, 2)
+
+	count, err := jet.QueryOne[int64](ctx, db, RawStatement(`SELECT COUNT(*) FROM film`))
+	require.NoError(t, err)
+	require.Equal(t, int64(3), count)
+
+	_, err = jet.QueryOne[QueryActor](ctx, db, RawStatement(`SELECT actor_id AS "query_actor.actor_id" FROM actor WHERE actor_id = 3`))
+	require.ErrorIs(t, err, qrm.ErrNoRows)
+
+	_, err = jet.QueryOne[string](ctx, db, RawStatement(`SELECT first_name FROM actor WHERE actor_id = 3`))
+	require.ErrorIs(t, err, qrm.ErrNoRows)
+}
+
+func TestQueryRows(t *testing.T) {
+	db := openQueryTestDB(t)
+	defer db.Close()
+
+	ctx := context.Background()
+
+	rows, err := jet.QueryRows[QueryActor](ctx, db, selectActors)
+	require.NoError(t, err)
+
+	var actors []QueryActor
+
+	for rows.Next() {
+		actor, err := rows.Scan()
+		require.NoError(t, err)
+
+		actors = append(actors, actor)
+	}
+
+	require.NoError(t, rows.Err())
+	require.NoError(t, rows.Close())
+	require.Equal(t, []QueryActor{{ActorID: 1, FirstName: "Penelope"}, {ActorID: 2, FirstName: "Nick"}}, actors)
+
+	rows, err = jet.QueryRows[QueryActor](ctx, db, selectActors)
+	require.NoError(t, err)
+
+	all, err := rows.All()
+	require.NoError(t, err)
+	require.Equal(t, actors, all)
+
+	_, err = jet.QueryRows[int64](ctx, db, selectActors)
+	require.EqualError(t, err, "jet: Rows type has to be a struct, got int64")
+}
+
+func TestQueryGroupedRows(t *testing.T) {
+	db := openQueryTestDB(t)
+	defer db.Close()
+
+	ctx := context.Background()
+
+	rows, err := jet.QueryGroupedRows[queryActorFilms](ctx, db, selectActorFilms)
+	require.NoError(t, err)
+
+	var actors []queryActorFilms
+
+	for rows.Next() {
+		actor, err := rows.Scan()
+		require.NoError(t, err)
+
+		actors = append(actors, actor)
+	}
+
+	require.NoError(t, rows.Err())
+	require.NoError(t, rows.Close())
+
+	require.Equal(t, []queryActorFilms{
+		{
+			QueryActor: QueryActor{ActorID: 1, FirstName: "Penelope"},
+			Films:      []QueryFilm{{Title: "Academy Dinosaur"}, {Title: "Anaconda Confessions"}},
+		},
+		{
+			QueryActor: QueryActor{ActorID: 2, FirstName: "Nick"},
+			Films:      []QueryFilm{{Title: "Adaptation Holes"}},
+		},
+	}, actors)
+
+	all, err := jet.QueryAll[queryActorFilms](ctx, db, selectActorFilms)
+	require.NoError(t, err)
+	require.Equal(t, all, actors)
+
+	// destination without primary key has a group for each row
+	rows2, err := jet.QueryGroupedRows[QueryFilm](ctx, db, RawStatement(`SELECT title AS "query_film.title" FROM film ORDER BY title`))
+	require.NoError(t, err)
+	defer rows2.Close()
+
+	var films []QueryFilm
+
+	for rows2.Next() {
+		film, err := rows2.Scan()
+		require.NoError(t, err)
+
+		films = append(films, film)
+	}
+
+	require.NoError(t, rows2.Err())
+	require.Len(t, films, 3)
+
+	empty, err := jet.QueryGroupedRows[queryActorFilms](ctx, db, RawStatement(`
+SELECT actor.actor_id AS "query_actor.actor_id" FROM actor WHERE actor.actor_id > 10`))
+	require.NoError(t, err)
+	require.False(t, empty.Next())
+	require.NoError(t, empty.Err())
+	require.NoError(t, empty.Close())
+
+	_, err = jet.QueryGroupedRows[[]QueryActor](ctx, db, selectActors)
+	require.EqualError(t, err, "jet: GroupedRows type has to be a struct, got slice")
+}
+
+func TestQuery_StrictMode(t *testing.T) {
+	db := openQueryTestDB(t)
+	defer db.Close()
+
+	ctx := context.Background()
+
+	// query_film.title has no destination field
+	_, err := jet.QueryAll[QueryActor](ctx, db, selectActorFilms)
+	require.NoError(t, err)
+
+	_, err = jet.QueryAll[QueryActor](ctx, db, selectActorFilms, qrm.StrictColumns)
+	require.EqualError(t, err, "jet: strict mapping into sqlite.QueryActor failed, unmapped columns: query_film.title")
+
+	_, err = jet.QueryOne[QueryActor](qrm.WithStrictMode(ctx, qrm.StrictColumns), db, selectActorFilms)
+	require.EqualError(t, err, "jet: strict mapping into sqlite.QueryActor failed, unmapped columns: query_film.title")
+
+	err = selectActorFilms.QueryContext(qrm.WithStrictMode(ctx, qrm.Strict), db, &[]QueryActor{})
+	require.EqualError(t, err, "jet: strict mapping into sqlite.QueryActor failed, unmapped columns: query_film.title")
+
+	actors, err := jet.QueryAll[queryActorFilms](ctx, db, selectActorFilms, qrm.Strict)
+	require.NoError(t, err)
+	require.Len(t, actors, 2)
+
+	// actor first name is not selected
+	rows, err := jet.QueryRows[QueryActor](ctx, db, RawStatement(`SELECT actor_id AS "query_actor.actor_id" FROM actor`), qrm.StrictFields)
+	require.NoError(t, err)
+	defer rows.Close()
+
+	require.True(t, rows.Next())
+	_, err = rows.Scan()
+	require.EqualError(t, err, "jet: strict mapping into sqlite.QueryActor failed, unmapped fields: FirstName")
+}
+
+func TestQueryAll_Maps(t *testing.T) {
+	db := openQueryTestDB(t)
+	defer db.Close()
+
+	ctx := context.Background()
+
+	rows, err := jet.QueryAll[map[string]interface{}](ctx, db, selectActors)
+	require.NoError(t, err)
+	require.Equal(t, []map[string]interface{}{
+		{"query_actor.actor_id": int64(1), "query_actor.first_name": "Penelope"},
+		{"query_actor.actor_id": int64(2), "query_actor.first_name": "Nick"},
+	}, rows)
+	require.Equal(t, int64(2), queryInfo.RowsProcessed)
+
+	nested, err := jet.QueryOne[map[string]map[string]interface{}](ctx, db, selectActorFilms)
+	require.NoError(t, err)
+	require.Equal(t, map[string]map[string]interface{}{
+		"query_actor": {"actor_id": int64(1), "first_name": "Penelope"},
+		"query_film":  {"title": "Academy Dinosaur"},
+	}, nested)
+	require.Equal(t, int64(3), queryInfo.RowsProcessed)
+	require.NoError(t, queryInfo.Err)
+}